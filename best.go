@@ -0,0 +1,77 @@
+package cactuskev
+
+import (
+	"fmt"
+)
+
+// combinations[n] lists, in lexicographic order, every way of choosing five
+// of n cards. combinations[7] is the same as Perm7.
+var combinations = func() (t [10][][5]int) {
+	for n := 5; n < len(t); n++ {
+		var c [5]int
+		var choose func(i, from int)
+		choose = func(i, from int) {
+			if i == len(c) {
+				t[n] = append(t[n], c)
+				return
+			}
+			for c[i] = from; c[i] <= n-len(c)+i; c[i]++ {
+				choose(i+1, c[i]+1)
+			}
+		}
+		choose(0, 0)
+	}
+	return t
+}()
+
+// EvalBest evaluates every five-card combination of 5 to 9 cards, and returns
+// the best Score along with the five cards that make it.
+func EvalBest(cards []Card) (Score, [5]Card) {
+	score, five, _ := evalDetailed(cards)
+	return score, five
+}
+
+// evalDetailed is EvalBest that also returns the cards that do not play.
+func evalDetailed(cards []Card) (Score, [5]Card, []Card) {
+	score, best := evalBest(cards)
+
+	var (
+		five [5]Card
+		rest = make([]Card, 0, len(cards)-len(five))
+	)
+
+	for i, j := 0, 0; i < len(cards); i++ {
+		if j < len(best) && best[j] == i {
+			five[j] = cards[i]
+			j++
+		} else {
+			rest = append(rest, cards[i])
+		}
+	}
+
+	return score, five, rest
+}
+
+// evalBest returns the best Score of cards and the indices of the
+// combination that makes it.
+func evalBest(cards []Card) (Score, [5]int) {
+	if n := len(cards); n < 5 || n >= len(combinations) {
+		panic(fmt.Errorf("hand of %d cards not supported", n))
+	}
+
+	var (
+		h    FiveCardHand
+		best = Score(9999)
+		comb [5]int
+	)
+
+	for _, c := range combinations[len(cards)] {
+		h.A, h.B, h.C, h.D, h.E = cards[c[0]], cards[c[1]], cards[c[2]], cards[c[3]], cards[c[4]]
+
+		if q := h.Eval(); best.Less(q) {
+			best, comb = q, c
+		}
+	}
+
+	return best, comb
+}
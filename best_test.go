@@ -0,0 +1,101 @@
+package cactuskev
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCombinations(t *testing.T) {
+	for n, want := range map[int]int{5: 1, 6: 6, 7: 21, 8: 56, 9: 126} {
+		if got := len(combinations[n]); got != want {
+			t.Errorf("expected %d combinations of %d cards, got %d", want, n, got)
+		}
+	}
+
+	if len(combinations[7]) != len(Perm7) {
+		t.Fatalf("expected %d combinations of 7 cards to match Perm7, got %d", len(Perm7), len(combinations[7]))
+	}
+	for i, c := range combinations[7] {
+		if c != Perm7[i] {
+			t.Errorf("combination %d: expected %v, got %v", i, Perm7[i], c)
+		}
+	}
+}
+
+func TestEvalBest(t *testing.T) {
+	cards, err := ParseDeck("2c 7d Ah Kh 9s Qh Jh 3c Th")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for n := 5; n <= len(cards); n++ {
+		score, five := EvalBest(cards[:n])
+
+		h := NewFiveCardHand()
+		for i, c := range five {
+			h.SetCard(i, c)
+		}
+		if s := h.Eval(); s != score {
+			t.Errorf("%d cards: %v scores %v, expected %v", n, h, s, score)
+		}
+
+		if n == len(cards) && score != 1 {
+			t.Errorf("expected royal flush, got %v from %v", score, five)
+		}
+	}
+
+	for i := 0; i < 1000; i++ {
+		h := RandomHand(7)
+		if s, _ := EvalBest(h.Cards()); s != h.Eval() {
+			t.Errorf("%v: expected %v, got %v", h, h.Eval(), s)
+		}
+	}
+}
+
+func TestSixCardHand(t *testing.T) {
+	h, err := ParseHand("Ah Kh Qh Jh 9h Th")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := h.(*SixCardHand); !ok {
+		t.Errorf("expected *SixCardHand, got %T", h)
+	}
+
+	if s := h.Eval(); s != 1 {
+		t.Errorf("expected royal flush, got %v", s)
+	}
+}
+
+func TestEvalDetailed(t *testing.T) {
+	h, err := ParseHand("Kc 7d Kh 7s 2c 9s Kd")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	score, five, rest := h.(*SevenCardHand).EvalDetailed()
+	if score != h.Eval() {
+		t.Errorf("expected %v, got %v", h.Eval(), score)
+	}
+
+	if want := "[K♣ 7♦ K♥ 7♠ K♦]"; fmt.Sprint(five) != want {
+		t.Errorf("expected %s, got %v", want, five)
+	}
+
+	if want := "[2♣ 9♠]"; fmt.Sprint(rest) != want {
+		t.Errorf("expected kickers %s, got %v", want, rest)
+	}
+
+	for i := 0; i < 100; i++ {
+		h := RandomHand(7).(*SevenCardHand)
+		score, five, rest := h.EvalDetailed()
+
+		seen := make(map[Card]bool)
+		for _, c := range append(five[:], rest...) {
+			seen[c] = true
+		}
+		if len(seen) != 7 || len(rest) != 2 || score != h.Eval() {
+			t.Errorf("%v: unexpected %v %v %v", h, score, five, rest)
+		}
+	}
+}
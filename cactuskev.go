@@ -1,9 +1,21 @@
 // Credit: http://suffecool.net/poker/evaluator.html
 package cactuskev
 
+//go:generate go run maketables.go
+//go:generate go run maketables.go -tables lowball
+//go:generate go run maketables.go -tables shortdeck
+
 import (
+	"errors"
 	"fmt"
-	"log"
+)
+
+var (
+	ErrRank      = errors.New("invalid rank")
+	ErrSuit      = errors.New("invalid suit")
+	ErrDuplicate = errors.New("duplicate card")
+	ErrCard      = errors.New("invalid card")
+	ErrIndex     = errors.New("index overflow")
 )
 
 type Score int16
@@ -15,6 +27,8 @@ func (s Score) Less(other Score) bool {
 
 func (s Score) Category() Category {
 	switch {
+	case s < 0:
+		return FiveOfAKind
 	case s > 6185:
 		return HighCard
 	case s > 3325:
@@ -42,8 +56,13 @@ func (s Score) String() string {
 
 type Category int
 
+// Categories are ordered best first, so that a lower Category is a better
+// hand.
 const (
-	StraightFlush Category = iota
+	// FiveOfAKind beats a StraightFlush, and is only made with wild cards.
+	// Its scores go from -13 for five aces to -1 for five deuces.
+	FiveOfAKind Category = iota
+	StraightFlush
 	FourOfAKind
 	FullHouse
 	Flush
@@ -52,6 +71,8 @@ const (
 	TwoPair
 	OnePair
 	HighCard
+
+	numCategories
 )
 
 func (c Category) String() string {
@@ -74,11 +95,11 @@ func (c Category) String() string {
 		return "One Pair"
 	case HighCard:
 		return "High Card"
+	case FiveOfAKind:
+		return "Five of a Kind"
 	default:
-		log.Panicf("unknown Category %d", c)
+		return fmt.Sprintf("invalid(0x%x)", int(c))
 	}
-
-	return ""
 }
 
 func (s Suit) String() string {
@@ -92,10 +113,8 @@ func (s Suit) String() string {
 	case Spade:
 		return "♠"
 	default:
-		log.Panicf("unknown suit: %d", s)
+		return fmt.Sprintf("invalid(0x%x)", uint16(s))
 	}
-
-	return ""
 }
 
 func (r Rank) String() string {
@@ -127,12 +146,17 @@ func (r Rank) String() string {
 	case Ace:
 		return "A"
 	default:
-		log.Panicf("unknown rank: %d", r)
+		return fmt.Sprintf("invalid(0x%x)", uint16(r))
 	}
-
-	return ""
 }
 
 func (c Card) String() string {
+	if c == Joker {
+		return "Joker"
+	}
+	if !c.Valid() {
+		return fmt.Sprintf("invalid(0x%08x)", uint32(c))
+	}
+
 	return fmt.Sprintf("%v%v", c.Rank(), c.Suit())
 }
@@ -1,6 +1,8 @@
 package cactuskev
 
 import (
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -8,6 +10,10 @@ func BenchmarkFiveHand(b *testing.B) {
 	bench(b, RandomHand(5))
 }
 
+func BenchmarkSixHand(b *testing.B) {
+	bench(b, RandomHand(6))
+}
+
 func BenchmarkSevenHand(b *testing.B) {
 	bench(b, RandomHand(7))
 }
@@ -19,6 +25,34 @@ func bench(b *testing.B, hand Hand) {
 	}
 }
 
+// pairedHands returns five-card hands that are looked up by prime product.
+func pairedHands() []*FiveCardHand {
+	var hands []*FiveCardHand
+	for len(hands) < 1024 {
+		h := RandomHand(5).(*FiveCardHand)
+		if !h.IsSuited() && Unique5[h.Bit()] == 0 {
+			hands = append(hands, h)
+		}
+	}
+	return hands
+}
+
+func BenchmarkFiveHandPaired(b *testing.B) {
+	hands := pairedHands()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hands[i%len(hands)].Eval()
+	}
+}
+
+func BenchmarkFiveHandPairedSearch(b *testing.B) {
+	hands := pairedHands()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hands[i%len(hands)].EvalSearch()
+	}
+}
+
 func BenchmarkFiveCardHandPrime(b *testing.B) {
 	h := RandomHand(5)
 	b.ResetTimer()
@@ -31,6 +65,10 @@ func TestFive(t *testing.T) {
 	RandomHand(5).Eval()
 }
 
+func TestSix(t *testing.T) {
+	RandomHand(6).Eval()
+}
+
 func TestSeven(t *testing.T) {
 	RandomHand(7).Eval()
 }
@@ -41,6 +79,26 @@ func TestAllFive(t *testing.T) {
 		func(h Hand) Hand { return h } /* no need to zero it */)
 }
 
+func TestEvalSearch(t *testing.T) {
+	deck := NewDeck()
+	h := NewFiveCardHand()
+
+	for a := 0; a < 48; a++ {
+		for b := a + 1; b < 49; b++ {
+			for c := b + 1; c < 50; c++ {
+				for d := c + 1; d < 51; d++ {
+					for e := d + 1; e < 52; e++ {
+						h.A, h.B, h.C, h.D, h.E = deck[a], deck[b], deck[c], deck[d], deck[e]
+						if x, y := h.Eval(), h.EvalSearch(); x != y {
+							t.Fatalf("%v: perfect hash gives %v, binary search %v", h, x, y)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
 func TestAllSeven(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping, because.")
@@ -120,3 +178,90 @@ func TestHand(t *testing.T) {
 		}
 	}
 }
+
+func TestTryNewCard(t *testing.T) {
+	if c, err := TryNewCard(Diamond, King); err != nil || c != NewCard(Diamond, King) {
+		t.Errorf("expected %v, got %v, %v", NewCard(Diamond, King), c, err)
+	}
+
+	if _, err := TryNewCard(Diamond, Ace+1); !errors.Is(err, ErrRank) {
+		t.Errorf("expected ErrRank, got %v", err)
+	}
+
+	if _, err := TryNewCard(Club|Heart, Ace); !errors.Is(err, ErrSuit) {
+		t.Errorf("expected ErrSuit, got %v", err)
+	}
+}
+
+func TestCardValid(t *testing.T) {
+	for _, c := range NewDeck() {
+		if !c.Valid() {
+			t.Errorf("%v: expected valid card", c)
+		}
+	}
+
+	c := NewCard(Diamond, King)
+	for _, bad := range []Card{
+		0,
+		c ^ 0x01,                  // wrong prime
+		c | Card(Spade),           // several suit bits
+		c | 1<<16,                 // several rank bits
+		c&^0xf00 | Card(Queen)<<8, // rank disagrees with prime
+		c | 1<<30,                 // stray high bit
+	} {
+		if bad.Valid() {
+			t.Errorf("0x%08x: expected invalid card", uint32(bad))
+		}
+
+		if s := bad.String(); !strings.HasPrefix(s, "invalid(0x") {
+			t.Errorf("0x%08x: unexpected String %q", uint32(bad), s)
+		}
+	}
+
+	if s := Suit(0x3000).String(); s != "invalid(0x3000)" {
+		t.Errorf("unexpected Suit String %q", s)
+	}
+
+	if s := Rank(13).String(); s != "invalid(0xd)" {
+		t.Errorf("unexpected Rank String %q", s)
+	}
+
+	if s := Category(42).String(); s != "invalid(0x2a)" {
+		t.Errorf("unexpected Category String %q", s)
+	}
+}
+
+func TestNewHandErr(t *testing.T) {
+	for _, n := range []int{5, 6, 7} {
+		if h, err := NewHandErr(n); err != nil || h.Len() != n {
+			t.Errorf("%d: unexpected hand %v, %v", n, h, err)
+		}
+	}
+
+	if _, err := NewHandErr(3); err == nil {
+		t.Errorf("expected error for three-card hand")
+	}
+}
+
+func TestSetCard(t *testing.T) {
+	h := NewHand(5)
+	c := NewCard(Spade, Ace)
+
+	if err := SetCard(h, 4, c); err != nil {
+		t.Error(err)
+	} else if d, err := GetCard(h, 4); err != nil || d != c {
+		t.Errorf("expected %v, got %v, %v", c, d, err)
+	}
+
+	if err := SetCard(h, 5, c); !errors.Is(err, ErrIndex) {
+		t.Errorf("expected ErrIndex, got %v", err)
+	}
+
+	if _, err := GetCard(h, -1); !errors.Is(err, ErrIndex) {
+		t.Errorf("expected ErrIndex, got %v", err)
+	}
+
+	if err := SetCard(h, 0, c|Card(Heart)); !errors.Is(err, ErrCard) {
+		t.Errorf("expected ErrCard, got %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package cactuskev
+
+// suitPermutations lists the 24 ways of reordering the four suits, as the
+// new position of each suit of a new Deck.
+var suitPermutations = func() (t [][4]int) {
+	var (
+		p    [4]int
+		used [4]bool
+		pick func(i int)
+	)
+
+	pick = func(i int) {
+		if i == len(p) {
+			t = append(t, p)
+			return
+		}
+		for s := range used {
+			if !used[s] {
+				used[s], p[i] = true, s
+				pick(i + 1)
+				used[s] = false
+			}
+		}
+	}
+	pick(0)
+
+	return t
+}()
+
+// permuteSuits moves the cards of each suit of s to the suit at its position
+// in p.
+func (s CardSet) permuteSuits(p [4]int) CardSet {
+	var t CardSet
+	for i, j := range p {
+		t |= (s >> uint(13*i) & 0x1fff) << uint(13*j)
+	}
+	return t
+}
+
+// Canonicalize returns the canonical form of a situation of hole cards and
+// board cards. Situations that only differ by the names of the suits, such as
+// AhKh on 7h2c3c and AsKs on 7s2d3d, have the same canonical form, which is
+// one of them. The cards of each are returned in the order of a new Deck.
+//
+// The board is taken as one set of cards, so the streets are not told apart:
+// a flop and turn have the same canonical form as any flop and turn with the
+// same four cards. To keep streets apart, canonicalize the cards dealt
+// before a street as the hole cards, and those of the street as the board.
+//
+// It returns an error if a card is not Valid, or is given twice.
+func Canonicalize(hole, board []Card) ([]Card, []Card, error) {
+	h, b, err := canonical(hole, board)
+	if err != nil {
+		return nil, nil, err
+	}
+	return h.ToCards(), b.ToCards(), nil
+}
+
+// Isomorphs returns the number of situations that have the same canonical
+// form as hole and board, including this one. Over all situations of a
+// number of hole and board cards, these add up to the number of ways of
+// dealing them: 1,326 over the 169 canonical hole cards, and 22,100 over the
+// 1,755 canonical flops.
+//
+// Like Canonicalize, it takes the board as one set of cards, and returns an
+// error if a card is not Valid, or is given twice.
+func Isomorphs(hole, board []Card) (int, error) {
+	h, b, err := canonical(hole, board)
+	if err != nil {
+		return 0, err
+	}
+
+	var n int
+
+	// The situation is left as it is by 24 / n of the permutations.
+	for _, p := range suitPermutations {
+		if h.permuteSuits(p) == h && b.permuteSuits(p) == b {
+			n++
+		}
+	}
+
+	return len(suitPermutations) / n, nil
+}
+
+// canonical returns the hole and board cards, under the permutation of the
+// suits that gives the lowest hole cards, and of those, the lowest board.
+func canonical(hole, board []Card) (CardSet, CardSet, error) {
+	all := append(append([]Card(nil), hole...), board...)
+	if err := checkCards(all); err != nil {
+		return 0, 0, err
+	}
+
+	var (
+		h, b         = FromCards(hole...), FromCards(board...)
+		bestH, bestB = h, b
+	)
+
+	for _, p := range suitPermutations {
+		ph, pb := h.permuteSuits(p), b.permuteSuits(p)
+		if ph < bestH || ph == bestH && pb < bestB {
+			bestH, bestB = ph, pb
+		}
+	}
+
+	return bestH, bestB, nil
+}
@@ -0,0 +1,156 @@
+package cactuskev
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCanonicalize(t *testing.T) {
+	for _, test := range []struct {
+		a, b string // hole cards and board
+		same bool
+	}{
+		{"Ah Kh | 7h 2c 3c", "As Ks | 7s 2d 3d", true},
+		{"Ah Kh | 7h 2c 3c", "Kd Ad | 3h 7d 2h", true},
+		{"Ah Kh | 7h 2c 3c", "Ah Kh | 7c 2h 3h", false},
+		{"Ah Kd |", "Ac Ks |", true},
+		{"Ah Kd |", "Ah Kh |", false},
+		{"| Qs Jd 4c", "| 4h Jc Qd", true},
+	} {
+		ah, ab := canonicalOf(t, test.a)
+		bh, bb := canonicalOf(t, test.b)
+
+		if same := equalCards(ah, bh) && equalCards(ab, bb); same != test.same {
+			t.Errorf("%s and %s: expected same %v, got %v %v and %v %v", test.a, test.b, test.same, ah, ab, bh, bb)
+		}
+	}
+}
+
+func canonicalOf(t *testing.T, s string) ([]Card, []Card) {
+	t.Helper()
+
+	h, b, _ := strings.Cut(s, "|")
+	hole, err := ParseDeck(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	board, err := ParseDeck(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ch, cb, err := Canonicalize(hole, board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ch, cb
+}
+
+func isomorphs(t *testing.T, hole, board []Card) int {
+	t.Helper()
+
+	n, err := Isomorphs(hole, board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func equalCards(a, b []Card) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestIsomorphs(t *testing.T) {
+	for _, test := range []struct {
+		hole, board int
+		classes     int
+	}{
+		{2, 0, 169},
+		{0, 3, 1755},
+		{0, 4, 16432},
+	} {
+		var (
+			classes = make(map[[2]CardSet]int)
+			raw     int
+		)
+
+		Combinations(NewDeck(), test.hole+test.board, func(cards []Card) {
+			Combinations(cards, test.hole, func(hole []Card) {
+				board := FromCards(cards...).Difference(FromCards(hole...)).ToCards()
+
+				h, b, err := Canonicalize(hole, board)
+				if err != nil {
+					t.Fatal(err)
+				}
+				key := [2]CardSet{FromCards(h...), FromCards(b...)}
+				if n, ok := classes[key]; !ok {
+					classes[key] = isomorphs(t, hole, board)
+				} else if m := isomorphs(t, hole, board); n != m {
+					t.Errorf("%v %v: expected %d isomorphs, got %d", hole, board, n, m)
+				}
+				raw++
+			})
+		})
+
+		var sum int
+		for _, n := range classes {
+			sum += n
+		}
+
+		if len(classes) != test.classes {
+			t.Errorf("%d hole and %d board cards: expected %d classes, got %d", test.hole, test.board, test.classes, len(classes))
+		}
+		if sum != raw {
+			t.Errorf("%d hole and %d board cards: isomorphs add up to %d, not %d", test.hole, test.board, sum, raw)
+		}
+	}
+}
+
+func TestIsomorphsHoldem(t *testing.T) {
+	for _, test := range []struct {
+		hole, board string
+		want        int
+	}{
+		{"As Ah", "", 6},
+		{"As Ks", "", 4},
+		{"As Kh", "", 12},
+		{"", "Ks Kh Kd", 4},
+		{"", "Ks Qs Js", 4},
+		{"", "Ks Qh Jd", 24},
+		{"As Ah", "Ks Kh 2c", 12},
+	} {
+		hole, _ := ParseDeck(test.hole)
+		board, _ := ParseDeck(test.board)
+
+		if got := isomorphs(t, hole, board); got != test.want {
+			t.Errorf("%s | %s: expected %d, got %d", test.hole, test.board, test.want, got)
+		}
+	}
+}
+
+func TestCanonicalizeErrors(t *testing.T) {
+	for _, test := range []struct {
+		hole, board []Card
+		err         error
+	}{
+		{[]Card{NewCard(Spade, Ace), NewCard(Heart, Ace)}, []Card{NewCard(Spade, Ace)}, ErrDuplicate},
+		{[]Card{NewCard(Spade, Ace), Joker}, nil, ErrCard},
+		{nil, []Card{NewCard(Spade, Ace), NewCard(Spade, Ace), NewCard(Heart, Ace)}, ErrDuplicate},
+	} {
+		if _, _, err := Canonicalize(test.hole, test.board); !errors.Is(err, test.err) {
+			t.Errorf("%v %v: expected %v, got %v", test.hole, test.board, test.err, err)
+		}
+		if _, err := Isomorphs(test.hole, test.board); !errors.Is(err, test.err) {
+			t.Errorf("%v %v: expected %v, got %v", test.hole, test.board, test.err, err)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package cactuskev
+
+import (
+	"math/bits"
+	"strings"
+)
+
+// A CardSet is a set of cards, held as a bit mask with the bit at the Index
+// of each card. It only holds Valid cards.
+type CardSet uint64
+
+// AllCards is the set of the 52 cards of a new Deck.
+const AllCards CardSet = 1<<52 - 1
+
+// Set returns the set holding only c, or the empty set if c is not Valid.
+func (c Card) Set() CardSet {
+	if !c.Valid() {
+		return 0
+	}
+	return 1 << uint(c.Index())
+}
+
+// FromCards returns the set of the Valid cards among cards.
+func FromCards(cards ...Card) CardSet {
+	var s CardSet
+	for _, c := range cards {
+		s |= c.Set()
+	}
+	return s
+}
+
+// ToCards returns the cards of s, in the order of a new Deck.
+func (s CardSet) ToCards() []Card {
+	cards := make([]Card, 0, s.Len())
+	s.Each(func(c Card) { cards = append(cards, c) })
+	return cards
+}
+
+// Each calls fn with each card of s, in the order of a new Deck.
+func (s CardSet) Each(fn func(c Card)) {
+	for m := s & AllCards; m != 0; m &= m - 1 {
+		fn(CardAt(bits.TrailingZeros64(uint64(m))))
+	}
+}
+
+// Len returns the number of cards in s.
+func (s CardSet) Len() int {
+	return bits.OnesCount64(uint64(s & AllCards))
+}
+
+// Contains reports whether c is in s.
+func (s CardSet) Contains(c Card) bool {
+	return s&c.Set() != 0
+}
+
+// Add returns s with c added.
+func (s CardSet) Add(c Card) CardSet {
+	return s | c.Set()
+}
+
+// Union returns the cards in s or o.
+func (s CardSet) Union(o CardSet) CardSet {
+	return s | o
+}
+
+// Intersect returns the cards in both s and o.
+func (s CardSet) Intersect(o CardSet) CardSet {
+	return s & o
+}
+
+// Difference returns the cards in s but not in o.
+func (s CardSet) Difference(o CardSet) CardSet {
+	return s &^ o
+}
+
+// String lists the cards of s, like a Deck.
+func (s CardSet) String() string {
+	var b strings.Builder
+	b.WriteByte('[')
+	s.Each(func(c Card) {
+		if b.Len() > 1 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(c.String())
+	})
+	b.WriteByte(']')
+	return b.String()
+}
@@ -0,0 +1,65 @@
+package cactuskev
+
+import (
+	"testing"
+)
+
+func TestCardSet(t *testing.T) {
+	var (
+		ak   = FromCards(NewCard(Spade, Ace), NewCard(Spade, King))
+		kq   = FromCards(NewCard(Spade, King), NewCard(Heart, Queen))
+		king = NewCard(Spade, King).Set()
+	)
+
+	if ak.Len() != 2 || !ak.Contains(NewCard(Spade, Ace)) || ak.Contains(NewCard(Heart, Ace)) {
+		t.Errorf("unexpected set %v", ak)
+	}
+	if got := ak.Union(kq); got.Len() != 3 || got != ak|kq {
+		t.Errorf("unexpected union %v", got)
+	}
+	if got := ak.Intersect(kq); got != king {
+		t.Errorf("unexpected intersection %v", got)
+	}
+	if got := ak.Difference(kq); got != NewCard(Spade, Ace).Set() {
+		t.Errorf("unexpected difference %v", got)
+	}
+	if got := ak.Add(NewCard(Spade, King)); got != ak {
+		t.Errorf("adding a card twice changed the set: %v", got)
+	}
+
+	if Joker.Set() != 0 || FromCards(Joker).Contains(Joker) {
+		t.Errorf("set holds Joker")
+	}
+	if AllCards.Len() != 52 || FromCards(NewDeck()...) != AllCards {
+		t.Errorf("unexpected set of all cards %v", AllCards)
+	}
+
+	if got, want := kq.String(), "[Q♥ K♠]"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestCardSetCards(t *testing.T) {
+	for i := 0; i < 52; i++ {
+		c := CardAt(i)
+		if got := c.Set().ToCards(); len(got) != 1 || got[0] != c {
+			t.Errorf("expected [%v], got %v", c, got)
+		}
+	}
+
+	d := NewDeck()
+	d.Shuffle(NewShuffler(1))
+	cards := FromCards(d[:20]...).ToCards()
+
+	if len(cards) != 20 {
+		t.Fatalf("expected 20 cards, got %d", len(cards))
+	}
+	for i, c := range cards {
+		if !Deck(d[:20]).Contains(c) {
+			t.Errorf("unexpected card %v", c)
+		}
+		if i > 0 && cards[i-1].Index() >= c.Index() {
+			t.Errorf("cards out of order: %v", cards)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package cactuskev
+
+// Combinations calls fn with every way of choosing k of cards, in colex
+// order: by the position in cards of the last card chosen, then of the one
+// before, and so on. fn must not keep combo, which is reused between calls.
+func Combinations(cards []Card, k int, fn func(combo []Card)) {
+	CombinationsBetween(cards, k, 0, Binomial(len(cards), k), fn)
+}
+
+// CombinationsBetween is like Combinations, but only calls fn with the
+// combinations of colex rank from up to, but not including, to. Together
+// with SplitCombinations, it lets workers share out the combinations.
+func CombinationsBetween(cards []Card, k, from, to int, fn func(combo []Card)) {
+	combo := make([]Card, k)
+
+	eachCombination(len(cards), k, from, to, func(idx []int) {
+		for i, j := range idx {
+			combo[i] = cards[j]
+		}
+		fn(combo)
+	})
+}
+
+// Combinations calls fn with every set of k cards of s, in colex order of
+// their Index.
+func (s CardSet) Combinations(k int, fn func(combo CardSet)) {
+	cards := s.ToCards()
+	sets := make([]CardSet, len(cards))
+	for i, c := range cards {
+		sets[i] = c.Set()
+	}
+
+	eachCombination(len(cards), k, 0, Binomial(len(cards), k), func(idx []int) {
+		var combo CardSet
+		for _, j := range idx {
+			combo |= sets[j]
+		}
+		fn(combo)
+	})
+}
+
+// eachCombination calls fn with the positions, in ascending order, of each
+// combination of k of n items of colex rank from up to to.
+func eachCombination(n, k, from, to int, fn func(idx []int)) {
+	if total := Binomial(n, k); to > total {
+		to = total
+	}
+	if from < 0 {
+		from = 0
+	}
+	if from >= to {
+		return
+	}
+
+	idx := make([]int, k)
+	ColexUnrank(from, idx)
+
+	for r := from; ; {
+		fn(idx)
+		if r++; r == to {
+			return
+		}
+
+		// The next combination in colex order moves up the first
+		// position that can move, and all before it back to the start.
+		i := 0
+		for i+1 < k && idx[i]+1 == idx[i+1] {
+			i++
+		}
+		idx[i]++
+		for j := 0; j < i; j++ {
+			idx[j] = j
+		}
+	}
+}
+
+// ColexRank returns the colex rank of the combination of the positions idx,
+// in ascending order: the number of combinations of as many positions that
+// come before it in the order of Combinations.
+func ColexRank(idx []int) int {
+	var r int
+	for i, j := range idx {
+		r += Binomial(j, i+1)
+	}
+	return r
+}
+
+// ColexUnrank sets idx to the positions, in ascending order, of the
+// combination of len(idx) positions of colex rank r.
+func ColexUnrank(r int, idx []int) {
+	for i := len(idx) - 1; i >= 0; i-- {
+		j := i
+		for Binomial(j+1, i+1) <= r {
+			j++
+		}
+		idx[i] = j
+		r -= Binomial(j, i+1)
+	}
+}
+
+// SplitCombinations splits the colex ranks of the combinations of k of n
+// items into up to parts ranges of about equal size, each holding ranks from
+// its first element up to, but not including, its second.
+func SplitCombinations(n, k, parts int) [][2]int {
+	total := Binomial(n, k)
+	if parts > total {
+		parts = total
+	}
+
+	var ranges [][2]int
+	for p := 0; p < parts; p++ {
+		ranges = append(ranges, [2]int{total * p / parts, total * (p + 1) / parts})
+	}
+	return ranges
+}
+
+// Binomial returns n choose k, the number of ways of choosing k of n items.
+func Binomial(n, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+	if n < len(binomials) {
+		return binomials[n][k]
+	}
+
+	b := 1
+	for i := 1; i <= k; i++ {
+		b = b * (n - k + i) / i
+	}
+	return b
+}
+
+// binomials[n][k] is n choose k, by Pascal's triangle, so that ranking
+// combinations of cards does not divide.
+var binomials = func() (t [65][65]int) {
+	for n := range t {
+		t[n][0] = 1
+		for k := 1; k <= n; k++ {
+			t[n][k] = t[n-1][k-1] + t[n-1][k]
+		}
+	}
+	return t
+}()
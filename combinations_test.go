@@ -0,0 +1,144 @@
+package cactuskev
+
+import (
+	"testing"
+)
+
+func TestCombinationsColex(t *testing.T) {
+	var (
+		cards = NewDeck()[:6]
+		seen  = make(map[CardSet]bool)
+		r     int
+	)
+
+	Combinations(cards, 3, func(combo []Card) {
+		s := FromCards(combo...)
+		if s.Len() != 3 || seen[s] {
+			t.Errorf("unexpected combination %v", combo)
+		}
+		seen[s] = true
+
+		idx := make([]int, len(combo))
+		for i, c := range combo {
+			idx[i] = c.Index()
+		}
+		if got := ColexRank(idx); got != r {
+			t.Errorf("%v: expected rank %d, got %d", combo, r, got)
+		}
+		r++
+	})
+
+	if len(seen) != 20 {
+		t.Errorf("expected 20 combinations, got %d", len(seen))
+	}
+
+	for _, test := range []struct{ n, k, want int }{
+		{6, 0, 1},
+		{6, 6, 1},
+		{6, 7, 0},
+		{0, 0, 1},
+		{52, 2, 1326},
+	} {
+		var got int
+		Combinations(NewDeck()[:test.n], test.k, func([]Card) { got++ })
+		if got != test.want {
+			t.Errorf("%d of %d: expected %d combinations, got %d", test.k, test.n, test.want, got)
+		}
+	}
+}
+
+func TestBinomial(t *testing.T) {
+	for _, tc := range [][3]int{
+		{52, 5, 2598960}, {47, 0, 1}, {5, 6, 0}, {5, -1, 0},
+		{64, 32, 1832624140942590534}, {65, 2, 2080}, {70, 3, 54740},
+	} {
+		if b := Binomial(tc[0], tc[1]); b != tc[2] {
+			t.Errorf("%d choose %d: expected %d, got %d", tc[0], tc[1], tc[2], b)
+		}
+	}
+}
+
+func TestColexUnrank(t *testing.T) {
+	idx := make([]int, 5)
+
+	for r := 0; r < Binomial(52, 5); r += 9973 {
+		ColexUnrank(r, idx)
+		for i := 1; i < len(idx); i++ {
+			if idx[i-1] >= idx[i] || idx[i] >= 52 {
+				t.Fatalf("rank %d: unexpected positions %v", r, idx)
+			}
+		}
+		if got := ColexRank(idx); got != r {
+			t.Fatalf("%v: expected rank %d, got %d", idx, r, got)
+		}
+	}
+
+	ColexUnrank(Binomial(52, 5)-1, idx)
+	if want := []int{47, 48, 49, 50, 51}; !equalInts(idx, want) {
+		t.Errorf("expected last combination %v, got %v", want, idx)
+	}
+}
+
+func TestSplitCombinations(t *testing.T) {
+	var (
+		deck  = NewDeck()[:20]
+		seen  = make(map[CardSet]bool)
+		parts = SplitCombinations(len(deck), 4, 7)
+	)
+
+	if len(parts) != 7 || parts[0][0] != 0 || parts[6][1] != Binomial(20, 4) {
+		t.Fatalf("unexpected parts %v", parts)
+	}
+
+	for i, p := range parts {
+		if i > 0 && p[0] != parts[i-1][1] {
+			t.Errorf("parts not adjacent: %v", parts)
+		}
+
+		CombinationsBetween(deck, 4, p[0], p[1], func(combo []Card) {
+			s := FromCards(combo...)
+			if seen[s] {
+				t.Errorf("combination %v in two parts", combo)
+			}
+			seen[s] = true
+		})
+	}
+
+	if len(seen) != Binomial(20, 4) {
+		t.Errorf("expected %d combinations, got %d", Binomial(20, 4), len(seen))
+	}
+
+	if got := SplitCombinations(5, 2, 20); len(got) != 10 {
+		t.Errorf("expected 10 parts of one, got %v", got)
+	}
+}
+
+func TestCardSetCombinations(t *testing.T) {
+	var (
+		s    = FromCards(NewDeck()[10:17]...)
+		seen = make(map[CardSet]bool)
+	)
+
+	s.Combinations(5, func(combo CardSet) {
+		if combo.Len() != 5 || combo.Difference(s) != 0 || seen[combo] {
+			t.Errorf("unexpected combination %v", combo)
+		}
+		seen[combo] = true
+	})
+
+	if len(seen) != 21 {
+		t.Errorf("expected 21 combinations, got %d", len(seen))
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
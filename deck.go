@@ -1,18 +1,29 @@
 package cactuskev
 
 import (
+	"fmt"
 	"log"
-	"math/rand"
+	"sort"
 )
 
 type Deck []Card
 
 func NewDeck() Deck {
-	deck := make([]Card, 52)
+	return newDeck(Deuce)
+}
+
+// NewShortDeck returns the 36 cards, Six to Ace, of Short Deck poker, in the
+// order of NewDeck.
+func NewShortDeck() Deck {
+	return newDeck(Six)
+}
+
+func newDeck(lowest Rank) Deck {
+	deck := make([]Card, 0, 4*(Ace-lowest+1))
 
-	for i, suit := range []Suit{Club, Diamond, Heart, Spade} {
-		for j, rank := range []Rank{Deuce, Trey, Four, Five, Six, Seven, Eight, Nine, Ten, Jack, Queen, King, Ace} {
-			deck[(i*13)+j] = NewCard(suit, rank)
+	for _, suit := range []Suit{Club, Diamond, Heart, Spade} {
+		for rank := lowest; rank <= Ace; rank++ {
+			deck = append(deck, NewCard(suit, rank))
 		}
 	}
 
@@ -22,15 +33,33 @@ func NewDeck() Deck {
 func (d Deck) Len() int      { return len(d) }
 func (d Deck) Swap(i, j int) { d[i], d[j] = d[j], d[i] }
 
+// Less orders cards by rank, and cards of the same rank by suit in the order
+// of NewDeck, clubs first. Cards that are not Valid, such as a Joker, come
+// after the cards they share a rank and suit index with.
 func (d Deck) Less(i, j int) bool {
-	return d[i].Rank() < d[j].Rank() &&
-		d[i].Suit() < d[j].Suit()
+	if d[i].Rank() != d[j].Rank() {
+		return d[i].Rank() < d[j].Rank()
+	}
+	if si, sj := suitIndex(d[i].Suit()), suitIndex(d[j].Suit()); si != sj {
+		return si < sj
+	}
+	return d[i] < d[j]
+}
+
+// Sort sorts d in the order of Less.
+func (d Deck) Sort() {
+	sort.Sort(d)
+}
+
+// Clone returns a copy of d.
+func (d Deck) Clone() Deck {
+	return append(Deck(nil), d...)
 }
  
+// Randomize shuffles d with the top-level functions of math/rand. See
+// Shuffle for other sources of randomness.
 func (d Deck) Randomize() {
-	for i, j := range rand.Perm(d.Len()) {
-		d.Swap(i, j)
-	}
+	d.Shuffle(globalShuffler{})
 }
 
 // Draw, if any cards are available, removes a card from the end of the deck
@@ -53,13 +82,98 @@ func (d *Deck) MustDraw() Card {
 	}
 }
 
+// Peek, if any cards are available, returns the card Draw would remove and
+// true, without removing it.
+func (d Deck) Peek() (Card, bool) {
+	if l := d.Len(); l == 0 {
+		return 0, false
+	} else {
+		return d[l-1], true
+	}
+}
+
+// Deal, if at least n cards are available, removes n cards from the end of
+// the deck and returns them, in the order Draw would, and true. Otherwise it
+// leaves the deck alone and returns false.
+func (d *Deck) Deal(n int) ([]Card, bool) {
+	l := d.Len()
+	if n < 0 || n > l {
+		return nil, false
+	}
+
+	cards := make([]Card, n)
+	for i := range cards {
+		cards[i] = (*d)[l-1-i]
+	}
+	*d = (*d)[:l-n]
+
+	return cards, true
+}
+
+// Burn, if any cards are available, discards the card Draw would remove and
+// returns true.
+func (d *Deck) Burn() bool {
+	_, ok := d.Draw()
+	return ok
+}
+
+// Cut moves the k cards at the end of the deck, which Draw removes first, to
+// the start, keeping their order. It panics unless 0 <= k <= d.Len().
+func (d Deck) Cut(k int) {
+	if k < 0 || k > d.Len() {
+		panic(fmt.Errorf("%w: cut %d of %d cards", ErrIndex, k, d.Len()))
+	}
+
+	top := append([]Card(nil), d[d.Len()-k:]...)
+	copy(d[k:], d[:d.Len()-k])
+	copy(d, top)
+}
+
+// Set returns the set of the Valid cards of d.
+func (d Deck) Set() CardSet {
+	return FromCards(d...)
+}
+
+// Contains reports whether c is in d. To look up many cards, look them up
+// in d.Set() instead.
+func (d Deck) Contains(c Card) bool {
+	if c.Valid() {
+		return d.Set().Contains(c)
+	}
+
+	for _, card := range d {
+		if card == c {
+			return true
+		}
+	}
+	return false
+}
+
 // Remove removes c from Deck.
 func (d *Deck) Remove(c Card) {
-	for i, card := range *d {
-		if card.Suit() == c.Suit() && card.Rank() == c.Rank() {
-			*d = append((*d)[:i], (*d)[i+1:]...)
+	d.RemoveAll(c)
+}
+
+// RemoveAll removes the given cards from Deck, keeping the order of the
+// others.
+func (d *Deck) RemoveAll(cards ...Card) {
+	var (
+		remove = FromCards(cards...)
+		others Deck // cards a CardSet cannot hold, such as a Joker
+	)
+	for _, c := range cards {
+		if !c.Valid() {
+			others = append(others, c)
 		}
 	}
+
+	kept := (*d)[:0]
+	for _, card := range *d {
+		if !remove.Contains(card) && !others.Contains(card) {
+			kept = append(kept, card)
+		}
+	}
+	*d = kept
 }
 
 type Suit uint16
@@ -95,11 +209,35 @@ const (
 type Card int32
 
 func NewCard(s Suit, r Rank) Card {
+	c, err := TryNewCard(s, r)
+	if err != nil {
+		log.Panic(err)
+	}
+
+	return c
+}
+
+// TryNewCard is like NewCard, but returns an error rather than panicking if
+// s or r is out of range.
+func TryNewCard(s Suit, r Rank) (Card, error) {
 	if int(r) >= len(Primes) {
-		log.Panicf("unknown rank: %d", r)
+		return 0, fmt.Errorf("%w: %d", ErrRank, r)
+	}
+
+	switch s {
+	case Club, Diamond, Heart, Spade:
+	default:
+		return 0, fmt.Errorf("%w: %d", ErrSuit, s)
 	}
 
-	return Card(Primes[r] | (int(r) << 8) | int(s) | (1 << uint(16+r)))
+	return Card(Primes[r] | (int(r) << 8) | int(s) | (1 << uint(16+r))), nil
+}
+
+// Valid reports whether c is a well-formed card, that is whether its prime,
+// rank, suit and rank bit agree with each other.
+func (c Card) Valid() bool {
+	v, err := TryNewCard(c.Suit(), c.Rank())
+	return err == nil && v == c
 }
 
 func (c Card) Suit() Suit {
@@ -117,3 +255,28 @@ func (c Card) Prime() int {
 func (c Card) Bit() int {
 	return int(c) >> 16
 }
+
+var suits = [...]Suit{Club, Diamond, Heart, Spade}
+
+// Index returns the position of c in a new Deck, from 0 (2♣) to 51 (A♠).
+func (c Card) Index() int {
+	return suitIndex(c.Suit())*13 + int(c.Rank())
+}
+
+// CardAt returns the card at position i, 0 to 51, of a new Deck.
+func CardAt(i int) Card {
+	return NewCard(suits[i/13], Rank(i%13))
+}
+
+func suitIndex(s Suit) int {
+	switch s {
+	case Club:
+		return 0
+	case Diamond:
+		return 1
+	case Heart:
+		return 2
+	default:
+		return 3
+	}
+}
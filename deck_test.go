@@ -42,3 +42,170 @@ func TestMustDraw(t *testing.T) {
 		deck.MustDraw()
 	}
 }
+
+func TestRemoveAll(t *testing.T) {
+	d := NewDeck()
+	d = append(d, Joker)
+	d.RemoveAll(NewCard(Club, Deuce), NewCard(Club, Trey), NewCard(Spade, Ace), Joker)
+	if d.Len() != 49 {
+		t.Errorf("expected Deck length of 49, not %d", d.Len())
+	}
+	for _, c := range []Card{NewCard(Club, Deuce), NewCard(Club, Trey), NewCard(Spade, Ace), Joker} {
+		if d.Contains(c) {
+			t.Errorf("unexpected Card in Deck: %s", c)
+		}
+	}
+	if !d.Contains(NewCard(Club, Four)) {
+		t.Errorf("missing Card in Deck: %s", NewCard(Club, Four))
+	}
+	for i := 1; i < d.Len(); i++ {
+		if d[i-1].Index() >= d[i].Index() {
+			t.Fatalf("order of Deck not kept: %v", d)
+		}
+	}
+
+	// Removing adjacent cards one by one, as dealing engines do.
+	d = NewDeck()
+	for i := 0; i < 13; i++ {
+		d.Remove(CardAt(i))
+	}
+	if d.Len() != 39 || d.Contains(NewCard(Club, Ace)) {
+		t.Errorf("clubs not removed: %v", d)
+	}
+}
+
+func TestContains(t *testing.T) {
+	d := NewShortDeck()
+	if s := d.Set(); s.Len() != 36 || s.Contains(NewCard(Heart, Five)) {
+		t.Errorf("unexpected set %v", s)
+	}
+	if d.Contains(Joker) || d.Contains(NewCard(Heart, Five)) || !d.Contains(NewCard(Heart, Six)) {
+		t.Errorf("unexpected membership in %v", d)
+	}
+
+	d = append(d, Joker)
+	if !d.Contains(Joker) || d.Set().Len() != 36 {
+		t.Errorf("expected Joker in %v", d)
+	}
+}
+
+func TestSort(t *testing.T) {
+	d := NewDeck()
+	d.Shuffle(NewShuffler(1))
+	d.Sort()
+
+	for i := 1; i < d.Len(); i++ {
+		if !d.Less(i-1, i) || d.Less(i, i-1) {
+			t.Fatalf("not in order at %d: %v", i, d)
+		}
+	}
+	if d[0] != NewCard(Club, Deuce) || d[51] != NewCard(Spade, Ace) {
+		t.Errorf("expected 2c first and As last, got %v and %v", d[0], d[51])
+	}
+	for i := 1; i < d.Len(); i++ {
+		if d[i-1].Rank() == d[i].Rank() && d[i-1].Index() > d[i].Index() {
+			t.Errorf("%v sorts before %v against their Index", d[i-1], d[i])
+		}
+	}
+
+	d = append(d, Joker)
+	d.Shuffle(NewShuffler(2))
+	d.Sort()
+	for i := 1; i < d.Len(); i++ {
+		if !d.Less(i-1, i) || d.Less(i, i-1) {
+			t.Fatalf("not in order at %d: %v", i, d)
+		}
+	}
+}
+
+func TestDeal(t *testing.T) {
+	d := NewDeck()
+
+	if c, ok := d.Peek(); !ok || c != NewCard(Spade, Ace) {
+		t.Errorf("expected to peek As, got %v", c)
+	}
+	if !d.Burn() || d.Len() != 51 {
+		t.Errorf("expected Deck length of 51 after burn, not %d", d.Len())
+	}
+
+	cards, ok := d.Deal(3)
+	if !ok {
+		t.Fatalf("could not deal 3 cards")
+	}
+	want := []Card{NewCard(Spade, King), NewCard(Spade, Queen), NewCard(Spade, Jack)}
+	for i := range want {
+		if cards[i] != want[i] {
+			t.Errorf("expected %v, got %v", want, cards)
+			break
+		}
+	}
+	if d.Len() != 48 {
+		t.Errorf("expected Deck length of 48, not %d", d.Len())
+	}
+
+	if _, ok := d.Deal(49); ok || d.Len() != 48 {
+		t.Errorf("dealt more cards than available")
+	}
+	if cards, ok := d.Deal(48); !ok || len(cards) != 48 || d.Len() != 0 {
+		t.Errorf("could not deal the rest of the Deck")
+	}
+	if _, ok := d.Peek(); ok {
+		t.Errorf("peeked into empty Deck")
+	}
+	if d.Burn() {
+		t.Errorf("burnt card of empty Deck")
+	}
+}
+
+func TestCut(t *testing.T) {
+	d := NewDeck()
+	d.Cut(2)
+
+	if d[0] != NewCard(Spade, King) || d[1] != NewCard(Spade, Ace) || d[2] != NewCard(Club, Deuce) {
+		t.Errorf("unexpected cut: %v", d[:3])
+	}
+	if c, _ := d.Peek(); c != NewCard(Spade, Queen) {
+		t.Errorf("expected Qs on top, got %v", c)
+	}
+
+	e := d.Clone()
+	e.Cut(0)
+	e.Cut(e.Len())
+	for i := range d {
+		if d[i] != e[i] {
+			t.Fatalf("empty cut changed Deck at %d", i)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("did not panic")
+		}
+	}()
+	d.Cut(53)
+}
+
+func TestClone(t *testing.T) {
+	d := NewDeck()
+	e := d.Clone()
+	e.Swap(0, 1)
+	e.MustDraw()
+
+	if d.Len() != 52 || d[0] != NewCard(Club, Deuce) {
+		t.Errorf("Clone shares cards with Deck")
+	}
+}
+
+func TestRandomizeHand(t *testing.T) {
+	// RandomizeHand used to skip cards, dealing every other one.
+	s := NewShuffler(3)
+	h := RandomHandFrom(7, s)
+
+	d := NewDeck()
+	d.Shuffle(NewShuffler(3))
+	for i := 0; i < h.Len(); i++ {
+		if h.Card(i) != d[i] {
+			t.Fatalf("expected %v, got %v at %d", d[:7], h.Card(i), i)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package cactuskev
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Locale holds the words used to describe hands.
+//
+// Short and Long hold a format per Category, which is given the arguments:
+//
+//	%[1]s  the most significant rank, e.g. "Ace"
+//	%[2]s  the same rank in plural, e.g. "Aces"
+//	%[3]s  the second most significant rank
+//	%[4]s  the same rank in plural
+//	%[5]s  the kickers, joined by Separator
+//	%[6]s  the first kicker with an indefinite article, e.g. "an Eight"
+type Locale struct {
+	Singular, Plural, Indefinite [13]string
+	Short, Long                  [numCategories]string
+	Separator                    string
+
+	// Names replacing the Long (and, for RoyalFlush, Short) description
+	// of particular hands.
+	RoyalFlush, SteelWheel, Broadway, Wheel string
+}
+
+var English = &Locale{
+	Singular: [...]string{
+		"Two", "Three", "Four", "Five", "Six", "Seven", "Eight",
+		"Nine", "Ten", "Jack", "Queen", "King", "Ace",
+	},
+	Plural: [...]string{
+		"Twos", "Threes", "Fours", "Fives", "Sixes", "Sevens", "Eights",
+		"Nines", "Tens", "Jacks", "Queens", "Kings", "Aces",
+	},
+	Indefinite: [...]string{
+		"a Two", "a Three", "a Four", "a Five", "a Six", "a Seven", "an Eight",
+		"a Nine", "a Ten", "a Jack", "a Queen", "a King", "an Ace",
+	},
+	Short: [...]string{
+		StraightFlush: "%[1]s-high straight flush",
+		FourOfAKind:   "Four %[2]s",
+		FullHouse:     "%[2]s full of %[4]s",
+		Flush:         "%[1]s-high flush",
+		Straight:      "%[1]s-high straight",
+		ThreeOfAKind:  "Three %[2]s",
+		TwoPair:       "Two Pair, %[2]s and %[4]s",
+		OnePair:       "Pair of %[2]s",
+		HighCard:      "%[1]s high",
+		FiveOfAKind:   "Five %[2]s",
+	},
+	Long: [...]string{
+		StraightFlush: "%[1]s-high straight flush",
+		FourOfAKind:   "Four %[2]s with %[6]s kicker",
+		FullHouse:     "%[2]s full of %[4]s",
+		Flush:         "%[1]s-high flush with %[5]s",
+		Straight:      "%[1]s-high straight",
+		ThreeOfAKind:  "Three %[2]s with %[5]s kickers",
+		TwoPair:       "Two Pair, %[2]s and %[4]s with %[6]s",
+		OnePair:       "Pair of %[2]s with %[5]s kickers",
+		HighCard:      "%[1]s high with %[5]s",
+		FiveOfAKind:   "Five %[2]s",
+	},
+	Separator:  ", ",
+	RoyalFlush: "Royal Flush",
+	SteelWheel: "Five-high straight flush (Steel Wheel)",
+	Broadway:   "Ace-high straight (Broadway)",
+	Wheel:      "Five-high straight (Wheel)",
+}
+
+// Describe returns the long English description of the hand h makes.
+func Describe(h Hand) string {
+	return h.Eval().Describe()
+}
+
+// Describe returns a long English description of s, such as "Four Aces with
+// a King kicker".
+func (s Score) Describe() string { return English.Describe(s) }
+
+// DescribeShort returns a short English description of s, such as "Four
+// Aces".
+func (s Score) DescribeShort() string { return English.DescribeShort(s) }
+
+// Describe returns the long description of s.
+func (l *Locale) Describe(s Score) string {
+	switch s {
+	case 1:
+		return l.RoyalFlush
+	case 10:
+		return l.SteelWheel
+	case 1600:
+		return l.Broadway
+	case 1609:
+		return l.Wheel
+	}
+
+	return l.describe(s, &l.Long)
+}
+
+// DescribeShort returns the short description of s.
+func (l *Locale) DescribeShort(s Score) string {
+	if s == 1 {
+		return l.RoyalFlush
+	}
+
+	return l.describe(s, &l.Short)
+}
+
+// made is the number of significant ranks, i.e. those that are not kickers,
+// of each Category.
+var made = [...]int{
+	StraightFlush: 5,
+	FourOfAKind:   1,
+	FullHouse:     2,
+	Flush:         1,
+	Straight:      5,
+	ThreeOfAKind:  1,
+	TwoPair:       2,
+	OnePair:       1,
+	HighCard:      1,
+	FiveOfAKind:   1,
+}
+
+func (l *Locale) describe(s Score, formats *[numCategories]string) string {
+	ranks := s.Ranks()
+	if ranks == nil {
+		return s.String()
+	}
+
+	var (
+		c       = s.Category()
+		kickers []string
+		args    = make([]interface{}, 6)
+	)
+
+	for _, r := range ranks[made[c]:] {
+		kickers = append(kickers, l.Singular[r])
+	}
+
+	args[0], args[1] = l.Singular[ranks[0]], l.Plural[ranks[0]]
+	if len(ranks) > 1 {
+		args[2], args[3] = l.Singular[ranks[1]], l.Plural[ranks[1]]
+	}
+	args[4] = strings.Join(kickers, l.Separator)
+	if len(ranks) > made[c] {
+		args[5] = l.Indefinite[ranks[made[c]]]
+	}
+
+	return fmt.Sprintf(formats[c], args...)
+}
+
+// scoreRanks lists, for each Score, the distinct ranks of the hands that make
+// it, most significant first: the rank of the largest group of cards, ties
+// broken by the higher rank. The ace of a five-high straight comes last.
+var scoreRanks = func() (t [7463][]Rank) {
+	for b := range Flushes {
+		if Flushes[b] == 0 {
+			continue
+		}
+
+		var ranks []Rank
+		for r := int(Ace); r >= 0; r-- {
+			if b&(1<<uint(r)) != 0 {
+				ranks = append(ranks, Rank(r))
+			}
+		}
+		if b == 0x100f {
+			ranks = append(ranks[1:], Ace)
+		}
+
+		t[Flushes[b]] = ranks
+		t[Unique5[b]] = ranks
+	}
+
+	for i, p := range products {
+		var counts [13]int
+		for r, prime := range Primes {
+			for ; p%prime == 0; p /= prime {
+				counts[r]++
+			}
+		}
+
+		var ranks []Rank
+		for n := 4; n > 0; n-- {
+			for r := int(Ace); r >= 0; r-- {
+				if counts[r] == n {
+					ranks = append(ranks, Rank(r))
+				}
+			}
+		}
+
+		t[values[i]] = ranks
+	}
+
+	return t
+}()
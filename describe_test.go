@@ -0,0 +1,69 @@
+package cactuskev
+
+import (
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		hand, long, short string
+	}{
+		{"Ah Kh Qh Jh Th", "Royal Flush", "Royal Flush"},
+		{"5d 4d 3d 2d Ad", "Five-high straight flush (Steel Wheel)", "Five-high straight flush"},
+		{"9c 8c 7c 6c 5c", "Nine-high straight flush", "Nine-high straight flush"},
+		{"As Ah Ad Ac Ks", "Four Aces with a King kicker", "Four Aces"},
+		{"Ks Kh Kd Kc As", "Four Kings with an Ace kicker", "Four Kings"},
+		{"7s 7h 7d 2c 2s", "Sevens full of Twos", "Sevens full of Twos"},
+		{"Qh Jh 9h 6h 4h", "Queen-high flush with Jack, Nine, Six, Four", "Queen-high flush"},
+		{"As Kh Qd Jc Ts", "Ace-high straight (Broadway)", "Ace-high straight"},
+		{"5s 4h 3d 2c As", "Five-high straight (Wheel)", "Five-high straight"},
+		{"8s 7h 6d 5c 4s", "Eight-high straight", "Eight-high straight"},
+		{"7s 7h 7d Ac 2s", "Three Sevens with Ace, Two kickers", "Three Sevens"},
+		{"Js Jh 4d 4c 9s", "Two Pair, Jacks and Fours with a Nine", "Two Pair, Jacks and Fours"},
+		{"Js Jh Ad Kc 9s", "Pair of Jacks with Ace, King, Nine kickers", "Pair of Jacks"},
+		{"As Kh 9d 6c 4s", "Ace high with King, Nine, Six, Four", "Ace high"},
+		{"7s 5h 4d 3c 2s", "Seven high with Five, Four, Three, Two", "Seven high"},
+	}
+
+	for _, test := range tests {
+		h, err := ParseHand(test.hand)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if s := Describe(h); s != test.long {
+			t.Errorf("%s: expected %q, got %q", test.hand, test.long, s)
+		}
+
+		if s := h.Eval().DescribeShort(); s != test.short {
+			t.Errorf("%s: expected %q, got %q", test.hand, test.short, s)
+		}
+	}
+}
+
+func TestDescribeAll(t *testing.T) {
+	seen := make(map[string]Score)
+
+	for s := Score(1); s <= 7462; s++ {
+		d := s.Describe()
+		if prev, ok := seen[d]; ok {
+			t.Errorf("%v and %v are both described as %q", prev, s, d)
+		}
+		seen[d] = s
+	}
+}
+
+func TestDescribeLocale(t *testing.T) {
+	l := *English
+	l.Plural[Ace] = "Esser"
+	l.Short[FourOfAKind] = "Fyra %[2]s"
+
+	h, err := ParseHand("As Ah Ad Ac Ks")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s := l.DescribeShort(h.Eval()); s != "Fyra Esser" {
+		t.Errorf(`expected "Fyra Esser", got %q`, s)
+	}
+}
@@ -0,0 +1,528 @@
+package cactuskev
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// EquityOptions controls how Equity deals the rest of the board. The zero
+// value is ready to use.
+type EquityOptions struct {
+	// MaxExhaustive is the most runouts that are enumerated one by one;
+	// with more, Trials random runouts are dealt instead. Zero means
+	// 2,000,000, which enumerates every heads-up preflop runout.
+	MaxExhaustive int
+
+	// Trials is the number of random runouts dealt when there are too many
+	// to enumerate. Zero means 100,000.
+	Trials int
+
+	// Seed seeds the random runouts; the same seed and Trials always give
+	// the same result.
+	Seed int64
+
+	// Workers is the number of goroutines sharing the work. Zero means
+	// runtime.NumCPU().
+	Workers int
+}
+
+// PlayerEquity is one player's share of the runouts: won outright, tied,
+// and the share of the pot won on average, ties split evenly.
+type PlayerEquity struct {
+	Win, Tie, Equity float64
+}
+
+type EquityResult struct {
+	Players    []PlayerEquity
+	Runouts    int  // runouts evaluated
+	Exhaustive bool // whether Runouts are all possible runouts
+}
+
+var (
+	ErrPlayers  = errors.New("need at least two players")
+	ErrHoldings = errors.New("ranges leave no holdings without shared cards")
+)
+
+// Equity deals the rest of the board, up to five cards, for the hole cards
+// of each player, and returns how often each has the best hold'em hand.
+// Dead cards are removed from the deck.
+func Equity(holes [][2]Card, board []Card, dead []Card, opts EquityOptions) (*EquityResult, error) {
+	if len(holes) < 2 {
+		return nil, ErrPlayers
+	}
+
+	var (
+		used   []Card
+		ranges = make([]Range, len(holes))
+	)
+
+	for i, h := range holes {
+		used = append(used, h[0], h[1])
+		ranges[i] = Range{{Cards: h, Weight: 1}}
+	}
+
+	if err := checkCards(append(append(used, board...), dead...)); err != nil {
+		return nil, err
+	}
+
+	return equityOf(ranges, board, dead, opts)
+}
+
+// RangeEquity is like Equity, but gives each player a range of hole cards.
+// Every combination of combos that share no card is weighted by the product
+// of their weights. Combos sharing a card with the board or dead cards are
+// removed.
+func RangeEquity(ranges []Range, board []Card, dead []Card, opts EquityOptions) (*EquityResult, error) {
+	if len(ranges) < 2 {
+		return nil, ErrPlayers
+	}
+
+	if err := checkCards(append(append([]Card(nil), board...), dead...)); err != nil {
+		return nil, err
+	}
+
+	live := make([]Range, len(ranges))
+	for i, r := range ranges {
+		for _, c := range r {
+			if err := checkCards(c.Cards[:]); err != nil {
+				return nil, err
+			}
+		}
+
+		if live[i] = r.Remove(append(append([]Card(nil), board...), dead...)...); len(live[i]) == 0 {
+			return nil, ErrHoldings
+		}
+	}
+
+	return equityOf(live, board, dead, opts)
+}
+
+func checkCards(cards []Card) error {
+	for _, c := range cards {
+		if !c.Valid() {
+			return fmt.Errorf("%w: %v", ErrCard, c)
+		}
+	}
+
+	return checkDistinct(cards)
+}
+
+func equityOf(ranges []Range, board, dead []Card, opts EquityOptions) (*EquityResult, error) {
+	if len(board) > 5 {
+		return nil, fmt.Errorf("board of %d cards not supported", len(board))
+	}
+
+	deck := NewDeck()
+	deck.RemoveAll(append(append([]Card(nil), board...), dead...)...)
+
+	k := 5 - len(board)
+	if left := deck.Len() - 2*len(ranges); k > left {
+		return nil, fmt.Errorf("%d cards left, need %d", left, k)
+	}
+
+	opts.defaults()
+
+	e := &equity{deck: deck, n: len(board), players: make([]PlayerEquity, len(ranges))}
+	copy(e.board[:], board)
+
+	// The number of showdowns, if every combination of combos is valid.
+	bound := Binomial(deck.Len()-2*len(ranges), k)
+	for _, r := range ranges {
+		if bound *= len(r); bound > opts.MaxExhaustive {
+			break
+		}
+	}
+
+	if bound <= opts.MaxExhaustive {
+		hs := holdings(ranges)
+		if len(hs) == 0 {
+			return nil, ErrHoldings
+		}
+		e.exhaustive(hs, opts.Workers)
+		e.result.Exhaustive = true
+	} else if err := e.monteCarlo(ranges, opts); err != nil {
+		return nil, err
+	}
+
+	for i := range e.players {
+		e.players[i].Win /= e.weight
+		e.players[i].Tie /= e.weight
+		e.players[i].Equity /= e.weight
+	}
+	e.result.Players = e.players
+
+	return &e.result, nil
+}
+
+func (o *EquityOptions) defaults() {
+	if o.MaxExhaustive == 0 {
+		o.MaxExhaustive = 2000000
+	}
+	if o.Trials == 0 {
+		o.Trials = 100000
+	}
+	if o.Workers == 0 {
+		o.Workers = runtime.NumCPU()
+	}
+}
+
+// A holding is the hole cards of every player at one showdown, with the
+// weight of that combination.
+type holding struct {
+	holes  [][2]Card
+	weight float64
+}
+
+// holdings lists every combination of one combo from each range, where no
+// two combos share a card.
+func holdings(ranges []Range) []holding {
+	var (
+		hs    []holding
+		holes = make([][2]Card, len(ranges))
+		pick  func(i int, used CardSet, weight float64)
+	)
+
+	pick = func(i int, used CardSet, weight float64) {
+		if i == len(ranges) {
+			hs = append(hs, holding{append([][2]Card(nil), holes...), weight})
+			return
+		}
+
+		for _, c := range ranges[i] {
+			m := comboMask(c.Cards)
+			if used&m != 0 {
+				continue
+			}
+			holes[i] = c.Cards
+			pick(i+1, used|m, weight*c.Weight)
+		}
+	}
+	pick(0, 0, 1)
+
+	return hs
+}
+
+func comboMask(cards [2]Card) CardSet {
+	return cards[0].Set() | cards[1].Set()
+}
+
+type equity struct {
+	deck  Deck // cards not on the board or dead
+	board [5]Card
+	n     int // cards on the board before dealing
+
+	mu      sync.Mutex
+	players []PlayerEquity
+	weight  float64
+	result  EquityResult
+}
+
+// tally counts the outcomes of the showdowns a single worker evaluates.
+type tally struct {
+	e       *equity
+	players []PlayerEquity
+	scores  []Score
+	weight  float64
+	n       int
+}
+
+func (e *equity) tally() *tally {
+	return &tally{e: e, players: make([]PlayerEquity, len(e.players)), scores: make([]Score, len(e.players))}
+}
+
+// showdown scores each player's holes on board and credits the winners with
+// weight w.
+func (t *tally) showdown(holes [][2]Card, b *[5]Card, w float64) {
+	var (
+		best    = Score(9999)
+		winners int
+	)
+
+	for i, h := range holes {
+		s := EvalSeven(h[0], h[1], b[0], b[1], b[2], b[3], b[4])
+		switch {
+		case s == best:
+			winners++
+		case best.Less(s):
+			best, winners = s, 1
+		}
+		t.scores[i] = s
+	}
+
+	for i, s := range t.scores {
+		if s != best {
+			continue
+		}
+		if winners == 1 {
+			t.players[i].Win += w
+		} else {
+			t.players[i].Tie += w
+		}
+		t.players[i].Equity += w / float64(winners)
+	}
+
+	t.weight += w
+	t.n++
+}
+
+func (t *tally) merge() {
+	t.e.mu.Lock()
+	defer t.e.mu.Unlock()
+
+	for i, p := range t.players {
+		t.e.players[i].Win += p.Win
+		t.e.players[i].Tie += p.Tie
+		t.e.players[i].Equity += p.Equity
+	}
+	t.e.weight += t.weight
+	t.e.result.Runouts += t.n
+}
+
+// exhaustive deals every runout for every holding. The work is split into
+// jobs of one holding and the first card dealt, which workers take turns at.
+func (e *equity) exhaustive(hs []holding, workers int) {
+	type job struct{ holding, first int }
+
+	var (
+		jobs = make(chan job, workers)
+		wg   sync.WaitGroup
+	)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var (
+				t    = e.tally()
+				b    = e.board
+				h    = -1
+				deck Deck
+				deal func(i, from int)
+			)
+
+			deal = func(i, from int) {
+				if i == len(b) {
+					t.showdown(hs[h].holes, &b, hs[h].weight)
+					return
+				}
+				for j := from; j <= deck.Len()-len(b)+i; j++ {
+					b[i] = deck[j]
+					deal(i+1, j+1)
+				}
+			}
+
+			for j := range jobs {
+				if j.holding != h {
+					h, deck = j.holding, e.deckFor(hs[j.holding].holes, deck[:0])
+				}
+
+				if e.n == len(b) {
+					t.showdown(hs[h].holes, &b, hs[h].weight)
+					continue
+				}
+				b[e.n] = deck[j.first]
+				deal(e.n+1, j.first+1)
+			}
+
+			t.merge()
+		}()
+	}
+
+	first := 1
+	if e.n < len(e.board) {
+		first = e.deck.Len() - 2*len(e.players) - (len(e.board) - e.n) + 1
+	}
+	for h := range hs {
+		for j := 0; j < first; j++ {
+			jobs <- job{h, j}
+		}
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// deckFor appends to d the cards of e.deck not among holes.
+func (e *equity) deckFor(holes [][2]Card, d Deck) Deck {
+	var used CardSet
+	for _, h := range holes {
+		used |= comboMask(h)
+	}
+
+	for _, c := range e.deck {
+		if !used.Contains(c) {
+			d = append(d, c)
+		}
+	}
+
+	return d
+}
+
+// monteCarlo plays opts.Trials showdowns, each between combos picked at
+// random by weight from each range, and a random runout. The trials are
+// split into fixed chunks, each with its own seed, so the result does not
+// depend on the number of workers. A trial whose picks keep sharing cards
+// is skipped, so fewer runouts may be played; only if none is, monteCarlo
+// returns ErrHoldings.
+func (e *equity) monteCarlo(ranges []Range, opts EquityOptions) error {
+	const chunk = 10000
+
+	pick, err := picker(ranges)
+	if err != nil {
+		return err
+	}
+
+	var (
+		chunks  = make(chan int)
+		tallies = make([]*tally, (opts.Trials+chunk-1)/chunk)
+		wg      sync.WaitGroup
+	)
+
+	for w := 0; w < opts.Workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var (
+				b     = e.board
+				holes = make([][2]Card, len(ranges))
+				rest  = make(Deck, e.deck.Len())
+			)
+
+			for c := range chunks {
+				t := e.tally()
+				r := rand.New(rand.NewSource(chunkSeed(opts.Seed, c)))
+				copy(rest, e.deck)
+
+				for trial := c * chunk; trial < opts.Trials && trial < (c+1)*chunk; trial++ {
+					used, ok := pick(r, holes)
+					if !ok {
+						continue
+					}
+
+					// Deal the runout by partial shuffle, skipping the
+					// cards held by players.
+					for i, j := e.n, 0; i < len(b); j++ {
+						k := j + r.Intn(rest.Len()-j)
+						rest.Swap(j, k)
+						if !used.Contains(rest[j]) {
+							b[i] = rest[j]
+							i++
+						}
+					}
+					t.showdown(holes, &b, 1)
+				}
+
+				tallies[c] = t
+			}
+		}()
+	}
+
+	for c := range tallies {
+		chunks <- c
+	}
+	close(chunks)
+	wg.Wait()
+
+	// Merge in order of chunk, as floating point sums depend on it.
+	for _, t := range tallies {
+		t.merge()
+	}
+
+	if e.result.Runouts == 0 {
+		return ErrHoldings
+	}
+
+	return nil
+}
+
+// chunkSeed returns the seed of chunk c of a run seeded with seed. Both are
+// scrambled by splitmix64's mixing function, so that nearby seeds do not
+// share the streams of their chunks.
+func chunkSeed(seed int64, c int) int64 {
+	mix := func(z uint64) uint64 {
+		z += 0x9e3779b97f4a7c15
+		z = (z ^ z>>30) * 0xbf58476d1ce4e5b9
+		z = (z ^ z>>27) * 0x94d049bb133111eb
+		return z ^ z>>31
+	}
+
+	return int64(mix(mix(uint64(seed)) + uint64(c)))
+}
+
+// maxListed is the most combinations of combos picker lists to pick from.
+const maxListed = 1 << 16
+
+// picker returns a function that sets holes to combos picked at random from
+// each range, each combination of combos that share no card as likely as
+// the product of their weights, and returns their cards. With few enough
+// combinations, it lists those that share no card and picks one of them.
+// Otherwise it picks a combo from each range, and starts over when two
+// share a card; after 1000 tries, it gives up and returns false.
+func picker(ranges []Range) (func(r *rand.Rand, holes [][2]Card) (CardSet, bool), error) {
+	const attempts = 1000
+
+	n := 1
+	for _, r := range ranges {
+		if n *= len(r); n > maxListed {
+			break
+		}
+	}
+
+	if n <= maxListed {
+		hs := holdings(ranges)
+		if len(hs) == 0 {
+			return nil, ErrHoldings
+		}
+
+		var (
+			cumulative = make([]float64, len(hs))
+			sum        float64
+		)
+		for i, h := range hs {
+			sum += h.weight
+			cumulative[i] = sum
+		}
+
+		return func(r *rand.Rand, holes [][2]Card) (CardSet, bool) {
+			var used CardSet
+			h := hs[sort.SearchFloat64s(cumulative, r.Float64()*sum)]
+			for i := range holes {
+				holes[i] = h.holes[i]
+				used |= comboMask(holes[i])
+			}
+			return used, true
+		}, nil
+	}
+
+	cumulative := make([][]float64, len(ranges))
+	for i, r := range ranges {
+		var sum float64
+		for _, c := range r {
+			sum += c.Weight
+			cumulative[i] = append(cumulative[i], sum)
+		}
+	}
+
+	return func(r *rand.Rand, holes [][2]Card) (CardSet, bool) {
+	next:
+		for tries := 0; tries < attempts; tries++ {
+			var used CardSet
+			for i, cum := range cumulative {
+				x := r.Float64() * cum[len(cum)-1]
+				holes[i] = ranges[i][sort.SearchFloat64s(cum, x)].Cards
+				m := comboMask(holes[i])
+				if used&m != 0 {
+					continue next
+				}
+				used |= m
+			}
+			return used, true
+		}
+		return 0, false
+	}, nil
+}
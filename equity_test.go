@@ -0,0 +1,185 @@
+package cactuskev
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func mustParse(t testing.TB, s string) Deck {
+	d, err := ParseDeck(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func holes(t testing.TB, s ...string) [][2]Card {
+	var h [][2]Card
+	for _, s := range s {
+		d := mustParse(t, s)
+		h = append(h, [2]Card{d[0], d[1]})
+	}
+	return h
+}
+
+func TestEquityRiver(t *testing.T) {
+	r, err := Equity(holes(t, "AsAh", "KsKh", "Kd Kc"), mustParse(t, "2c 7d 9h Jc 3s"), nil, EquityOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []PlayerEquity{{1, 0, 1}, {0, 0, 0}, {0, 0, 0}}
+	if r.Runouts != 1 || !r.Exhaustive || !reflect.DeepEqual(r.Players, want) {
+		t.Errorf("unexpected result %+v", r)
+	}
+
+	r, err = Equity(holes(t, "AsKh", "AdKc"), mustParse(t, "2c 7d 9h Jc 3s"), nil, EquityOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want = []PlayerEquity{{0, 1, 0.5}, {0, 1, 0.5}}
+	if !reflect.DeepEqual(r.Players, want) {
+		t.Errorf("unexpected result %+v", r)
+	}
+}
+
+func TestEquityFlop(t *testing.T) {
+	var (
+		hs    = holes(t, "AsAh", "8c9c")
+		board = mustParse(t, "Tc Jc 2d")
+		dead  = mustParse(t, "3h")
+	)
+
+	r, err := Equity(hs, board, dead, EquityOptions{Workers: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Runouts != 44*43/2 || !r.Exhaustive {
+		t.Errorf("expected all 946 runouts, got %d", r.Runouts)
+	}
+
+	// Brute force the same runouts.
+	deck := NewDeck()
+	for _, c := range append(append(mustParse(t, "AsAh8c9c"), board...), dead...) {
+		deck.Remove(c)
+	}
+
+	var share [2]float64
+	for i := range deck {
+		for j := i + 1; j < len(deck); j++ {
+			var s [2]Score
+			for p, h := range hs {
+				s[p], _ = EvalBest(append([]Card{h[0], h[1], deck[i], deck[j]}, board...))
+			}
+			switch {
+			case s[0] == s[1]:
+				share[0] += 0.5
+				share[1] += 0.5
+			case s[1].Less(s[0]):
+				share[0]++
+			default:
+				share[1]++
+			}
+		}
+	}
+
+	for p := range share {
+		if want := share[p] / 946; math.Abs(r.Players[p].Equity-want) > 1e-12 {
+			t.Errorf("player %d: expected equity %v, got %v", p, want, r.Players[p].Equity)
+		}
+	}
+}
+
+func TestEquityMonteCarlo(t *testing.T) {
+	hs := holes(t, "AsAh", "KsKh", "7d8d")
+	opts := EquityOptions{MaxExhaustive: 1, Trials: 50000, Seed: 42, Workers: 1}
+
+	a, err := Equity(hs, nil, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts.Workers = 4
+	b, err := Equity(hs, nil, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.Exhaustive || a.Runouts != 50000 {
+		t.Errorf("expected 50000 random runouts, got %+v", a)
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("same seed gave different results: %+v, %+v", a, b)
+	}
+
+	var sum float64
+	for _, p := range a.Players {
+		sum += p.Equity
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		t.Errorf("equities sum to %v", sum)
+	}
+
+	// AA is about a 2:1 favourite against KK and a suited connector.
+	if e := a.Players[0].Equity; e < 0.6 || e > 0.7 {
+		t.Errorf("unexpected equity for AA: %v", e)
+	}
+}
+
+// TestChunkSeed checks that adjacent seeds do not share the streams of their
+// Monte Carlo chunks, as they would if chunk c of seed s were seeded like
+// chunk c-1 of seed s+1.
+func TestChunkSeed(t *testing.T) {
+	first := make(map[int64]string)
+	for _, seed := range []int64{-1, 0, 1, 2, 42, 43} {
+		for c := 0; c < 10; c++ {
+			v := rand.New(rand.NewSource(chunkSeed(seed, c))).Int63()
+			if prev, ok := first[v]; ok {
+				t.Errorf("seed %d chunk %d repeats the stream of %s", seed, c, prev)
+			}
+			first[v] = fmt.Sprintf("seed %d chunk %d", seed, c)
+		}
+	}
+
+	hs := holes(t, "AsAh", "KsKh")
+	opts := EquityOptions{MaxExhaustive: 1, Trials: 20000, Seed: 42, Workers: 1}
+	a, err := Equity(hs, nil, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts.Seed++
+	b, err := Equity(hs, nil, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(a, b) {
+		t.Errorf("seeds 42 and 43 gave the same result: %+v", a)
+	}
+}
+
+func TestEquityErrors(t *testing.T) {
+	if _, err := Equity(holes(t, "AsAh"), nil, nil, EquityOptions{}); err != ErrPlayers {
+		t.Errorf("expected ErrPlayers, got %v", err)
+	}
+
+	if _, err := Equity(holes(t, "AsAh", "AsKh"), nil, nil, EquityOptions{}); err == nil {
+		t.Errorf("expected error for duplicate card")
+	}
+
+	if _, err := Equity(holes(t, "AsAh", "KsKh"), mustParse(t, "2c 3c 4c 5c 6c 7c"), nil, EquityOptions{}); err == nil {
+		t.Errorf("expected error for six-card board")
+	}
+}
+
+func BenchmarkEquityPreflop(b *testing.B) {
+	hs := holes(b, "AsAh", "KsKh")
+	for i := 0; i < b.N; i++ {
+		Equity(hs, nil, nil, EquityOptions{})
+	}
+}
@@ -0,0 +1,113 @@
+package cactuskev
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math"
+)
+
+var (
+	ErrCommitment = errors.New("server seed does not match commitment")
+	ErrShuffle    = errors.New("deck does not match shuffle")
+)
+
+// A Commitment is the SHA-256 hash of a server seed. The server publishes it
+// before a hand, and reveals the seed after, so that players can check that
+// the seed was not picked once their own seeds were known.
+type Commitment [sha256.Size]byte
+
+// Commit returns the Commitment to serverSeed.
+func Commit(serverSeed []byte) Commitment {
+	return sha256.Sum256(serverSeed)
+}
+
+func (c Commitment) String() string {
+	return hex.EncodeToString(c[:])
+}
+
+// FairShuffler is a Shuffler drawing from a stream of bytes that depends only
+// on a server seed and the seeds of the clients, so that anyone knowing them
+// can repeat a shuffle.
+//
+// Block i of the stream is the HMAC-SHA256, keyed by the server seed, of the
+// client seeds, each preceded by its length as a big-endian uint32, followed
+// by i as a big-endian uint64. Intn(n) reads the next 8 bytes as a big-endian
+// uint64 v, and returns v mod n, unless v falls in the last, incomplete
+// multiple of n below 2^64, in which case it reads the next 8 bytes instead.
+type FairShuffler struct {
+	mac   []byte
+	msg   []byte
+	block uint64
+	buf   []byte
+}
+
+// NewFairShuffler returns a FairShuffler for the seeds, in the given order.
+func NewFairShuffler(serverSeed []byte, clientSeeds ...[]byte) *FairShuffler {
+	f := &FairShuffler{mac: append([]byte(nil), serverSeed...)}
+
+	for _, seed := range clientSeeds {
+		f.msg = binary.BigEndian.AppendUint32(f.msg, uint32(len(seed)))
+		f.msg = append(f.msg, seed...)
+	}
+
+	return f
+}
+
+func (f *FairShuffler) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+
+	limit := math.MaxUint64 - math.MaxUint64%uint64(n)
+	for {
+		if v := f.next(); v < limit {
+			return int(v % uint64(n))
+		}
+	}
+}
+
+func (f *FairShuffler) next() uint64 {
+	if len(f.buf) < 8 {
+		h := hmac.New(sha256.New, f.mac)
+		h.Write(f.msg)
+		h.Write(binary.BigEndian.AppendUint64(nil, f.block))
+		f.buf = h.Sum(f.buf[:0])
+		f.block++
+	}
+
+	v := binary.BigEndian.Uint64(f.buf)
+	f.buf = f.buf[8:]
+	return v
+}
+
+// FairDeck returns a NewDeck shuffled by a FairShuffler for the seeds. As in
+// Deck.Shuffle, for i from 51 down to 1, card i is swapped with card Intn(i+1).
+func FairDeck(serverSeed []byte, clientSeeds ...[]byte) Deck {
+	d := NewDeck()
+	d.Shuffle(NewFairShuffler(serverSeed, clientSeeds...))
+	return d
+}
+
+// VerifyShuffle checks that serverSeed is the one committed to, and that deck
+// is the FairDeck of serverSeed and clientSeeds. It returns ErrCommitment or
+// ErrShuffle if not.
+func VerifyShuffle(serverSeed []byte, clientSeeds [][]byte, commitment Commitment, deck Deck) error {
+	if Commit(serverSeed) != commitment {
+		return ErrCommitment
+	}
+
+	want := FairDeck(serverSeed, clientSeeds...)
+	if len(deck) != len(want) {
+		return ErrShuffle
+	}
+	for i := range want {
+		if deck[i] != want[i] {
+			return ErrShuffle
+		}
+	}
+
+	return nil
+}
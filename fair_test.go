@@ -0,0 +1,65 @@
+package cactuskev
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFairDeck(t *testing.T) {
+	var (
+		server  = []byte("server seed")
+		clients = [][]byte{[]byte("alice"), []byte("bob")}
+	)
+
+	if got, want := Commit(server).String(), "a4e53dc2f480b8fce6fe688b1317658b446299df23ad533394406427c8c19557"; got != want {
+		t.Errorf("expected commitment %s, got %s", want, got)
+	}
+
+	// The deck must not change between versions, or old deals could no
+	// longer be verified.
+	want, err := ParseDeck("2s Qh 8c Ah 6h Td Qs Kc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := FairDeck(server, clients...)
+	for i, c := range want {
+		if d[i] != c {
+			t.Fatalf("expected %v, got %v", want, d[:len(want)])
+		}
+	}
+
+	if err := VerifyShuffle(server, clients, Commit(server), d); err != nil {
+		t.Errorf("deal not verified: %v", err)
+	}
+}
+
+func TestVerifyShuffle(t *testing.T) {
+	var (
+		server     = []byte("server seed")
+		clients    = [][]byte{[]byte("alice"), []byte("bob")}
+		commitment = Commit(server)
+		deck       = FairDeck(server, clients...)
+	)
+
+	swapped := append(Deck(nil), deck...)
+	swapped.Swap(0, 1)
+
+	for _, test := range []struct {
+		name    string
+		server  []byte
+		clients [][]byte
+		deck    Deck
+		err     error
+	}{
+		{"other server seed", []byte("server seed!"), clients, deck, ErrCommitment},
+		{"other client seed", server, [][]byte{[]byte("alice"), []byte("bobby")}, deck, ErrShuffle},
+		{"client seeds reordered", server, [][]byte{[]byte("bob"), []byte("alice")}, deck, ErrShuffle},
+		{"client seeds joined", server, [][]byte{[]byte("alicebob")}, deck, ErrShuffle},
+		{"cards swapped", server, clients, swapped, ErrShuffle},
+		{"card missing", server, clients, deck[1:], ErrShuffle},
+	} {
+		if err := VerifyShuffle(test.server, test.clients, commitment, test.deck); !errors.Is(err, test.err) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.err, err)
+		}
+	}
+}
@@ -3,7 +3,6 @@ package cactuskev
 import (
 	"fmt"
 	"log"
-	"math/rand"
 )
 
 type Hand interface {
@@ -17,14 +16,52 @@ type Hand interface {
 }
 
 func NewHand(n int) Hand {
+	h, err := NewHandErr(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return h
+}
+
+// NewHandErr is like NewHand, but returns an error rather than panicking if
+// hands of n cards are not supported.
+func NewHandErr(n int) (Hand, error) {
 	switch n {
 	case 5:
-		return NewFiveCardHand()
+		return NewFiveCardHand(), nil
+	case 6:
+		return NewSixCardHand(), nil
 	case 7:
-		return NewSevenCardHand()
+		return NewSevenCardHand(), nil
 	default:
-		panic(fmt.Errorf("hand of %d cards not supported", n))
+		return nil, fmt.Errorf("hand of %d cards not supported", n)
+	}
+}
+
+// SetCard is a checked h.SetCard(n, c). It returns an error if n is out of
+// range for h or c is not a valid card.
+func SetCard(h Hand, n int, c Card) error {
+	if n < 0 || n >= h.Len() {
+		return fmt.Errorf("%w: %d", ErrIndex, n)
+	}
+
+	if !c.Valid() {
+		return fmt.Errorf("%w: %v", ErrCard, c)
+	}
+
+	h.SetCard(n, c)
+	return nil
+}
+
+// GetCard is a checked h.Card(n). It returns an error if n is out of range
+// for h.
+func GetCard(h Hand, n int) (Card, error) {
+	if n < 0 || n >= h.Len() {
+		return 0, fmt.Errorf("%w: %d", ErrIndex, n)
 	}
+
+	return h.Card(n), nil
 }
 
 type FiveCardHand struct {
@@ -38,7 +75,34 @@ func (h *FiveCardHand) IsSuited() bool {
 	return h.A&h.B&h.C&h.D&h.E& 0xf000 != 0
 }
 
+// Eval scores the hand. A Joker is wild, and scores as with Wild.Eval.
 func (h *FiveCardHand) Eval() Score {
+	if h.hasJoker() {
+		return h.evalWild()
+	}
+
+	// Flushes and Straight Flushes
+	if h.IsSuited() {
+		return Flushes[h.Bit()]
+	}
+
+	// Straights and High Cards
+	if s := Unique5[h.Bit()]; s != 0 {
+		return s
+	}
+
+	// and others...
+	return findFast(uint32((h.A & 0xff) * (h.B & 0xff) * (h.C & 0xff) * (h.D & 0xff) * (h.E & 0xff)))
+}
+
+// EvalSearch is Eval using Cactus Kev's original binary search over products,
+// rather than a perfect hash, for hands that are neither flushes nor made of
+// five unique ranks.
+func (h *FiveCardHand) EvalSearch() Score {
+	if h.hasJoker() {
+		return h.evalWild()
+	}
+
 	// Flushes and Straight Flushes
 	if h.IsSuited() {
 		return Flushes[h.Bit()]
@@ -64,6 +128,23 @@ func (h *FiveCardHand) Eval() Score {
 	}
 }
 
+func (h *FiveCardHand) hasJoker() bool {
+	return (h.A|h.B|h.C|h.D|h.E)&Joker != 0
+}
+
+// evalWild scores a hand holding a Joker, which the plain lookup tables
+// cannot index.
+func (h *FiveCardHand) evalWild() Score {
+	s, _, _ := Wild{}.eval([5]Card{h.A, h.B, h.C, h.D, h.E})
+	return s
+}
+
+// EvalDetailed is like Eval, but also returns the five cards that make the
+// hand and the (always empty) cards that do not play.
+func (h *FiveCardHand) EvalDetailed() (Score, [5]Card, []Card) {
+	return h.Eval(), [...]Card{h.A, h.B, h.C, h.D, h.E}, []Card{}
+}
+
 func (h *FiveCardHand) SetCard(n int, c Card) {
 	switch n {
 	case 0:
@@ -117,6 +198,88 @@ func (h *FiveCardHand) String() string {
 	return fmt.Sprintf("[%v %v %v %v %v]", h.A, h.B, h.C, h.D, h.E)
 }
 
+type SixCardHand struct{ A, B, C, D, E, F Card }
+
+func NewSixCardHand() *SixCardHand { return new(SixCardHand) }
+
+func (h *SixCardHand) Eval() Score {
+	s, _ := EvalBest([]Card{h.A, h.B, h.C, h.D, h.E, h.F})
+	return s
+}
+
+// EvalDetailed is like Eval, but also returns the five cards that make the
+// hand and the cards that do not play.
+func (h *SixCardHand) EvalDetailed() (Score, [5]Card, []Card) {
+	return evalDetailed(h.Cards())
+}
+
+func (h *SixCardHand) SetCard(n int, c Card) {
+	switch n {
+	case 0:
+		h.A = c
+	case 1:
+		h.B = c
+	case 2:
+		h.C = c
+	case 3:
+		h.D = c
+	case 4:
+		h.E = c
+	case 5:
+		h.F = c
+	default:
+		log.Panicf("index overflow: %d", n)
+	}
+}
+
+func (h *SixCardHand) Card(n int) Card {
+	switch n {
+	case 0:
+		return h.A
+	case 1:
+		return h.B
+	case 2:
+		return h.C
+	case 3:
+		return h.D
+	case 4:
+		return h.E
+	case 5:
+		return h.F
+	default:
+		log.Panicf("index overflow: %d", n)
+		return 0
+	}
+}
+
+func (h SixCardHand) Len() int { return 6 }
+
+func (h *SixCardHand) Cards() []Card {
+	return []Card{h.A, h.B, h.C, h.D, h.E, h.F}
+}
+
+func (h *SixCardHand) Prime() int {
+	return h.A.Prime() *
+		h.B.Prime() *
+		h.C.Prime() *
+		h.D.Prime() *
+		h.E.Prime() *
+		h.F.Prime()
+}
+
+func (h *SixCardHand) Bit() int {
+	return h.A.Bit() |
+		h.B.Bit() |
+		h.C.Bit() |
+		h.D.Bit() |
+		h.E.Bit() |
+		h.F.Bit()
+}
+
+func (h *SixCardHand) String() string {
+	return fmt.Sprintf("[%v %v %v %v %v %v]", h.A, h.B, h.C, h.D, h.E, h.F)
+}
+
 type SevenCardHand struct{ A, B, C, D, E, F, G Card }
 
 func NewSevenCardHand() *SevenCardHand { return new(SevenCardHand) }
@@ -142,6 +305,12 @@ func (h *SevenCardHand) Eval() Score {
 	return best
 }
 
+// EvalDetailed is like Eval, but also returns the five cards that make the
+// hand and the cards that do not play.
+func (h *SevenCardHand) EvalDetailed() (Score, [5]Card, []Card) {
+	return evalDetailed(h.Cards())
+}
+
 func (h *SevenCardHand) SetCard(n int, c Card) {
 	switch n {
 	case 0:
@@ -252,16 +421,24 @@ func RandomHand(n int) Hand {
 	return h
 }
 
+// RandomHandFrom is like RandomHand, but deals from a deck shuffled by s.
+func RandomHandFrom(n int, s Shuffler) Hand {
+	h := NewHand(n)
+	RandomizeHandFrom(h, s)
+	return h
+}
+
 func RandomizeHand(h Hand) {
-	d := NewDeck()
+	RandomizeHandFrom(h, globalShuffler{})
+}
 
-	for i, j := range rand.Perm(d.Len()) {
-		d.Swap(i, j)
-	}
+// RandomizeHandFrom is like RandomizeHand, but deals from a deck shuffled by
+// s.
+func RandomizeHandFrom(h Hand, s Shuffler) {
+	d := NewDeck()
+	d.Shuffle(s)
 
 	for i := 0; i < h.Len(); i++ {
-		c := d[i]
-		d = d[1:]
-		h.SetCard(i, c)
+		h.SetCard(i, d[i])
 	}
 }
@@ -0,0 +1,79 @@
+package cactuskev
+
+import (
+	"sort"
+)
+
+// The prime products of five-card hands that are neither flushes nor made of
+// five unique ranks are looked up by Paul Senzee's perfect hash, see
+// http://senzee.blogspot.com/2006/06/some-perfect-hash.html. Rather than
+// being copied, the adjustment table is searched for when the package is
+// initialized.
+var hashAdjust, hashValues = perfectHash()
+
+func senzee(u uint32) (a, b uint32) {
+	u += 0xe91aaa35
+	u ^= u >> 16
+	u += u << 8
+	u ^= u >> 4
+	return (u + (u << 2)) >> 19, (u >> 8) & 0x1ff
+}
+
+// findFast returns the Score of the hand with prime product u.
+func findFast(u uint32) Score {
+	a, b := senzee(u)
+	return hashValues[a^uint32(hashAdjust[b])]
+}
+
+// perfectHash picks an adjustment for each of the 512 buckets senzee sorts
+// products into, such that every product of the bucket lands on a free slot
+// of the 8192 entry table. The fullest buckets are placed first.
+func perfectHash() (adjust [512]uint16, table [8192]Score) {
+	var (
+		buckets [512][]uint32
+		index   = make(map[uint32]int, len(products))
+		used    [8192]bool
+	)
+
+	for i, p := range products {
+		a, b := senzee(uint32(p))
+		buckets[b] = append(buckets[b], a)
+		index[a<<9|b] = i
+	}
+
+	order := make([]int, len(buckets))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(buckets[order[i]]) > len(buckets[order[j]])
+	})
+
+next:
+	for _, b := range order {
+	search:
+		for adj := uint32(0); adj < uint32(len(table)); adj++ {
+			for i, a := range buckets[b] {
+				if used[a^adj] {
+					continue search
+				}
+				for _, prev := range buckets[b][:i] {
+					if prev == a {
+						continue search
+					}
+				}
+			}
+
+			for _, a := range buckets[b] {
+				used[a^adj] = true
+				table[a^adj] = values[index[a<<9|uint32(b)]]
+			}
+			adjust[b] = uint16(adj)
+			continue next
+		}
+
+		panic("cactuskev: no perfect hash for products")
+	}
+
+	return adjust, table
+}
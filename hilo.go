@@ -0,0 +1,152 @@
+package cactuskev
+
+import (
+	"fmt"
+)
+
+// HiLo is a hand of a high-low split game, such as Omaha Hi-Lo or Stud
+// Hi-Lo, where the best high hand and the best Ace-to-Five low of eight or
+// better each win half the pot.
+type HiLo struct {
+	High      Score
+	HighCards [5]Card
+
+	// Low and LowCards are set only if the hand has a low of eight or
+	// better, which Qualified reports.
+	Low       AceToFiveScore
+	LowCards  [5]Card
+	Qualified bool
+}
+
+// EightOrBetter reports whether s has no pair and no card above an eight,
+// which a low hand needs to qualify in high-low split games.
+func (s AceToFiveScore) EightOrBetter() bool {
+	return s >= 1 && s <= 56
+}
+
+// EvalHiLo evaluates every five-card combination of 5 to 9 cards, as in
+// Stud Hi-Lo, and returns the best high hand and the best qualifying low.
+func EvalHiLo(cards []Card) HiLo {
+	var hl HiLo
+
+	hl.High, hl.HighCards = EvalBest(cards)
+	if low, five := EvalRazz(cards); low.EightOrBetter() {
+		hl.Low, hl.LowCards, hl.Qualified = low, five, true
+	}
+
+	return hl
+}
+
+// EvalOmahaHiLo returns the best high hand and the best qualifying low of
+// Omaha Hi-Lo, each made of exactly two of 4 to 6 hole cards and exactly
+// three of 3 to 5 board cards. The two halves may use different hole cards.
+// Like EvalOmaha, it panics for other numbers of cards.
+func EvalOmahaHiLo(hole, board []Card) HiLo {
+	var (
+		hl    HiLo
+		h     FiveCardHand
+		low   = AceToFiveScore(9999)
+		cards [11]Card
+	)
+
+	hl.High, hl.HighCards = EvalOmahaBest(hole, board)
+
+	cards = omahaCards(hole, board)
+	for _, c := range omahaCombinations[len(hole)][len(board)] {
+		h.A, h.B, h.C, h.D, h.E = cards[c[0]], cards[c[1]], cards[c[2]], cards[c[3]], cards[c[4]]
+
+		if q := h.EvalAceToFive(); low.Less(q) {
+			low, hl.LowCards = q, [5]Card{h.A, h.B, h.C, h.D, h.E}
+		}
+	}
+
+	if hl.Qualified = low.EightOrBetter(); hl.Qualified {
+		hl.Low = low
+	} else {
+		hl.LowCards = [5]Card{}
+	}
+
+	return hl
+}
+
+// Split is how a pot is divided among the hands at showdown.
+type Split struct {
+	// Shares holds the chips won by each hand.
+	Shares []int
+
+	// Scoop is the index of the hand that won the whole pot, or -1 if the
+	// pot was shared.
+	Scoop int
+}
+
+// SplitPot divides pot chips among the hands at showdown. The hands are
+// given in seat order, and first is the index of the first hand left of the
+// button.
+//
+// Half the pot goes to the best high hands and half to the best qualifying
+// lows; the whole pot goes to the best high hands when no low qualifies.
+// Each half is split evenly among the hands tying for it, so a hand sharing
+// one half and winning the other outright is quartered. When a pot does not
+// divide evenly, the odd chip goes to the high half, and within a half, to
+// the tying hands nearest left of the button.
+func SplitPot(pot int, hands []HiLo, first int) Split {
+	if len(hands) == 0 || pot < 0 || first < 0 || first >= len(hands) {
+		panic(fmt.Errorf("cannot split pot of %d among %d hands from %d", pot, len(hands), first))
+	}
+
+	var (
+		split   = Split{Shares: make([]int, len(hands)), Scoop: -1}
+		highs   []int
+		lows    []int
+		best    = Score(9999)
+		bestLow = AceToFiveScore(9999)
+	)
+
+	// Walk the hands from the button, so that winners are listed in the
+	// order odd chips are handed out.
+	for n := 0; n < len(hands); n++ {
+		i := (first + n) % len(hands)
+		h := hands[i]
+
+		switch {
+		case h.High == best:
+			highs = append(highs, i)
+		case best.Less(h.High):
+			best, highs = h.High, []int{i}
+		}
+
+		if !h.Qualified {
+			continue
+		}
+		switch {
+		case h.Low == bestLow:
+			lows = append(lows, i)
+		case bestLow.Less(h.Low):
+			bestLow, lows = h.Low, []int{i}
+		}
+	}
+
+	high := pot
+	if len(lows) > 0 {
+		high = pot - pot/2
+		share(split.Shares, pot/2, lows)
+	}
+	share(split.Shares, high, highs)
+
+	if len(highs) == 1 && (len(lows) == 0 || len(lows) == 1 && lows[0] == highs[0]) {
+		split.Scoop = highs[0]
+	}
+
+	return split
+}
+
+// share splits chips evenly among winners, giving what is left over one
+// chip at a time to the first winners.
+func share(shares []int, chips int, winners []int) {
+	for n, i := range winners {
+		shares[i] += chips / len(winners)
+		if n < chips%len(winners) {
+			shares[i]++
+		}
+	}
+}
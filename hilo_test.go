@@ -0,0 +1,86 @@
+package cactuskev
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEvalHiLo(t *testing.T) {
+	hl := EvalHiLo(mustParse(t, "Ah 2h 3c 4d 5s Kh 9h"))
+	if hl.High != 1609 || !hl.Qualified || hl.Low != 1 {
+		t.Errorf("expected a wheel both ways, got %+v", hl)
+	}
+
+	hl = EvalHiLo(mustParse(t, "Ah Ad 9c 9d Ks Kh Th"))
+	if hl.High.Category() != TwoPair || hl.Qualified || hl.Low != 0 {
+		t.Errorf("expected two pair and no low, got %+v", hl)
+	}
+
+	if s := mustParseFive(t, "8c 7d 5h 4s 2c").EvalAceToFive(); !s.EightOrBetter() {
+		t.Errorf("expected %v to qualify", s)
+	}
+	if s := mustParseFive(t, "9c 4d 3h 2s Ac").EvalAceToFive(); s.EightOrBetter() {
+		t.Errorf("expected %v not to qualify", s)
+	}
+}
+
+func TestEvalOmahaHiLo(t *testing.T) {
+	// Three low cards on the board qualify only with two more in hand.
+	board := mustParse(t, "2c 5d 7h Kc Ks")
+
+	hl := EvalOmahaHiLo(mustParse(t, "Ah 3s Kd Qd"), board)
+	if !hl.Qualified || hl.Low.Category() != Number7 {
+		t.Errorf("expected a seven low, got %+v", hl)
+	}
+	if hl.High.Category() != ThreeOfAKind {
+		t.Errorf("expected three kings, got %v", hl.High)
+	}
+
+	hl = EvalOmahaHiLo(mustParse(t, "Ah Kd Qd Jd"), board)
+	if hl.Qualified {
+		t.Errorf("expected no low with one low card in hand, got %+v", hl)
+	}
+}
+
+func TestSplitPot(t *testing.T) {
+	var (
+		high  = func(s Score) HiLo { return HiLo{High: s} }
+		hilo  = func(s Score, l AceToFiveScore) HiLo { return HiLo{High: s, Low: l, Qualified: true} }
+		split = func(shares ...int) []int { return shares }
+	)
+
+	for _, tc := range []struct {
+		name   string
+		pot    int
+		hands  []HiLo
+		first  int
+		shares []int
+		scoop  int
+	}{
+		{"high only", 100, []HiLo{high(2000), high(1000)}, 0, split(0, 100), 1},
+		{"split", 100, []HiLo{high(1000), hilo(2000, 10)}, 0, split(50, 50), -1},
+		{"scoop", 100, []HiLo{hilo(1000, 10), hilo(2000, 20)}, 0, split(100, 0), 0},
+		{"odd chip high", 101, []HiLo{high(1000), hilo(2000, 10)}, 0, split(51, 50), -1},
+		{"quartered", 100, []HiLo{hilo(1000, 10), hilo(2000, 10)}, 0, split(75, 25), -1},
+		{"quartered odd", 102, []HiLo{hilo(1000, 10), hilo(2000, 10)}, 0, split(77, 25), -1},
+		{"tied high", 100, []HiLo{high(1000), high(1000), high(2000)}, 0, split(50, 50, 0), -1},
+		// Odd chips go to the tying hands nearest left of the button.
+		{"odd chip seat", 101, []HiLo{high(1000), high(1000), high(1000)}, 0, split(34, 34, 33), -1},
+		{"odd chip wraps", 101, []HiLo{high(1000), high(1000), high(1000)}, 2, split(34, 33, 34), -1},
+		{"odd low chip", 103, []HiLo{hilo(500, 30), hilo(1000, 20), hilo(2000, 20)}, 2, split(52, 25, 26), -1},
+	} {
+		s := SplitPot(tc.pot, tc.hands, tc.first)
+
+		if !reflect.DeepEqual(s.Shares, tc.shares) || s.Scoop != tc.scoop {
+			t.Errorf("%s: expected %v scoop %d, got %v scoop %d", tc.name, tc.shares, tc.scoop, s.Shares, s.Scoop)
+		}
+
+		var sum int
+		for _, c := range s.Shares {
+			sum += c
+		}
+		if sum != tc.pot {
+			t.Errorf("%s: shares add up to %d, expected %d", tc.name, sum, tc.pot)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package cactuskev
+
+// Ranks returns the distinct ranks of the hands scoring s, most significant
+// first, e.g. the rank of the pair followed by the kickers. The ace of a
+// five-high straight comes last.
+func (s Score) Ranks() []Rank {
+	if s >= -13 && s < 0 {
+		return []Rank{Rank(-1 - s)}
+	}
+	if s < 1 || int(s) >= len(scoreRanks) {
+		return nil
+	}
+
+	return append([]Rank(nil), scoreRanks[s]...)
+}
+
+// groupSizes are the number of cards of each of the ranks of a Category, in
+// the order returned by Score.Ranks.
+var groupSizes = [...][]int{
+	StraightFlush: {1, 1, 1, 1, 1},
+	FourOfAKind:   {4, 1},
+	FullHouse:     {3, 2},
+	Flush:         {1, 1, 1, 1, 1},
+	Straight:      {1, 1, 1, 1, 1},
+	ThreeOfAKind:  {3, 1, 1},
+	TwoPair:       {2, 2, 1},
+	OnePair:       {2, 1, 1, 1},
+	HighCard:      {1, 1, 1, 1, 1},
+	FiveOfAKind:   {5},
+}
+
+// Equivalents returns a representative of the five-card hands scoring s; for
+// five of a kind, four cards and a Joker. It returns five zero cards if s is
+// not a valid score.
+func (s Score) Equivalents() [5]Card {
+	var hand [5]Card
+
+	ranks := s.Ranks()
+	if ranks == nil {
+		return hand
+	}
+
+	c := s.Category()
+	i := 0
+	for j, n := range groupSizes[c] {
+		for ; n > 0; n-- {
+			suit := suits[i%len(suits)]
+			if c == StraightFlush || c == Flush {
+				suit = Spade
+			}
+
+			hand[i] = NewCard(suit, ranks[j])
+			i++
+		}
+	}
+
+	if c == FiveOfAKind {
+		hand[4] = Joker
+	}
+
+	return hand
+}
+
+// Count returns the number of five-card hands that score s, without wild
+// cards.
+func (s Score) Count() int {
+	if s < 1 || int(s) >= len(scoreRanks) {
+		return 0
+	}
+
+	switch s.Category() {
+	case StraightFlush, Flush:
+		return 4
+	case FourOfAKind:
+		return 4
+	case FullHouse:
+		return 4 * 6
+	case ThreeOfAKind:
+		return 4 * 4 * 4
+	case TwoPair:
+		return 6 * 6 * 4
+	case OnePair:
+		return 6 * 4 * 4 * 4
+	default: // Straight, HighCard: any suits but a flush
+		return 4*4*4*4*4 - 4
+	}
+}
+
+// Percentile returns the share of all 2,598,960 five-card hands that s beats.
+func (s Score) Percentile() float64 {
+	if s.Category() == FiveOfAKind && s >= -13 {
+		return 1
+	}
+	if s < 1 || int(s) >= len(scoreRanks) {
+		return 0
+	}
+
+	return float64(worse[s]) / 2598960
+}
+
+// worse[s] is the number of five-card hands that score worse than s.
+var worse = func() (t [7463]int) {
+	for s := len(t) - 2; s > 0; s-- {
+		t[s] = t[s+1] + Score(s+1).Count()
+	}
+	return t
+}()
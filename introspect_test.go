@@ -0,0 +1,76 @@
+package cactuskev
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScoreRanks(t *testing.T) {
+	tests := []struct {
+		hand  string
+		ranks []Rank
+	}{
+		{"Js Jh Ad Kc 9s", []Rank{Jack, Ace, King, Nine}},
+		{"4s 4h Jd Jc 9s", []Rank{Jack, Four, Nine}},
+		{"2s 2h 2d Ac Ah", []Rank{Deuce, Ace}},
+		{"5s 4h 3d 2c As", []Rank{Five, Four, Trey, Deuce, Ace}},
+		{"As Kh 9d 6c 4s", []Rank{Ace, King, Nine, Six, Four}},
+	}
+
+	for _, test := range tests {
+		h, err := ParseHand(test.hand)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r := h.Eval().Ranks(); !reflect.DeepEqual(r, test.ranks) {
+			t.Errorf("%s: expected %v, got %v", test.hand, test.ranks, r)
+		}
+	}
+
+	if r := Score(0).Ranks(); r != nil {
+		t.Errorf("expected no ranks for invalid score, got %v", r)
+	}
+}
+
+func TestEquivalents(t *testing.T) {
+	var total int
+
+	for s := Score(1); s <= 7462; s++ {
+		h := NewFiveCardHand()
+		for i, c := range s.Equivalents() {
+			h.SetCard(i, c)
+		}
+
+		if q := h.Eval(); q != s {
+			t.Errorf("%v: representative %v scores %v", s, h, q)
+		}
+
+		total += s.Count()
+	}
+
+	if total != 2598960 {
+		t.Errorf("expected 2598960 hands, counted %d", total)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	if p := Score(7462).Percentile(); p != 0 {
+		t.Errorf("expected worst hand to beat nothing, got %v", p)
+	}
+
+	if p := Score(1).Percentile(); p != float64(2598960-4)/2598960 {
+		t.Errorf("unexpected royal flush percentile %v", p)
+	}
+
+	// A pair of twos beats every high card hand.
+	if p := Score(6185).Percentile(); p != 1302540.0/2598960 {
+		t.Errorf("unexpected percentile %v", p)
+	}
+
+	for s := Score(1); s < 7462; s++ {
+		if s.Percentile() <= (s + 1).Percentile() {
+			t.Errorf("%v: percentile not above %v", s, s+1)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package cactuskev
+
+import (
+	"fmt"
+	"sort"
+)
+
+// AceToFiveScore ranks a lowball hand where aces are low and straights and
+// flushes do not count, from 1 for 5-4-3-2-A to 6175 for four kings.
+type AceToFiveScore int16
+
+// DeuceToSevenScore ranks a lowball hand where aces are high and straights
+// and flushes count against the hand, from 1 for 7-5-4-3-2 to 7462 for a
+// royal flush.
+type DeuceToSevenScore int16
+
+// Less reports whether s is a worse low than other.
+func (s AceToFiveScore) Less(other AceToFiveScore) bool {
+	return s > other
+}
+
+func (s AceToFiveScore) Category() LowCategory {
+	switch {
+	case s > 6019:
+		return LowFourOfAKind
+	case s > 5863:
+		return LowFullHouse
+	case s > 5005:
+		return LowThreeOfAKind
+	case s > 4147:
+		return LowTwoPair
+	case s > 1287:
+		return LowOnePair
+	case s > 792:
+		return NumberK
+	case s > 462:
+		return NumberQ
+	case s > 252:
+		return NumberJ
+	case s > 126:
+		return NumberT
+	case s > 56:
+		return Number9
+	case s > 21:
+		return Number8
+	case s > 6:
+		return Number7
+	case s > 1:
+		return Number6
+	default:
+		return Wheel
+	}
+}
+
+func (s AceToFiveScore) String() string {
+	return fmt.Sprintf("%v(%d)", s.Category(), s)
+}
+
+// Less reports whether s is a worse low than other.
+func (s DeuceToSevenScore) Less(other DeuceToSevenScore) bool {
+	return s > other
+}
+
+func (s DeuceToSevenScore) Category() LowCategory {
+	switch {
+	case s > 7453:
+		return LowStraightFlush
+	case s > 7297:
+		return LowFourOfAKind
+	case s > 7141:
+		return LowFullHouse
+	case s > 5863:
+		return LowFlush
+	case s > 5854:
+		return LowStraight
+	case s > 4996:
+		return LowThreeOfAKind
+	case s > 4138:
+		return LowTwoPair
+	case s > 1278:
+		return LowOnePair
+	case s > 784:
+		return NumberA
+	case s > 455:
+		return NumberK
+	case s > 246:
+		return NumberQ
+	case s > 121:
+		return NumberJ
+	case s > 52:
+		return NumberT
+	case s > 18:
+		return Number9
+	case s > 4:
+		return Number8
+	default:
+		return Number7
+	}
+}
+
+func (s DeuceToSevenScore) String() string {
+	return fmt.Sprintf("%v(%d)", s.Category(), s)
+}
+
+// LowCategory is the category of a lowball hand, best first. Hands without
+// a pair are named by their highest card; Ace-to-Five has no straights or
+// flushes, and Deuce-to-Seven no Wheel or Number6.
+type LowCategory int
+
+const (
+	Wheel LowCategory = iota
+	Number6
+	Number7
+	Number8
+	Number9
+	NumberT
+	NumberJ
+	NumberQ
+	NumberK
+	NumberA
+	LowOnePair
+	LowTwoPair
+	LowThreeOfAKind
+	LowStraight
+	LowFlush
+	LowFullHouse
+	LowFourOfAKind
+	LowStraightFlush
+)
+
+func (c LowCategory) String() string {
+	switch c {
+	case Wheel:
+		return "Wheel"
+	case Number6:
+		return "Number 6"
+	case Number7:
+		return "Number 7"
+	case Number8:
+		return "Number 8"
+	case Number9:
+		return "Number 9"
+	case NumberT:
+		return "Number T"
+	case NumberJ:
+		return "Number J"
+	case NumberQ:
+		return "Number Q"
+	case NumberK:
+		return "Number K"
+	case NumberA:
+		return "Number A"
+	case LowOnePair:
+		return "One Pair"
+	case LowTwoPair:
+		return "Two Pair"
+	case LowThreeOfAKind:
+		return "Three of a Kind"
+	case LowStraight:
+		return "Straight"
+	case LowFlush:
+		return "Flush"
+	case LowFullHouse:
+		return "Full House"
+	case LowFourOfAKind:
+		return "Four of a Kind"
+	case LowStraightFlush:
+		return "Straight Flush"
+	default:
+		return fmt.Sprintf("invalid(0x%x)", int(c))
+	}
+}
+
+// EvalAceToFive returns the Ace-to-Five low of the hand.
+func (h *FiveCardHand) EvalAceToFive() AceToFiveScore {
+	if s := AceToFive5[h.Bit()]; s != 0 {
+		return s
+	}
+
+	return aceToFiveValues[h.productIndex()]
+}
+
+// EvalDeuceToSeven returns the Deuce-to-Seven low of the hand.
+func (h *FiveCardHand) EvalDeuceToSeven() DeuceToSevenScore {
+	if h.IsSuited() {
+		return DeuceToSevenFlushes[h.Bit()]
+	}
+
+	if s := DeuceToSeven5[h.Bit()]; s != 0 {
+		return s
+	}
+
+	return deuceToSevenValues[h.productIndex()]
+}
+
+// productIndex returns the index into products of a hand with a pair or
+// more.
+func (h *FiveCardHand) productIndex() int {
+	return sort.SearchInts(products[:], int((h.A&0xff)*(h.B&0xff)*(h.C&0xff)*(h.D&0xff)*(h.E&0xff)))
+}
+
+// EvalRazz evaluates every five-card combination of 5 to 9 cards, and returns
+// the best Ace-to-Five low along with the five cards that make it.
+func EvalRazz(cards []Card) (AceToFiveScore, [5]Card) {
+	if n := len(cards); n < 5 || n >= len(combinations) {
+		panic(fmt.Errorf("hand of %d cards not supported", n))
+	}
+
+	var (
+		h    FiveCardHand
+		best = AceToFiveScore(9999)
+		five [5]Card
+	)
+
+	for _, c := range combinations[len(cards)] {
+		h.A, h.B, h.C, h.D, h.E = cards[c[0]], cards[c[1]], cards[c[2]], cards[c[3]], cards[c[4]]
+
+		if q := h.EvalAceToFive(); best.Less(q) {
+			best, five = q, [5]Card{h.A, h.B, h.C, h.D, h.E}
+		}
+	}
+
+	return best, five
+}
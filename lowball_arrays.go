@@ -0,0 +1,2147 @@
+// Code generated by "go run maketables.go -tables lowball"; DO NOT EDIT.
+
+package cactuskev
+
+// Ace-to-Five lowball: hands of five unique ranks, flushes or not, by rank
+// bits, and other hands in the order of products.
+var AceToFive5 = [...]AceToFiveScore{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 6, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 11, 0, 0, 0, 0, 0, 0, 0, 15, 0, 0, 0, 18, 0, 20, 21, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 26, 0, 0, 0, 0, 0,
+	0, 0, 30, 0, 0, 0, 33, 0, 35, 36, 0, 0, 0, 0, 0, 0, 0, 0, 40, 0,
+	0, 0, 43, 0, 45, 46, 0, 0, 0, 0, 49, 0, 51, 52, 0, 0, 54, 55, 0,
+	56, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 61, 0,
+	0, 0, 0, 0, 0, 0, 65, 0, 0, 0, 68, 0, 70, 71, 0, 0, 0, 0, 0, 0,
+	0, 0, 75, 0, 0, 0, 78, 0, 80, 81, 0, 0, 0, 0, 84, 0, 86, 87, 0,
+	0, 89, 90, 0, 91, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 95, 0, 0, 0, 98,
+	0, 100, 101, 0, 0, 0, 0, 104, 0, 106, 107, 0, 0, 109, 110, 0,
+	111, 0, 0, 0, 0, 0, 0, 114, 0, 116, 117, 0, 0, 119, 120, 0, 121,
+	0, 0, 0, 0, 123, 124, 0, 125, 0, 0, 0, 126, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 131, 0, 0, 0, 0, 0,
+	0, 0, 135, 0, 0, 0, 138, 0, 140, 141, 0, 0, 0, 0, 0, 0, 0, 0,
+	145, 0, 0, 0, 148, 0, 150, 151, 0, 0, 0, 0, 154, 0, 156, 157, 0,
+	0, 159, 160, 0, 161, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 165, 0, 0, 0,
+	168, 0, 170, 171, 0, 0, 0, 0, 174, 0, 176, 177, 0, 0, 179, 180,
+	0, 181, 0, 0, 0, 0, 0, 0, 184, 0, 186, 187, 0, 0, 189, 190, 0,
+	191, 0, 0, 0, 0, 193, 194, 0, 195, 0, 0, 0, 196, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 200, 0, 0, 0, 203, 0, 205, 206, 0, 0,
+	0, 0, 209, 0, 211, 212, 0, 0, 214, 215, 0, 216, 0, 0, 0, 0, 0, 0,
+	219, 0, 221, 222, 0, 0, 224, 225, 0, 226, 0, 0, 0, 0, 228, 229,
+	0, 230, 0, 0, 0, 231, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 234, 0, 236,
+	237, 0, 0, 239, 240, 0, 241, 0, 0, 0, 0, 243, 244, 0, 245, 0, 0,
+	0, 246, 0, 0, 0, 0, 0, 0, 0, 0, 248, 249, 0, 250, 0, 0, 0, 251,
+	0, 0, 0, 0, 0, 0, 0, 252, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 257, 0, 0, 0,
+	0, 0, 0, 0, 261, 0, 0, 0, 264, 0, 266, 267, 0, 0, 0, 0, 0, 0, 0,
+	0, 271, 0, 0, 0, 274, 0, 276, 277, 0, 0, 0, 0, 280, 0, 282, 283,
+	0, 0, 285, 286, 0, 287, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 291, 0, 0,
+	0, 294, 0, 296, 297, 0, 0, 0, 0, 300, 0, 302, 303, 0, 0, 305,
+	306, 0, 307, 0, 0, 0, 0, 0, 0, 310, 0, 312, 313, 0, 0, 315, 316,
+	0, 317, 0, 0, 0, 0, 319, 320, 0, 321, 0, 0, 0, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 326, 0, 0, 0, 329, 0, 331, 332, 0,
+	0, 0, 0, 335, 0, 337, 338, 0, 0, 340, 341, 0, 342, 0, 0, 0, 0, 0,
+	0, 345, 0, 347, 348, 0, 0, 350, 351, 0, 352, 0, 0, 0, 0, 354,
+	355, 0, 356, 0, 0, 0, 357, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 360, 0,
+	362, 363, 0, 0, 365, 366, 0, 367, 0, 0, 0, 0, 369, 370, 0, 371,
+	0, 0, 0, 372, 0, 0, 0, 0, 0, 0, 0, 0, 374, 375, 0, 376, 0, 0, 0,
+	377, 0, 0, 0, 0, 0, 0, 0, 378, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 382, 0, 0, 0, 385, 0, 387, 388,
+	0, 0, 0, 0, 391, 0, 393, 394, 0, 0, 396, 397, 0, 398, 0, 0, 0, 0,
+	0, 0, 401, 0, 403, 404, 0, 0, 406, 407, 0, 408, 0, 0, 0, 0, 410,
+	411, 0, 412, 0, 0, 0, 413, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 416, 0,
+	418, 419, 0, 0, 421, 422, 0, 423, 0, 0, 0, 0, 425, 426, 0, 427,
+	0, 0, 0, 428, 0, 0, 0, 0, 0, 0, 0, 0, 430, 431, 0, 432, 0, 0, 0,
+	433, 0, 0, 0, 0, 0, 0, 0, 434, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 437, 0, 439, 440, 0, 0, 442, 443, 0, 444, 0,
+	0, 0, 0, 446, 447, 0, 448, 0, 0, 0, 449, 0, 0, 0, 0, 0, 0, 0, 0,
+	451, 452, 0, 453, 0, 0, 0, 454, 0, 0, 0, 0, 0, 0, 0, 455, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 457, 458, 0, 459, 0, 0,
+	0, 460, 0, 0, 0, 0, 0, 0, 0, 461, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 462, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 467, 0, 0, 0, 0, 0, 0, 0, 471, 0, 0,
+	0, 474, 0, 476, 477, 0, 0, 0, 0, 0, 0, 0, 0, 481, 0, 0, 0, 484,
+	0, 486, 487, 0, 0, 0, 0, 490, 0, 492, 493, 0, 0, 495, 496, 0,
+	497, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 501, 0, 0, 0, 504, 0, 506,
+	507, 0, 0, 0, 0, 510, 0, 512, 513, 0, 0, 515, 516, 0, 517, 0, 0,
+	0, 0, 0, 0, 520, 0, 522, 523, 0, 0, 525, 526, 0, 527, 0, 0, 0, 0,
+	529, 530, 0, 531, 0, 0, 0, 532, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 536, 0, 0, 0, 539, 0, 541, 542, 0, 0, 0, 0, 545, 0, 547,
+	548, 0, 0, 550, 551, 0, 552, 0, 0, 0, 0, 0, 0, 555, 0, 557, 558,
+	0, 0, 560, 561, 0, 562, 0, 0, 0, 0, 564, 565, 0, 566, 0, 0, 0,
+	567, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 570, 0, 572, 573, 0, 0, 575,
+	576, 0, 577, 0, 0, 0, 0, 579, 580, 0, 581, 0, 0, 0, 582, 0, 0, 0,
+	0, 0, 0, 0, 0, 584, 585, 0, 586, 0, 0, 0, 587, 0, 0, 0, 0, 0, 0,
+	0, 588, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 592, 0, 0, 0, 595, 0, 597, 598, 0, 0, 0, 0, 601, 0, 603,
+	604, 0, 0, 606, 607, 0, 608, 0, 0, 0, 0, 0, 0, 611, 0, 613, 614,
+	0, 0, 616, 617, 0, 618, 0, 0, 0, 0, 620, 621, 0, 622, 0, 0, 0,
+	623, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 626, 0, 628, 629, 0, 0, 631,
+	632, 0, 633, 0, 0, 0, 0, 635, 636, 0, 637, 0, 0, 0, 638, 0, 0, 0,
+	0, 0, 0, 0, 0, 640, 641, 0, 642, 0, 0, 0, 643, 0, 0, 0, 0, 0, 0,
+	0, 644, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	647, 0, 649, 650, 0, 0, 652, 653, 0, 654, 0, 0, 0, 0, 656, 657,
+	0, 658, 0, 0, 0, 659, 0, 0, 0, 0, 0, 0, 0, 0, 661, 662, 0, 663,
+	0, 0, 0, 664, 0, 0, 0, 0, 0, 0, 0, 665, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 667, 668, 0, 669, 0, 0, 0, 670, 0, 0, 0,
+	0, 0, 0, 0, 671, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	672, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 676, 0, 0,
+	0, 679, 0, 681, 682, 0, 0, 0, 0, 685, 0, 687, 688, 0, 0, 690,
+	691, 0, 692, 0, 0, 0, 0, 0, 0, 695, 0, 697, 698, 0, 0, 700, 701,
+	0, 702, 0, 0, 0, 0, 704, 705, 0, 706, 0, 0, 0, 707, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 710, 0, 712, 713, 0, 0, 715, 716, 0, 717, 0, 0,
+	0, 0, 719, 720, 0, 721, 0, 0, 0, 722, 0, 0, 0, 0, 0, 0, 0, 0,
+	724, 725, 0, 726, 0, 0, 0, 727, 0, 0, 0, 0, 0, 0, 0, 728, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 731, 0, 733, 734,
+	0, 0, 736, 737, 0, 738, 0, 0, 0, 0, 740, 741, 0, 742, 0, 0, 0,
+	743, 0, 0, 0, 0, 0, 0, 0, 0, 745, 746, 0, 747, 0, 0, 0, 748, 0,
+	0, 0, 0, 0, 0, 0, 749, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 751, 752, 0, 753, 0, 0, 0, 754, 0, 0, 0, 0, 0, 0, 0, 755,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 756, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 759, 0, 761, 762, 0, 0, 764, 765, 0, 766, 0,
+	0, 0, 0, 768, 769, 0, 770, 0, 0, 0, 771, 0, 0, 0, 0, 0, 0, 0, 0,
+	773, 774, 0, 775, 0, 0, 0, 776, 0, 0, 0, 0, 0, 0, 0, 777, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 779, 780, 0, 781, 0, 0,
+	0, 782, 0, 0, 0, 0, 0, 0, 0, 783, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 784, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 786, 787, 0,
+	788, 0, 0, 0, 789, 0, 0, 0, 0, 0, 0, 0, 790, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 791, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 792, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 797, 0, 0, 0, 0, 0, 0, 0, 801,
+	0, 0, 0, 804, 0, 806, 807, 0, 0, 0, 0, 0, 0, 0, 0, 811, 0, 0, 0,
+	814, 0, 816, 817, 0, 0, 0, 0, 820, 0, 822, 823, 0, 0, 825, 826,
+	0, 827, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 831, 0, 0, 0, 834, 0, 836,
+	837, 0, 0, 0, 0, 840, 0, 842, 843, 0, 0, 845, 846, 0, 847, 0, 0,
+	0, 0, 0, 0, 850, 0, 852, 853, 0, 0, 855, 856, 0, 857, 0, 0, 0, 0,
+	859, 860, 0, 861, 0, 0, 0, 862, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 866, 0, 0, 0, 869, 0, 871, 872, 0, 0, 0, 0, 875, 0, 877,
+	878, 0, 0, 880, 881, 0, 882, 0, 0, 0, 0, 0, 0, 885, 0, 887, 888,
+	0, 0, 890, 891, 0, 892, 0, 0, 0, 0, 894, 895, 0, 896, 0, 0, 0,
+	897, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 900, 0, 902, 903, 0, 0, 905,
+	906, 0, 907, 0, 0, 0, 0, 909, 910, 0, 911, 0, 0, 0, 912, 0, 0, 0,
+	0, 0, 0, 0, 0, 914, 915, 0, 916, 0, 0, 0, 917, 0, 0, 0, 0, 0, 0,
+	0, 918, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 922, 0, 0, 0, 925, 0, 927, 928, 0, 0, 0, 0, 931, 0, 933,
+	934, 0, 0, 936, 937, 0, 938, 0, 0, 0, 0, 0, 0, 941, 0, 943, 944,
+	0, 0, 946, 947, 0, 948, 0, 0, 0, 0, 950, 951, 0, 952, 0, 0, 0,
+	953, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 956, 0, 958, 959, 0, 0, 961,
+	962, 0, 963, 0, 0, 0, 0, 965, 966, 0, 967, 0, 0, 0, 968, 0, 0, 0,
+	0, 0, 0, 0, 0, 970, 971, 0, 972, 0, 0, 0, 973, 0, 0, 0, 0, 0, 0,
+	0, 974, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	977, 0, 979, 980, 0, 0, 982, 983, 0, 984, 0, 0, 0, 0, 986, 987,
+	0, 988, 0, 0, 0, 989, 0, 0, 0, 0, 0, 0, 0, 0, 991, 992, 0, 993,
+	0, 0, 0, 994, 0, 0, 0, 0, 0, 0, 0, 995, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 997, 998, 0, 999, 0, 0, 0, 1000, 0, 0, 0,
+	0, 0, 0, 0, 1001, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1002, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1006, 0, 0,
+	0, 1009, 0, 1011, 1012, 0, 0, 0, 0, 1015, 0, 1017, 1018, 0, 0,
+	1020, 1021, 0, 1022, 0, 0, 0, 0, 0, 0, 1025, 0, 1027, 1028, 0, 0,
+	1030, 1031, 0, 1032, 0, 0, 0, 0, 1034, 1035, 0, 1036, 0, 0, 0,
+	1037, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1040, 0, 1042, 1043, 0, 0,
+	1045, 1046, 0, 1047, 0, 0, 0, 0, 1049, 1050, 0, 1051, 0, 0, 0,
+	1052, 0, 0, 0, 0, 0, 0, 0, 0, 1054, 1055, 0, 1056, 0, 0, 0, 1057,
+	0, 0, 0, 0, 0, 0, 0, 1058, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1061, 0, 1063, 1064, 0, 0, 1066, 1067, 0, 1068, 0,
+	0, 0, 0, 1070, 1071, 0, 1072, 0, 0, 0, 1073, 0, 0, 0, 0, 0, 0, 0,
+	0, 1075, 1076, 0, 1077, 0, 0, 0, 1078, 0, 0, 0, 0, 0, 0, 0, 1079,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1081, 1082, 0,
+	1083, 0, 0, 0, 1084, 0, 0, 0, 0, 0, 0, 0, 1085, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1086, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1089, 0, 1091, 1092, 0, 0, 1094, 1095, 0, 1096, 0, 0, 0, 0,
+	1098, 1099, 0, 1100, 0, 0, 0, 1101, 0, 0, 0, 0, 0, 0, 0, 0, 1103,
+	1104, 0, 1105, 0, 0, 0, 1106, 0, 0, 0, 0, 0, 0, 0, 1107, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1109, 1110, 0, 1111, 0, 0,
+	0, 1112, 0, 0, 0, 0, 0, 0, 0, 1113, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1114, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1116, 1117, 0,
+	1118, 0, 0, 0, 1119, 0, 0, 0, 0, 0, 0, 0, 1120, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1121, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1122,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1126, 0, 0, 0, 1129, 0, 1131, 1132, 0, 0, 0, 0, 1135,
+	0, 1137, 1138, 0, 0, 1140, 1141, 0, 1142, 0, 0, 0, 0, 0, 0, 1145,
+	0, 1147, 1148, 0, 0, 1150, 1151, 0, 1152, 0, 0, 0, 0, 1154, 1155,
+	0, 1156, 0, 0, 0, 1157, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1160, 0,
+	1162, 1163, 0, 0, 1165, 1166, 0, 1167, 0, 0, 0, 0, 1169, 1170, 0,
+	1171, 0, 0, 0, 1172, 0, 0, 0, 0, 0, 0, 0, 0, 1174, 1175, 0, 1176,
+	0, 0, 0, 1177, 0, 0, 0, 0, 0, 0, 0, 1178, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1181, 0, 1183, 1184, 0, 0, 1186,
+	1187, 0, 1188, 0, 0, 0, 0, 1190, 1191, 0, 1192, 0, 0, 0, 1193, 0,
+	0, 0, 0, 0, 0, 0, 0, 1195, 1196, 0, 1197, 0, 0, 0, 1198, 0, 0, 0,
+	0, 0, 0, 0, 1199, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1201, 1202, 0, 1203, 0, 0, 0, 1204, 0, 0, 0, 0, 0, 0, 0, 1205, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1206, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1209, 0, 1211, 1212, 0, 0, 1214, 1215, 0, 1216,
+	0, 0, 0, 0, 1218, 1219, 0, 1220, 0, 0, 0, 1221, 0, 0, 0, 0, 0, 0,
+	0, 0, 1223, 1224, 0, 1225, 0, 0, 0, 1226, 0, 0, 0, 0, 0, 0, 0,
+	1227, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1229, 1230,
+	0, 1231, 0, 0, 0, 1232, 0, 0, 0, 0, 0, 0, 0, 1233, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1234, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1236, 1237, 0, 1238, 0, 0, 0, 1239, 0, 0, 0, 0, 0, 0, 0, 1240, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1241, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1242, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1245, 0, 1247, 1248, 0, 0, 1250, 1251, 0, 1252, 0,
+	0, 0, 0, 1254, 1255, 0, 1256, 0, 0, 0, 1257, 0, 0, 0, 0, 0, 0, 0,
+	0, 1259, 1260, 0, 1261, 0, 0, 0, 1262, 0, 0, 0, 0, 0, 0, 0, 1263,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1265, 1266, 0,
+	1267, 0, 0, 0, 1268, 0, 0, 0, 0, 0, 0, 0, 1269, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1270, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1272, 1273, 0, 1274, 0, 0, 0, 1275, 0, 0, 0, 0, 0, 0, 0, 1276, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1277, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1278, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1280, 1281, 0, 1282, 0, 0, 0, 1283, 0, 0, 0, 0, 0, 0, 0,
+	1284, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1285, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1286, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1287, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0,
+	0, 0, 0, 2, 0, 0, 0, 3, 0, 4, 5, 0, 0, 0, 0, 0, 0, 0, 0, 7, 0, 0,
+	0, 8, 0, 9, 10, 0, 0, 0, 0, 12, 0, 13, 14, 0, 0, 16, 17, 0, 19,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 22, 0, 0, 0, 23, 0, 24, 25, 0, 0,
+	0, 0, 27, 0, 28, 29, 0, 0, 31, 32, 0, 34, 0, 0, 0, 0, 0, 0, 37,
+	0, 38, 39, 0, 0, 41, 42, 0, 44, 0, 0, 0, 0, 47, 48, 0, 50, 0, 0,
+	0, 53, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 57, 0, 0, 0, 58,
+	0, 59, 60, 0, 0, 0, 0, 62, 0, 63, 64, 0, 0, 66, 67, 0, 69, 0, 0,
+	0, 0, 0, 0, 72, 0, 73, 74, 0, 0, 76, 77, 0, 79, 0, 0, 0, 0, 82,
+	83, 0, 85, 0, 0, 0, 88, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 92, 0, 93,
+	94, 0, 0, 96, 97, 0, 99, 0, 0, 0, 0, 102, 103, 0, 105, 0, 0, 0,
+	108, 0, 0, 0, 0, 0, 0, 0, 0, 112, 113, 0, 115, 0, 0, 0, 118, 0,
+	0, 0, 0, 0, 0, 0, 122, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 127, 0, 0, 0, 128, 0, 129, 130, 0, 0, 0,
+	0, 132, 0, 133, 134, 0, 0, 136, 137, 0, 139, 0, 0, 0, 0, 0, 0,
+	142, 0, 143, 144, 0, 0, 146, 147, 0, 149, 0, 0, 0, 0, 152, 153,
+	0, 155, 0, 0, 0, 158, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 162, 0, 163,
+	164, 0, 0, 166, 167, 0, 169, 0, 0, 0, 0, 172, 173, 0, 175, 0, 0,
+	0, 178, 0, 0, 0, 0, 0, 0, 0, 0, 182, 183, 0, 185, 0, 0, 0, 188,
+	0, 0, 0, 0, 0, 0, 0, 192, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 197, 0, 198, 199, 0, 0, 201, 202, 0, 204, 0, 0, 0,
+	0, 207, 208, 0, 210, 0, 0, 0, 213, 0, 0, 0, 0, 0, 0, 0, 0, 217,
+	218, 0, 220, 0, 0, 0, 223, 0, 0, 0, 0, 0, 0, 0, 227, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 232, 233, 0, 235, 0, 0, 0,
+	238, 0, 0, 0, 0, 0, 0, 0, 242, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 247, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	253, 0, 0, 0, 254, 0, 255, 256, 0, 0, 0, 0, 258, 0, 259, 260, 0,
+	0, 262, 263, 0, 265, 0, 0, 0, 0, 0, 0, 268, 0, 269, 270, 0, 0,
+	272, 273, 0, 275, 0, 0, 0, 0, 278, 279, 0, 281, 0, 0, 0, 284, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 288, 0, 289, 290, 0, 0, 292, 293, 0,
+	295, 0, 0, 0, 0, 298, 299, 0, 301, 0, 0, 0, 304, 0, 0, 0, 0, 0,
+	0, 0, 0, 308, 309, 0, 311, 0, 0, 0, 314, 0, 0, 0, 0, 0, 0, 0,
+	318, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 323,
+	0, 324, 325, 0, 0, 327, 328, 0, 330, 0, 0, 0, 0, 333, 334, 0,
+	336, 0, 0, 0, 339, 0, 0, 0, 0, 0, 0, 0, 0, 343, 344, 0, 346, 0,
+	0, 0, 349, 0, 0, 0, 0, 0, 0, 0, 353, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 358, 359, 0, 361, 0, 0, 0, 364, 0, 0, 0, 0,
+	0, 0, 0, 368, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 373,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 379, 0, 380, 381, 0, 0, 383,
+	384, 0, 386, 0, 0, 0, 0, 389, 390, 0, 392, 0, 0, 0, 395, 0, 0, 0,
+	0, 0, 0, 0, 0, 399, 400, 0, 402, 0, 0, 0, 405, 0, 0, 0, 0, 0, 0,
+	0, 409, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 414, 415,
+	0, 417, 0, 0, 0, 420, 0, 0, 0, 0, 0, 0, 0, 424, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 429, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	435, 436, 0, 438, 0, 0, 0, 441, 0, 0, 0, 0, 0, 0, 0, 445, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 450, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 456, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 463, 0, 0, 0, 464, 0, 465, 466, 0, 0, 0,
+	0, 468, 0, 469, 470, 0, 0, 472, 473, 0, 475, 0, 0, 0, 0, 0, 0,
+	478, 0, 479, 480, 0, 0, 482, 483, 0, 485, 0, 0, 0, 0, 488, 489,
+	0, 491, 0, 0, 0, 494, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 498, 0, 499,
+	500, 0, 0, 502, 503, 0, 505, 0, 0, 0, 0, 508, 509, 0, 511, 0, 0,
+	0, 514, 0, 0, 0, 0, 0, 0, 0, 0, 518, 519, 0, 521, 0, 0, 0, 524,
+	0, 0, 0, 0, 0, 0, 0, 528, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 533, 0, 534, 535, 0, 0, 537, 538, 0, 540, 0, 0, 0,
+	0, 543, 544, 0, 546, 0, 0, 0, 549, 0, 0, 0, 0, 0, 0, 0, 0, 553,
+	554, 0, 556, 0, 0, 0, 559, 0, 0, 0, 0, 0, 0, 0, 563, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 568, 569, 0, 571, 0, 0, 0,
+	574, 0, 0, 0, 0, 0, 0, 0, 578, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 583, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 589, 0,
+	590, 591, 0, 0, 593, 594, 0, 596, 0, 0, 0, 0, 599, 600, 0, 602,
+	0, 0, 0, 605, 0, 0, 0, 0, 0, 0, 0, 0, 609, 610, 0, 612, 0, 0, 0,
+	615, 0, 0, 0, 0, 0, 0, 0, 619, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 624, 625, 0, 627, 0, 0, 0, 630, 0, 0, 0, 0, 0, 0,
+	0, 634, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 639, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 645, 646, 0, 648, 0, 0, 0, 651, 0, 0, 0,
+	0, 0, 0, 0, 655, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	660, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 666, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 673, 0, 674, 675, 0, 0,
+	677, 678, 0, 680, 0, 0, 0, 0, 683, 684, 0, 686, 0, 0, 0, 689, 0,
+	0, 0, 0, 0, 0, 0, 0, 693, 694, 0, 696, 0, 0, 0, 699, 0, 0, 0, 0,
+	0, 0, 0, 703, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	708, 709, 0, 711, 0, 0, 0, 714, 0, 0, 0, 0, 0, 0, 0, 718, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 723, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 729, 730, 0, 732, 0, 0, 0, 735, 0, 0, 0, 0, 0, 0, 0,
+	739, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 744, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 750, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 757, 758, 0, 760, 0, 0, 0, 763, 0, 0, 0, 0, 0,
+	0, 0, 767, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 772, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 778, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 785, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 793, 0, 0, 0, 794, 0, 795, 796,
+	0, 0, 0, 0, 798, 0, 799, 800, 0, 0, 802, 803, 0, 805, 0, 0, 0, 0,
+	0, 0, 808, 0, 809, 810, 0, 0, 812, 813, 0, 815, 0, 0, 0, 0, 818,
+	819, 0, 821, 0, 0, 0, 824, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 828, 0,
+	829, 830, 0, 0, 832, 833, 0, 835, 0, 0, 0, 0, 838, 839, 0, 841,
+	0, 0, 0, 844, 0, 0, 0, 0, 0, 0, 0, 0, 848, 849, 0, 851, 0, 0, 0,
+	854, 0, 0, 0, 0, 0, 0, 0, 858, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 863, 0, 864, 865, 0, 0, 867, 868, 0, 870, 0,
+	0, 0, 0, 873, 874, 0, 876, 0, 0, 0, 879, 0, 0, 0, 0, 0, 0, 0, 0,
+	883, 884, 0, 886, 0, 0, 0, 889, 0, 0, 0, 0, 0, 0, 0, 893, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 898, 899, 0, 901, 0, 0,
+	0, 904, 0, 0, 0, 0, 0, 0, 0, 908, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 913, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 919, 0,
+	920, 921, 0, 0, 923, 924, 0, 926, 0, 0, 0, 0, 929, 930, 0, 932,
+	0, 0, 0, 935, 0, 0, 0, 0, 0, 0, 0, 0, 939, 940, 0, 942, 0, 0, 0,
+	945, 0, 0, 0, 0, 0, 0, 0, 949, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 954, 955, 0, 957, 0, 0, 0, 960, 0, 0, 0, 0, 0, 0,
+	0, 964, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 969, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 975, 976, 0, 978, 0, 0, 0, 981, 0, 0, 0,
+	0, 0, 0, 0, 985, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	990, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 996, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1003, 0, 1004, 1005, 0, 0,
+	1007, 1008, 0, 1010, 0, 0, 0, 0, 1013, 1014, 0, 1016, 0, 0, 0,
+	1019, 0, 0, 0, 0, 0, 0, 0, 0, 1023, 1024, 0, 1026, 0, 0, 0, 1029,
+	0, 0, 0, 0, 0, 0, 0, 1033, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1038, 1039, 0, 1041, 0, 0, 0, 1044, 0, 0, 0, 0, 0, 0, 0,
+	1048, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1053, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1059, 1060, 0, 1062, 0, 0, 0, 1065, 0, 0, 0,
+	0, 0, 0, 0, 1069, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1074, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1080, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1087, 1088, 0, 1090, 0, 0, 0,
+	1093, 0, 0, 0, 0, 0, 0, 0, 1097, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1102, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1108, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1115, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1123, 0, 1124, 1125, 0,
+	0, 1127, 1128, 0, 1130, 0, 0, 0, 0, 1133, 1134, 0, 1136, 0, 0, 0,
+	1139, 0, 0, 0, 0, 0, 0, 0, 0, 1143, 1144, 0, 1146, 0, 0, 0, 1149,
+	0, 0, 0, 0, 0, 0, 0, 1153, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1158, 1159, 0, 1161, 0, 0, 0, 1164, 0, 0, 0, 0, 0, 0, 0,
+	1168, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1173, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1179, 1180, 0, 1182, 0, 0, 0, 1185, 0, 0, 0,
+	0, 0, 0, 0, 1189, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1194, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1200, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1207, 1208, 0, 1210, 0, 0, 0,
+	1213, 0, 0, 0, 0, 0, 0, 0, 1217, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1222, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1228, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1235, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1243, 1244, 0, 1246, 0, 0, 0,
+	1249, 0, 0, 0, 0, 0, 0, 0, 1253, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1258, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1264, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1271, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1279}
+
+var aceToFiveValues = [...]AceToFiveScore{
+	6033, 5877, 6034, 5889, 6035, 5074, 6045, 5076, 6036, 4171, 5878,
+	6037, 4172, 5079, 5140, 6038, 5077, 4193, 6039, 5083, 6040, 5142,
+	5879, 4173, 6046, 5088, 1511, 5080, 4204, 5094, 6041, 4174, 6042,
+	5901, 5084, 5101, 6047, 4226, 6043, 5145, 4175, 5081, 1731, 6032,
+	1514, 5890, 5089, 4176, 5109, 4194, 5149, 5085, 5118, 5206, 5095,
+	1520, 4177, 4237, 5128, 6048, 5154, 5102, 1516, 5143, 5090, 5880,
+	4227, 5072, 1734, 1530, 5160, 4178, 1951, 6049, 5096, 1522, 4195,
+	4179, 5902, 1545, 5086, 5110, 1740, 5119, 5167, 6057, 5103, 4196,
+	5891, 4180, 5881, 5913, 6050, 1566, 1736, 1532, 4270, 2171, 4170,
+	5129, 5146, 5091, 1750, 6051, 1517, 5175, 4205, 1547, 5111, 1742,
+	5073, 1954, 5097, 5184, 4197, 1765, 1525, 5120, 1594, 5208, 5150,
+	5092, 1523, 1630, 6052, 6058, 4198, 1568, 1960, 5098, 5194, 5104,
+	4325, 5272, 1786, 5130, 5147, 1752, 4228, 4281, 4238, 5138, 1675,
+	1535, 5155, 5075, 4199, 5882, 6053, 1533, 5105, 1767, 4271, 1596,
+	4248, 5151, 1508, 4206, 1550, 6054, 4229, 1970, 5112, 5161, 1745,
+	5099, 1632, 1814, 5209, 1539, 1548, 5121, 5211, 1850, 1985, 1526,
+	5883, 1788, 4200, 4207, 1554, 6055, 5113, 1571, 4336, 5914, 4201,
+	5168, 1677, 5106, 5156, 1569, 5122, 2174, 5215, 5131, 5892, 6044,
+	1895, 4230, 1755, 4326, 4272, 5274, 1509, 2006, 1737, 4391, 5107,
+	1575, 5162, 5078, 2391, 1816, 4249, 1728, 4202, 4231, 1536, 1770,
+	2180, 4208, 1599, 5132, 1956, 5152, 1559, 1852, 5176, 5114, 1759,
+	1527, 1597, 1635, 1743, 4192, 5212, 1551, 5185, 5123, 5884, 5220,
+	5082, 4209, 4468, 1633, 5169, 2034, 6059, 5115, 1540, 1774, 4232,
+	1603, 1962, 1791, 5893, 2070, 1897, 1580, 5124, 4327, 5226, 6069,
+	1639, 4239, 5216, 1680, 1555, 5195, 2190, 5133, 5157, 1572, 2611,
+	2394, 5275, 1729, 4210, 1678, 4402, 1537, 1586, 5925, 5116, 1753,
+	1795, 2176, 1512, 4282, 5177, 5139, 2115, 5087, 2205, 5134, 5163,
+	4233, 5125, 4240, 1510, 1819, 5233, 1684, 1957, 4392, 1779, 1552,
+	5186, 1608, 1972, 5158, 1576, 1768, 4292, 4234, 5903, 1855, 1948,
+	1541, 5093, 4273, 1644, 4556, 2182, 5221, 1600, 1518, 1746, 1560,
+	4479, 4211, 1614, 1987, 5164, 5885, 2226, 1823, 5135, 1636, 1963,
+	5170, 6060, 1556, 4212, 5196, 1800, 1650, 1573, 2614, 5227, 1965,
+	5126, 6070, 4469, 1789, 5241, 4235, 1859, 1900, 4328, 4241, 1604,
+	5100, 5277, 1689, 4337, 4283, 5886, 5141, 5250, 5894, 1581, 1806,
+	5338, 1621, 4225, 2008, 1640, 5171, 2177, 4393, 1732, 6061, 1681,
+	4274, 4213, 2192, 1528, 1577, 4242, 1756, 1695, 4347, 2396, 2254,
+	1657, 5915, 5136, 5178, 5234, 1904, 2831, 5165, 1587, 5937, 1828,
+	5281, 1973, 1601, 1747, 1513, 1561, 4293, 2290, 1817, 5137, 4275,
+	5187, 4203, 5260, 2207, 1543, 1975, 1771, 2400, 1864, 5108, 4567,
+	1637, 2183, 1738, 1685, 5213, 1542, 5895, 1853, 1609, 1834, 1760,
+	1988, 4655, 4470, 4243, 2036, 5117, 5179, 1702, 5204, 1990, 1645,
+	1605, 5172, 4557, 1870, 6062, 4338, 1519, 1966, 1665, 3051, 2072,
+	1557, 5242, 2335, 5188, 1582, 5887, 5197, 1615, 1979, 1775, 4276,
+	2620, 2228, 1641, 1564, 5278, 1909, 5217, 1792, 1682, 4329, 4765,
+	5251, 1898, 5286, 1651, 1757, 5173, 2616, 4303, 6063, 5926, 6071,
+	1841, 2168, 2009, 5127, 2834, 5144, 1588, 2410, 1994, 2193, 1748,
+	1690, 1515, 2011, 1915, 4348, 2397, 4394, 4244, 1877, 4330, 1710,
+	1730, 2117, 5198, 4403, 5292, 1578, 1772, 5404, 5340, 5180, 1622,
+	5282, 5876, 1796, 1686, 4250, 4245, 1719, 2425, 1610, 5261, 1761,
+	1529, 2208, 2185, 1763, 1976, 4277, 1696, 4284, 2256, 1562, 5189,
+	1658, 1592, 5896, 1949, 1820, 6064, 5148, 4413, 1780, 1646, 4558,
+	5222, 5181, 1521, 2015, 4278, 4395, 5904, 4666, 3054, 2292, 2037,
+	1628, 1922, 1856, 5205, 1616, 4331, 1776, 1544, 1991, 2402, 5299,
+	3271, 4471, 5190, 4251, 1793, 2039, 1999, 1885, 4480, 2073, 4246,
+	4886, 1652, 4358, 1980, 1606, 5228, 2446, 4339, 1563, 4656, 2229,
+	1824, 1784, 5199, 1703, 2075, 1583, 2630, 4776, 5287, 1801, 1691,
+	1642, 2617, 4304, 5218, 4279, 1666, 5182, 4490, 4236, 6065, 2337,
+	1860, 1673, 4404, 1901, 2195, 4285, 1995, 2622, 5343, 1623, 4472,
+	5153, 1797, 2043, 1565, 5279, 2012, 5200, 2840, 4766, 1589, 5191,
+	2645, 1930, 6066, 2118, 1531, 2020, 5293, 4332, 5949, 1807, 1697,
+	5938, 4269, 5307, 1659, 5341, 2169, 1821, 5235, 2079, 4148, 2836,
+	1733, 1584, 1781, 2120, 2210, 2412, 1939, 4286, 4252, 4333, 2186,
+	5159, 2474, 5905, 1687, 4294, 5316, 2257, 1812, 1711, 1857, 1950,
+	1905, 2199, 4414, 1611, 1546, 2026, 1762, 4396, 5406, 2405, 1829,
+	3274, 5283, 2016, 2510, 2850, 1952, 5897, 1590, 4481, 2293, 1848,
+	1720, 5201, 2427, 6067, 1647, 4253, 4559, 2666, 5223, 1524, 4657,
+	2403, 5300, 1865, 1825, 1593, 4568, 3060, 1704, 2040, 1739, 2124,
+	2214, 2000, 1617, 5192, 1777, 4473, 1802, 4897, 2048, 2231, 1835,
+	4359, 4334, 1967, 4287, 1667, 3491, 3056, 5243, 5326, 1861, 1629,
+	5166, 2076, 6056, 1612, 1902, 4340, 1653, 5898, 2625, 5229, 2084,
+	1567, 6072, 4578, 1871, 4397, 1958, 2555, 4767, 4491, 5252, 2338,
+	1893, 4887, 1648, 5961, 2196, 4560, 1808, 2054, 5224, 1692, 4324,
+	2448, 2623, 5344, 5270, 3070, 4254, 2044, 1910, 4424, 1735, 4405,
+	1618, 2632, 2415, 3711, 4341, 5202, 5288, 6081, 2235, 2021, 1624,
+	2694, 2090, 5470, 4349, 1798, 4305, 1591, 2388, 5308, 1842, 1712,
+	2170, 2080, 4159, 1906, 1674, 2837, 2121, 1654, 1534, 2211, 5409,
+	2413, 5230, 1698, 5203, 1830, 6073, 2259, 1749, 1660, 2730, 2129,
+	4288, 5236, 1916, 2219, 2842, 5317, 1782, 1878, 5174, 2647, 1721,
+	2430, 5262, 2200, 5294, 1693, 1977, 2027, 2865, 5407, 2406, 1866,
+	4474, 5927, 1595, 4569, 2061, 2295, 4398, 1741, 4149, 4289, 4406,
+	1953, 1549, 5183, 2428, 5347, 1625, 1836, 1968, 3280, 4342, 2187,
+	1764, 2476, 2135, 3494, 4501, 4255, 1631, 2097, 4482, 4399, 5207,
+	4561, 2125, 5906, 1538, 2215, 1699, 1992, 3085, 2263, 5899, 2049,
+	4658, 3276, 1661, 1872, 2232, 1826, 5237, 1705, 1619, 2512, 2775,
+	2852, 1783, 4667, 3057, 4256, 5327, 1923, 2240, 4888, 1981, 1803,
+	2668, 1983, 2451, 2626, 4295, 5301, 2299, 2085, 1668, 1911, 4579,
+	3062, 5244, 2886, 2340, 1862, 1959, 1655, 4562, 2635, 4290, 3714,
+	5231, 1886, 1553, 6074, 4369, 5193, 3931, 5536, 3290, 2055, 4360,
+	1570, 5916, 2608, 2449, 4475, 1843, 4677, 5271, 4483, 4768, 1785,
+	1676, 4425, 2142, 5253, 4400, 2246, 2633, 2416, 1996, 4777, 1809,
+	4659, 1751, 6082, 2236, 4343, 2091, 2172, 5950, 1706, 2013, 1917,
+	2845, 4280, 4476, 2557, 1879, 2105, 2650, 5907, 5352, 4257, 1626,
+	5888, 6075, 1804, 2197, 5410, 4350, 5345, 1669, 2260, 3072, 4150,
+	5245, 2344, 2004, 4344, 2130, 1713, 4390, 2220, 1907, 2843, 1700,
+	4787, 2648, 2431, 1931, 2268, 5413, 1662, 5973, 1831, 5238, 2696,
+	1574, 5284, 1766, 2479, 5472, 4407, 2389, 5309, 3106, 4769, 2062,
+	2296, 4247, 2914, 5254, 1288, 1722, 4415, 5358, 1627, 5263, 1810,
+	2212, 4296, 1940, 2304, 4589, 5348, 1867, 2178, 1969, 1598, 2017,
+	4570, 2732, 2515, 2855, 2477, 2136, 1744, 1558, 4668, 4502, 5318,
+	4477, 2098, 2419, 1813, 1924, 2150, 2950, 3305, 2274, 2201, 1663,
+	2671, 1837, 5239, 2867, 5939, 2407, 6076, 4484, 2264, 3500, 3277,
+	3065, 1714, 2041, 1634, 2513, 4345, 2853, 5210, 2001, 1887, 6093,
+	4660, 1849, 2159, 4297, 2241, 3934, 1832, 2310, 1707, 5917, 3282,
+	2669, 1873, 1984, 2452, 3496, 4467, 2300, 1787, 2032, 3063, 1723,
+	2077, 2434, 4408, 2341, 5264, 4889, 3326, 2636, 2216, 4778, 1982,
+	1670, 5365, 4370, 4563, 3087, 4898, 5246, 2349, 1868, 5962, 1602,
+	4571, 2233, 1912, 4335, 2777, 2560, 3134, 4678, 4492, 2639, 3720,
+	5328, 2068, 2143, 4661, 1579, 5289, 2995, 2247, 1838, 1708, 2627,
+	5928, 4770, 2281, 4564, 3510, 3075, 5255, 2045, 1844, 1638, 5908,
+	2173, 2888, 5214, 1679, 2846, 1811, 3170, 2558, 3716, 2106, 2651,
+	1932, 5353, 1997, 1894, 2188, 1671, 2022, 4908, 5538, 2699, 3292,
+	5475, 5247, 2355, 1874, 1754, 2609, 2392, 4298, 1918, 6077, 2317,
+	4435, 5273, 3073, 2081, 1880, 1289, 2654, 2345, 2005, 4426, 2828,
+	4890, 2122, 1585, 5295, 4351, 2417, 4788, 1941, 2455, 2269, 5414,
+	1715, 5602, 4771, 6083, 2237, 2735, 2697, 1913, 5256, 2480, 5473,
+	4151, 2390, 6078, 5418, 4485, 1815, 3730, 4409, 4160, 5290, 2113,
+	5359, 2203, 2028, 2870, 5411, 4306, 1769, 5373, 4662, 2305, 4590,
+	1724, 1845, 2261, 2439, 4565, 2179, 2733, 5265, 2516, 1683, 2856,
+	1955, 2221, 4416, 1851, 3215, 2420, 4352, 2432, 2151, 4410, 1607,
+	4572, 2275, 3285, 1716, 1758, 2672, 2018, 1919, 2868, 4688, 5382,
+	2859, 1672, 3108, 4669, 5248, 3066, 2362, 1881, 5424, 2297, 2916,
+	4181, 2126, 1925, 1839, 5296, 2675, 6094, 3525, 3090, 2160, 4899,
+	2050, 1643, 5349, 2311, 4555, 5302, 5219, 1725, 3283, 4512, 4152,
+	2780, 4299, 5266, 2325, 3497, 4772, 4503, 3048, 2002, 6079, 1888,
+	5257, 2033, 2952, 2435, 3307, 1875, 3940, 1613, 4573, 4361, 1773,
+	2483, 5366, 3354, 3088, 2086, 2265, 2224, 2350, 3502, 4580, 2891,
+	4891, 1961, 1790, 4300, 2778, 4417, 2561, 4798, 4486, 2460, 2640,
+	4779, 2069, 1896, 2242, 3936, 3546, 5541, 4411, 3295, 2056, 1649,
+	2612, 4353, 5225, 2453, 2519, 5985, 4446, 3745, 2282, 3390, 4670,
+	6068, 5392, 3076, 1717, 2301, 5918, 4493, 1926, 2889, 2342, 3328,
+	2637, 4487, 4307, 3717, 5431, 1846, 4371, 2189, 6084, 5303, 4909,
+	5539, 2700, 2092, 3293, 1688, 5476, 3079, 2356, 2046, 2610, 4892,
+	2393, 4663, 3136, 2318, 4436, 2003, 5909, 2370, 1889, 1726, 3722,
+	2466, 4161, 2655, 1933, 5267, 4401, 3950, 2997, 2248, 2023, 1920,
+	5940, 2703, 5479, 2456, 1882, 5336, 2659, 3512, 1620, 5310, 5974,
+	3766, 2736, 2131, 1794, 2175, 5297, 2082, 1292, 2847, 4773, 3172,
+	4301, 5419, 5258, 2652, 2379, 4780, 4308, 5354, 1942, 2114, 1847,
+	4153, 2204, 2739, 1694, 2871, 5374, 3574, 3111, 2564, 2252, 2063,
+	1656, 5319, 2440, 4494, 2919, 3435, 1290, 1818, 2346, 5232, 2829,
+	2202, 5997, 1778, 2488, 5910, 2029, 4488, 2270, 2874, 5415, 5350,
+	5604, 1883, 1971, 4523, 3286, 4354, 2481, 5951, 2137, 4689, 5383,
+	5919, 2860, 4291, 4664, 3109, 2288, 2099, 3732, 2363, 5425, 2955,
+	1934, 2917, 1854, 3310, 4893, 4182, 5360, 2024, 5439, 2524, 5484,
+	4154, 4600, 2676, 4671, 3091, 2398, 2306, 5311, 3505, 4591, 3268,
+	1927, 2181, 4355, 4513, 1298, 2517, 2781, 2857, 2326, 2680, 2127,
+	3794, 1727, 2217, 4478, 2494, 3217, 2421, 5304, 5268, 4919, 1943,
+	2953, 3308, 2276, 3094, 4900, 2051, 2673, 5448, 1986, 4309, 2484,
+	4418, 1890, 2784, 4654, 4574, 5320, 2225, 3503, 3067, 1822, 1701,
+	2892, 5329, 3965, 3331, 4362, 4799, 2461, 2030, 4774, 2530, 2879,
+	6095, 3527, 4372, 5269, 3937, 4672, 5542, 1799, 2312, 3296, 2087,
+	4581, 2613, 1928, 2895, 2520, 5668, 4447, 3139, 1964, 4781, 4679,
+	1664, 4575, 2686, 5393, 3725, 3049, 5240, 2333, 1858, 2144, 2436,
+	3000, 5305, 1899, 3942, 3329, 5545, 3299, 2057, 5432, 2569, 2618,
+	3515, 5367, 3356, 4495, 1891, 2351, 4155, 5276, 3080, 4427, 3986,
+	4356, 3610, 3175, 2562, 3137, 2107, 4363, 4764, 2371, 2641, 5355,
+	3723, 2467, 4894, 5249, 3099, 3548, 4901, 2052, 2998, 2238, 1805,
+	2501, 2704, 2093, 5480, 5458, 3747, 2283, 5337, 2660, 3392, 3513,
+	1935, 3077, 4782, 4214, 5330, 2007, 2832, 4419, 4162, 2708, 6105,
+	3830, 3173, 4789, 2380, 2408, 5312, 5416, 4895, 2575, 5607, 2191,
+	5911, 2088, 1308, 5963, 2701, 4582, 4310, 5477, 2357, 2537, 2900,
+	2740, 2132, 4346, 2395, 4673, 2222, 3112, 2565, 2319, 2253, 4437,
+	3735, 1944, 1709, 2920, 4576, 1291, 2656, 5361, 1903, 2830, 3952,
+	2744, 5550, 5734, 4611, 2489, 2058, 5402, 5321, 2457, 2875, 5920,
+	1827, 5605, 3768, 3115, 5280, 4524, 2737, 2064, 1936, 4311, 4428,
+	2923, 1293, 2031, 1892, 2714, 5490, 3655, 5420, 2289, 3220, 2422,
+	3733, 2152, 1718, 2956, 2423, 3311, 4014, 5313, 5259, 6085, 4364,
+	2206, 5440, 2525, 2094, 5485, 1323, 1974, 4601, 2872, 5375, 2138,
+	3576, 2399, 3506, 1863, 4504, 4156, 4566, 2441, 3437, 2100, 1945,
+	4163, 2959, 3314, 2681, 4783, 2750, 6096, 2495, 3530, 3218, 2161,
+	4920, 5322, 3095, 6009, 2266, 1833, 4699, 3287, 5449, 2133, 2223,
+	2582, 4690, 5384, 2785, 2861, 3488, 3052, 4496, 2035, 4157, 2364,
+	4534, 5426, 2437, 4902, 4420, 5900, 2243, 3945, 3332, 2789, 3875,
+	2677, 2531, 2880, 1989, 5368, 3528, 3359, 3092, 5331, 3120, 2065,
+	3269, 2302, 1869, 2545, 2928, 4514, 1299, 2782, 2896, 4312, 5986,
+	3140, 2327, 3796, 3335, 2642, 2687, 3726, 3050, 1937, 2071, 2334,
+	3551, 4421, 4885, 3001, 4583, 5929, 2721, 3943, 5497, 5546, 1978,
+	3300, 2485, 2444, 2570, 2139, 2619, 3750, 5314, 3395, 3516, 4505,
+	3357, 3143, 2227, 4680, 2101, 1344, 1908, 4809, 2893, 3967, 2964,
+	5556, 3319, 2145, 4903, 2059, 4674, 3176, 3004, 4800, 2249, 2628,
+	3708, 2462, 1946, 2795, 4050, 5285, 3100, 4910, 3549, 5543, 4365,
+	3519, 3297, 5952, 2757, 5332, 2502, 2615, 4302, 2521, 5459, 5670,
+	4448, 5323, 4438, 3748, 3393, 1840, 3179, 5394, 4215, 2108, 2657,
+	4158, 2833, 6086, 2244, 3955, 2709, 2095, 6106, 4784, 4584, 2906,
+	5433, 2409, 1993, 2458, 4095, 2576, 5608, 3771, 4366, 4164, 3081,
+	2538, 1295, 2901, 2347, 2010, 1914, 2590, 3988, 6117, 2838, 3612,
+	3340, 5421, 4497, 3736, 2372, 1876, 2643, 4790, 2468, 2116, 2271,
+	5941, 3953, 2745, 5291, 4422, 5551, 5611, 5998, 4612, 4429, 2705,
+	5376, 5481, 5403, 3579, 3148, 5339, 2661, 4681, 2442, 3769, 3116,
+	3440, 3739, 5864, 2924, 2146, 1294, 2599, 3009, 6087, 2250, 3832,
+	2715, 4498, 5491, 4675, 3221, 2381, 3126, 2424, 4904, 2066, 2307,
+	4710, 5505, 4592, 1309, 2184, 4165, 2802, 2472, 2741, 5921, 5385,
+	2862, 3577, 3113, 3184, 2566, 2255, 5333, 2109, 5427, 1372, 2921,
+	3438, 3224, 5356, 4183, 2153, 2960, 4412, 3315, 2277, 5736, 2490,
+	2751, 2140, 2678, 1947, 3531, 4506, 2876, 2102, 3272, 2765, 5800,
+	5975, 5514, 4525, 4700, 4622, 4515, 1301, 4367, 2014, 2508, 2848,
+	5324, 2583, 3799, 4665, 3053, 3657, 2291, 4791, 1408, 4535, 2957,
+	1921, 2272, 6097, 3534, 3312, 4016, 2162, 5563, 3946, 5616, 2067,
+	2313, 2934, 5441, 2664, 2526, 2790, 2486, 5486, 1324, 4785, 4602,
+	3360, 2401, 5298, 3507, 3121, 4820, 3270, 3058, 4430, 3970, 2546,
+	2929, 2038, 1300, 5362, 5922, 2682, 1998, 2463, 4930, 3797, 3336,
+	1884, 2496, 4499, 4921, 3552, 2308, 5964, 6088, 3928, 4593, 3363,
+	3096, 2722, 2103, 5498, 2352, 4357, 2970, 5450, 2522, 5673, 3346,
+	4449, 2445, 3751, 2786, 3396, 5395, 3489, 3144, 3229, 4373, 4166,
+	2154, 4810, 3968, 2965, 2074, 5557, 3320, 2278, 3555, 3333, 3005,
+	2629, 5434, 4775, 3877, 3154, 2532, 2796, 2881, 4905, 4682, 3754,
+	2284, 3399, 3520, 3082, 2758, 2147, 2810, 5524, 2251, 3991, 3615,
+	2897, 5671, 3141, 2553, 5334, 2688, 3180, 3727, 4489, 2469, 2336,
+	3539, 2163, 1453, 3956, 3002, 2314, 2194, 4911, 5547, 2706, 3301,
+	5482, 2907, 2358, 3190, 2571, 2621, 4633, 4906, 5342, 2110, 2662,
+	3517, 3772, 4585, 2320, 5930, 3068, 2941, 2042, 1345, 2819, 1296,
+	4374, 2591, 3989, 3835, 6118, 2839, 3613, 3959, 3177, 3341, 5335,
+	3709, 2644, 1929, 4052, 5571, 5369, 3368, 3101, 4683, 1311, 2353,
+	2019, 5612, 3775, 2692, 5468, 2742, 2503, 2148, 3580, 3015, 5460,
+	4258, 4507, 2567, 5306, 3149, 4792, 4586, 4431, 5422, 3441, 4216,
+	2977, 3740, 2078, 5006, 5622, 2835, 3560, 2600, 2710, 3010, 5739,
+	2119, 2491, 6107, 3833, 2209, 2411, 2877, 1938, 3127, 4097, 6089,
+	3759, 2285, 5580, 2577, 3583, 5609, 3404, 4711, 2111, 4526, 5506,
+	1310, 2728, 5363, 3444, 2539, 2803, 2473, 2902, 3660, 5315, 3185,
+	3737, 4167, 4432, 4594, 3225, 4019, 2198, 4912, 2746, 5552, 5442,
+	2527, 5737, 4613, 5487, 1326, 2359, 2025, 4603, 2863, 5405, 2404,
+	4691, 6090, 3235, 2321, 4439, 3273, 2766, 6010, 2155, 4793, 3117,
+	5515, 4623, 2365, 1302, 2279, 5428, 2925, 2509, 2849, 4184, 5629,
+	2683, 4941, 3800, 2716, 2497, 5492, 3658, 3222, 4168, 5923, 4375,
+	2426, 3535, 4017, 3097, 5564, 5617, 3780, 5451, 3278, 2935, 2665,
+	1325, 5931, 1304, 2787, 3161, 3492, 4684, 2328, 6098, 4721, 3803,
+	4821, 2164, 1373, 3059, 3971, 4587, 2315, 3022, 4595, 2961, 2123,
+	3316, 2213, 4931, 2752, 3880, 2533, 2882, 3532, 5377, 3588, 3083,
+	4896, 2047, 3364, 5802, 5590, 2443, 2230, 4701, 3449, 2156, 2971,
+	2898, 3974, 5674, 3347, 2773, 4508, 3197, 2584, 2112, 2689, 3490,
+	4801, 3055, 2985, 5325, 5370, 3230, 1409, 3374, 4536, 4433, 3947,
+	3556, 5548, 3302, 2791, 2572, 3878, 2624, 3155, 4692, 5386, 5677,
+	3361, 4450, 3755, 3122, 2083, 3400, 1347, 6091, 2366, 4577, 6099,
+	3566, 2547, 2811, 2930, 2165, 2884, 5525, 3992, 4185, 3616, 4509,
+	2554, 3712, 4794, 3337, 4831, 2286, 4055, 5435, 3410, 3540, 3102,
+	3553, 6129, 4169, 3929, 5534, 2723, 3288, 2053, 2504, 5499, 4516,
+	4313, 5461, 2447, 3191, 3752, 3995, 2329, 4634, 3619, 3397, 3808,
+	3145, 5912, 3069, 2942, 2373, 1346, 4423, 4811, 2820, 5371, 4913,
+	2966, 3381, 5558, 2711, 3321, 6108, 3836, 2360, 3960, 3006, 2631,
+	2414, 3710, 4376, 2322, 2797, 4100, 5953, 2578, 4053, 5976, 5572,
+	3369, 1312, 6080, 2663, 2234, 3521, 2759, 3776, 2693, 2089, 2540,
+	5469, 2903, 3979, 4732, 3016, 5987, 4259, 4802, 3839, 3242, 2464,
+	3181, 2157, 2978, 2382, 3030, 5865, 5623, 3786, 2287, 1454, 3957,
+	3417, 3561, 2747, 5553, 5740, 4614, 1314, 5408, 2908, 4377, 5682,
+	5942, 4098, 3760, 5581, 3584, 3773, 3118, 3405, 5396, 2258, 2729,
+	2128, 2926, 3445, 1297, 2218, 3205, 2592, 4914, 6119, 2841, 4685,
+	2717, 3342, 5493, 3661, 4510, 5743, 5378, 6100, 3594, 2646, 2166,
+	2429, 4020, 3039, 2323, 4440, 3455, 5613, 1327, 4527, 2864, 4000,
+	3581, 3624, 3150, 3104, 3236, 1375, 2374, 2060, 3664, 3442, 2294,
+	3741, 2912, 2470, 5007, 2962, 3317, 4023, 5630, 4842, 2601, 4942,
+	3011, 4795, 2753, 4693, 5443, 5387, 5488, 1329, 5932, 5637, 3128,
+	2367, 5805, 5346, 5429, 4712, 3781, 4702, 5507, 4186, 3279, 2804,
+	2475, 2134, 2585, 1305, 3162, 3493, 3844, 4500, 2684, 3186, 4722,
+	3804, 1411, 4537, 2383, 2096, 1374, 5379, 3226, 2948, 3601, 3303,
+	3023, 4922, 4517, 1318, 3462, 2792, 5999, 3881, 2330, 5452, 3814,
+	4596, 5646, 3589, 3084, 3123, 4378, 2262, 3498, 3275, 2767, 5803,
+	5591, 2548, 5516, 2931, 3450, 4624, 1303, 3975, 2511, 2774, 2851,
+	3198, 3801, 3338, 5748, 3250, 2492, 4686, 2986, 1410, 3884, 3375,
+	4694, 5388, 2883, 2239, 3932, 3536, 4915, 5565, 2724, 5618, 5500,
+	4952, 2368, 5943, 2936, 2667, 4187, 2450, 4803, 4597, 4441, 5678,
+	3146, 3669, 2298, 1348, 4822, 2690, 4812, 3061, 3972, 3567, 2967,
+	5559, 2885, 2339, 3322, 4028, 3007, 3324, 2634, 3713, 3259, 4932,
+	6101, 2528, 5688, 5965, 5954, 1333, 2167, 2798, 4604, 4832, 4056,
+	3411, 2331, 5397, 4368, 6130, 3821, 3522, 3930, 2760, 5535, 3365,
+	3289, 1350, 2972, 4314, 5675, 3348, 3996, 3132, 5436, 3620, 3182,
+	3809, 4676, 2498, 3718, 3231, 1456, 4923, 2141, 4059, 3382, 2993,
+	3103, 2245, 3557, 2909, 4006, 6102, 3630, 5380, 4796, 4743, 3156,
+	4101, 2375, 5462, 4804, 3508, 3756, 2471, 5656, 3401, 4217, 2812,
+	3980, 2593, 5526, 3993, 4733, 6120, 2844, 3617, 3343, 2712, 3168,
+	2556, 6109, 3840, 2104, 2649, 3243, 3889, 2534, 5695, 5351, 4451,
+	3541, 3031, 3787, 1455, 4104, 3418, 5398, 5614, 1315, 3850, 4695,
+	5389, 2904, 3425, 5683, 3192, 3151, 4635, 2384, 4598, 5437, 3742,
+	3071, 2943, 5009, 2343, 4188, 2821, 2602, 4379, 3012, 3206, 3837,
+	3961, 2748, 5554, 5744, 4615, 4786, 3595, 3129, 4963, 2573, 2267,
+	3637, 5412, 4916, 3040, 5573, 4713, 3370, 5508, 5600, 3456, 2376,
+	1313, 3777, 4518, 2695, 1354, 2805, 2478, 5471, 5754, 2332, 4442,
+	4001, 3017, 4853, 3625, 4260, 3187, 3105, 1376, 2718, 3728, 5494,
+	3665, 3227, 2979, 2913, 4064, 5008, 5624, 5357, 4528, 3562, 4024,
+	4843, 5741, 2505, 1330, 6103, 3675, 5638, 2303, 3857, 4917, 3761,
+	4588, 5582, 2768, 5806, 3585, 3406, 5517, 2385, 4625, 4034, 4218,
+	1378, 2731, 3446, 2514, 2854, 4805, 5444, 4443, 6110, 1338, 3845,
+	4605, 3662, 1412, 2754, 3213, 2418, 2149, 3537, 2949, 4021, 3602,
+	4109, 5566, 2579, 3304, 2273, 5619, 5809, 1319, 2937, 2670, 3463,
+	1328, 5933, 2541, 5703, 4452, 2866, 3815, 5647, 5761, 5399, 2499,
+	3470, 3237, 4823, 3499, 3064, 4924, 1414, 4538, 5631, 4943, 5453,
+	5966, 4933, 5749, 3251, 5944, 2793, 6092, 3523, 3885, 2158, 3933,
+	3366, 3682, 2309, 3782, 4953, 3124, 2973, 3281, 5988, 3349, 4041,
+	5390, 2932, 3645, 1306, 3163, 3495, 3895, 2377, 5445, 2535, 5495,
+	3479, 3670, 4456, 4723, 3232, 3805, 4606, 4189, 2433, 4029, 3024,
+	3558, 3938, 3325, 2725, 3260, 5501, 5689, 3882, 1334, 3157, 5364,
+	2691, 3590, 3757, 3352, 3086, 3822, 3402, 2348, 6011, 4519, 1382,
+	5592, 2813, 3451, 5527, 4925, 1351, 4696, 5934, 3976, 2968, 2776,
+	3865, 5560, 2559, 3323, 3199, 3133, 2386, 4444, 5454, 2638, 2987,
+	3719, 3542, 4190, 3376, 1457, 2799, 4060, 3544, 1359, 2994, 5814,
+	4703, 2761, 4007, 3631, 3193, 4744, 4636, 2586, 3743, 2280, 5679,
+	3388, 3509, 3074, 2944, 4070, 5657, 3902, 1349, 4520, 1418, 4539,
+	2822, 3568, 2887, 4806, 1459, 3962, 5769, 2506, 3169, 3715, 5463,
+	3890, 4833, 2910, 4057, 5696, 5574, 3412, 3371, 6131, 4219, 4105,
+	4529, 4907, 3778, 5537, 2698, 3291, 5474, 2354, 2549, 6141, 4453,
+	4315, 3851, 3018, 4261, 3426, 5400, 3997, 3690, 2316, 6121, 4434,
+	3621, 3810, 3344, 2980, 4115, 2580, 5010, 5625, 2653, 4974, 3563,
+	3383, 4380, 3948, 6000, 5446, 2542, 5502, 1365, 5977, 4964, 2454,
+	4102, 3638, 3762, 5583, 3586, 3407, 5601, 3152, 3764, 2734, 3447,
+	1355, 4077, 4813, 5712, 3981, 4454, 5561, 5012, 4734, 5755, 4616,
+	4697, 4854, 5401, 3013, 5417, 3841, 2507, 3244, 3729, 4926, 5464,
+	4065, 3032, 3788, 3130, 4191, 3419, 4714, 4220, 5455, 5509, 1316,
+	2869, 2806, 5372, 2719, 5684, 3572, 6111, 3676, 3858, 3238, 3188,
+	2438, 3433, 4035, 1379, 4122, 4521, 4864, 1463, 5632, 4944, 3207,
+	1339, 2387, 3910, 5745, 2543, 2911, 3214, 3596, 4110, 3041, 1387,
+	3783, 3457, 3284, 2769, 5810, 5518, 4626, 1307, 5704, 3164, 2594,
+	4687, 4002, 5381, 2755, 6122, 2858, 3626, 5762, 4617, 4724, 3806,
+	3107, 3471, 1377, 2361, 3666, 5423, 2915, 1415, 3025, 5820, 5866,
+	5778, 4704, 4025, 4844, 5567, 5620, 4807, 2587, 2938, 2674, 1331,
+	3524, 3591, 3089, 5639, 4530, 1423, 5807, 5955, 3683, 5593, 4544,
+	3452, 4824, 4511, 5016, 3977, 2779, 4042, 3646, 3200, 2603, 5722,
+	2324, 4455, 3846, 3792, 3896, 2988, 3480, 4457, 5935, 1413, 3377,
+	4085, 3131, 2951, 3603, 3306, 6153, 5945, 2550, 5510, 3939, 1393,
+	4607, 1320, 3464, 5465, 2482, 2974, 5680, 3816, 3350, 5648, 3353,
+	4531, 4221, 3501, 1383, 3569, 2890, 3963, 3233, 2726, 6112, 3866,
+	5827, 3699, 4797, 4705, 5750, 3252, 2459, 4834, 4927, 3413, 4130,
+	3886, 2588, 3158, 6132, 3935, 3545, 5540, 1360, 3294, 5456, 5815,
+	4814, 4954, 5519, 1429, 5967, 4608, 4627, 4316, 2814, 2518, 5528,
+	5666, 4445, 3998, 3622, 3744, 3811, 3389, 5391, 2800, 3671, 4071,
+	3903, 1419, 3384, 3543, 4030, 2762, 5568, 1460, 5770, 5978, 3327,
+	3919, 2551, 2939, 3261, 5430, 5690, 1335, 4928, 3194, 3823, 4637,
+	5946, 1468, 3078, 3982, 2945, 6142, 4735, 1352, 2823, 3984, 3608,
+	2727, 3842, 3135, 5503, 3691, 3245, 4934, 2369, 4116, 3721, 2465,
+	3033, 4975, 3789, 1458, 3420, 4061, 5575, 3949, 2996, 3372, 1317,
+	1366, 5788, 2595, 4815, 2702, 4008, 2975, 6123, 5478, 3632, 5685,
+	3351, 4745, 3019, 5924, 2658, 3511, 1400, 3765, 5658, 4532, 4078,
+	2981, 5713, 3208, 5013, 5626, 3564, 3828, 2763, 5746, 3171, 3597,
+	4985, 2378, 3891, 3159, 5697, 3042, 3458, 5835, 4106, 3763, 5584,
+	4706, 5021, 3408, 5466, 2604, 2738, 5529, 1474, 4003, 4609, 3852,
+	3627, 3427, 3573, 3110, 2563, 4222, 3667, 1436, 4540, 2918, 3434,
+	5011, 4715, 4123, 6113, 4026, 4865, 1464, 4845, 4381, 5732, 2807,
+	2487, 3911, 1332, 4139, 2596, 5640, 4965, 3195, 2873, 3639, 6012,
+	4638, 1388, 5603, 3239, 4522, 2552, 2946, 1356, 5467, 5756, 3847,
+	4855, 5633, 4945, 3653, 4223, 3731, 2954, 3604, 4066, 3309, 4012,
+	2770, 5821, 5576, 6114, 5779, 4618, 5956, 1321, 3465, 3784, 5438,
+	2523, 5483, 5027, 4599, 3817, 4996, 2605, 5649, 3020, 4262, 3677,
+	3859, 3165, 3504, 1424, 4545, 4725, 4816, 4036, 2982, 1380, 5569,
+	5017, 5627, 4716, 3026, 5511, 5723, 2679, 5751, 3253, 1340, 3793,
+	2808, 2493, 3887, 3216, 4086, 4918, 4111, 4825, 5585, 3592, 3093,
+	4955, 6154, 4619, 1394, 5811, 5594, 5447, 3453, 5705, 4935, 2783,
+	4643, 5763, 3201, 3672, 3472, 2989, 1481, 3964, 4031, 1416, 3378,
+	3330, 2771, 5828, 5520, 3700, 3262, 5691, 4628, 1336, 3873, 2529,
+	2878, 4131, 3526, 5844, 3824, 5989, 3240, 3684, 1430, 1353, 2597,
+	3570, 4043, 6124, 2894, 3647, 5667, 3138, 5634, 5947, 3897, 1444,
+	4541, 2685, 3481, 3724, 4458, 4224, 4835, 4062, 3414, 2999, 6133,
+	2815, 4826, 3941, 6115, 5544, 3920, 4009, 3298, 3633, 4746, 2568,
+	4317, 3166, 3514, 5854, 3355, 4936, 5659, 4707, 4726, 3812, 1384,
+	5034, 1469, 2606, 3027, 3985, 3867, 3385, 3609, 3174, 4753, 4542,
+	3892, 5698, 4639, 4048, 4107, 4717, 3098, 3547, 5512, 1361, 5816,
+	2824, 5595, 5789, 4620, 2500, 3853, 3983, 3428, 5457, 4736, 3202,
+	3746, 3391, 1401, 4817, 4072, 5867, 2990, 3904, 3246, 5577, 1420,
+	3379, 4382, 3034, 3790, 2707, 1461, 6104, 5771, 3421, 3829, 2816,
+	5530, 4986, 4263, 4966, 3640, 4093, 2574, 5686, 5606, 2772, 5836,
+	5521, 5022, 5979, 1357, 3571, 6143, 2536, 2899, 5757, 1475, 4856,
+	3209, 3692, 3734, 1437, 6001, 4117, 1489, 4836, 4067, 5586, 3598,
+	4976, 3415, 5968, 6134, 3043, 3951, 2743, 5549, 3459, 2947, 5733,
+	4610, 1367, 2825, 4140, 5936, 3678, 3860, 4004, 3628, 4827, 3767,
+	3114, 4037, 6165, 1381, 4708, 4079, 6125, 2922, 5714, 3386, 5014,
+	1341, 4937, 4846, 2713, 5489, 3654, 3219, 1498, 4543, 4112, 4264,
+	4013, 5641, 5812, 2983, 5706, 5028, 5635, 4946, 4737, 4997, 5764,
+	3575, 3473, 3848, 3247, 5042, 3436, 1417, 2607, 3035, 4124, 3791,
+	6126, 2958, 4866, 3605, 1465, 3422, 3313, 2749, 1322, 3912, 3466,
+	3167, 3529, 5990, 5957, 3818, 3685, 1389, 5650, 5798, 2817, 4698,
+	5531, 4044, 3648, 3210, 2581, 3898, 4644, 3482, 4459, 5752, 3254,
+	5051, 3599, 4533, 1482, 4818, 5822, 3044, 5596, 5780, 3944, 3460,
+	4956, 2788, 3874, 3203, 5980, 4947, 3358, 4718, 5845, 5522, 3119,
+	1425, 4629, 1385, 4546, 3673, 2826, 2544, 2927, 5018, 3868, 4032,
+	4847, 5724, 3795, 3334, 1445, 3263, 5692, 4087, 1337, 5578, 3550,
+	5642, 1362, 4874, 5817, 6155, 4727, 3825, 2720, 1395, 5496, 3028,
+	4265, 3749, 3394, 4828, 5855, 5958, 3142, 4073, 3905, 4630, 1421,
+	5035, 6135, 4808, 3966, 2963, 3606, 5555, 1462, 3318, 5772, 4938,
+	5829, 6127, 3003, 3701, 4318, 3467, 4754, 4010, 2794, 3634, 4132,
+	4049, 3819, 5587, 4747, 5651, 3518, 2991, 2756, 1431, 6144, 5660,
+	3387, 5669, 3693, 6002, 3255, 3178, 4118, 4977, 3893, 5699, 5061,
+	3954, 4939, 1368, 4957, 3921, 4738, 2905, 3854, 4094, 3248, 3429,
+	3770, 4719, 5532, 4837, 4080, 1470, 5715, 3423, 5015, 6136, 4948,
+	2589, 3987, 6116, 4383, 3611, 3339, 3264, 5693, 4319, 1490, 4967,
+	3641, 3826, 5610, 5790, 3211, 1358, 4640, 3578, 5758, 3147, 4728,
+	4857, 1402, 3439, 2827, 6166, 3738, 5533, 4125, 6020, 4631, 4867,
+	1466, 4068, 2598, 3008, 3831, 3913, 4011, 4987, 3635, 5969, 4748,
+	1499, 3125, 1390, 3679, 3861, 5837, 4709, 5597, 5504, 5661, 5023,
+	4038, 3036, 2801, 4848, 4266, 1476, 4641, 3183, 1342, 2992, 1438,
+	5043, 5643, 4829, 3223, 5700, 5823, 5781, 4113, 5735, 6013, 4141,
+	3855, 5588, 5707, 3430, 1426, 4547, 5765, 2764, 5799, 3474, 5513,
+	3607, 4621, 5019, 3045, 4267, 4384, 5725, 3468, 3798, 3656, 4838,
+	4088, 5052, 5652, 4968, 3642, 3533, 4015, 6156, 5562, 1396, 5615,
+	3686, 2933, 4320, 5029, 4998, 4045, 5759, 3256, 5970, 3649, 4858,
+	3899, 4819, 3483, 4460, 3969, 5644, 4949, 5959, 5830, 3702, 4929,
+	4133, 3680, 3862, 4875, 3362, 1432, 4039, 1386, 2969, 4739, 5672,
+	3345, 3265, 5991, 3869, 1343, 4642, 4645, 3228, 3037, 3827, 1483,
+	5653, 4950, 3554, 3922, 1363, 5818, 3876, 5708, 3153, 5846, 5766,
+	5598, 3753, 3475, 3398, 1471, 4074, 3906, 2809, 1422, 5523, 3212,
+	3990, 3614, 4958, 4268, 4729, 5773, 1446, 4749, 3538, 3046, 5062,
+	5662, 3687, 5791, 4046, 6145, 3650, 3189, 4632, 1403, 5856, 3900,
+	5599, 3694, 5701, 3484, 4461, 2940, 5036, 4119, 2818, 4978, 4849,
+	3834, 3958, 4839, 4988, 4755, 1369, 3431, 6137, 4051, 5838, 5570,
+	3367, 5024, 3774, 4321, 5948, 1477, 4081, 5716, 3014, 3870, 5868,
+	1439, 4969, 3643, 2976, 4750, 6021, 5621, 3559, 1364, 5738, 5663,
+	6014, 6003, 4142, 4859, 6138, 4096, 3758, 5579, 3582, 3403, 4075,
+	3907, 5992, 4322, 3443, 4126, 4868, 1467, 5774, 3257, 3659, 4730,
+	3914, 3863, 1491, 3432, 4018, 4959, 1391, 5030, 6146, 4999, 4385,
+	3695, 3234, 6167, 4120, 5971, 4740, 4979, 5824, 5628, 4940, 5782,
+	3266, 5709, 1370, 3047, 5767, 1500, 3476, 4860, 3779, 1427, 4548,
+	4082, 5717, 5020, 3160, 4646, 5726, 4720, 3802, 5044, 1484, 4089,
+	3688, 4850, 3021, 6157, 4047, 1397, 3651, 3879, 5981, 4840, 5847,
+	3587, 3485, 4462, 6139, 5801, 5589, 3448, 4127, 3973, 4869, 4323,
+	3196, 1447, 5831, 3703, 3915, 2984, 5710, 5053, 3373, 4134, 6004,
+	1392, 3477, 1433, 3871, 5654, 5857, 5676, 5037, 3565, 4386, 5825,
+	5783, 4741, 4756, 3923, 4970, 4830, 3652, 4054, 3409, 6128, 4960,
+	3908, 4876, 1428, 3486, 5960, 4549, 1472, 5869, 5775, 5982, 3994,
+	5727, 3618, 3807, 4090, 3380, 6158, 3267, 6147, 1398, 5792, 3696,
+	3872, 4099, 4961, 4980, 1404, 3978, 4731, 1371, 5832, 3704, 3838,
+	3241, 1492, 4989, 4135, 3029, 3785, 5063, 3416, 5839, 4083, 4851,
+	5718, 1434, 5025, 5993, 1478, 5681, 5776, 5664, 6168, 1440, 3204,
+	3924, 5742, 6148, 3593, 1501, 4143, 3038, 3697, 3454, 4128, 4870,
+	1473, 4981, 4751, 3999, 3623, 3916, 5665, 4463, 5045, 3663, 4387,
+	6022, 4022, 4841, 5793, 4971, 5719, 5031, 5636, 5000, 5804, 1405,
+	6015, 5784, 4861, 3843, 5054, 4990, 4388, 3600, 4550, 5840, 5026,
+	3461, 4972, 5728, 4871, 1479, 3813, 5645, 4091, 3917, 4647, 1441,
+	6159, 1485, 1399, 6005, 5747, 3249, 4144, 3883, 4877, 5848, 4951,
+	6149, 5833, 3705, 5785, 4752, 3668, 4136, 1448, 5983, 4027, 1435,
+	4551, 3258, 5687, 5032, 5001, 5729, 3820, 5858, 4092, 5720, 5038,
+	3925, 6160, 3487, 4464, 4757, 5064, 4058, 4389, 4005, 3629, 4742,
+	6016, 3706, 4648, 5655, 4137, 4872, 1486, 5794, 4862, 4465, 3888,
+	5694, 1406, 5849, 4103, 3849, 3424, 4991, 3926, 1449, 6023, 5841,
+	1493, 5786, 5870, 1480, 4962, 3636, 1442, 5859, 5972, 5039, 6169,
+	5730, 5753, 4852, 4145, 5795, 4758, 4982, 4063, 1502, 6161, 1407,
+	3674, 3856, 4992, 6150, 4033, 5046, 5842, 3707, 5033, 5002, 4983,
+	4466, 4108, 1443, 5808, 5702, 5760, 3469, 4146, 5994, 1494, 5055,
+	3927, 4649, 3681, 1487, 6170, 4040, 3644, 3894, 3478, 5871, 5850,
+	5003, 6006, 1503, 4878, 5796, 1450, 4552, 5047, 6151, 3864, 4993,
+	5860, 6162, 6017, 4650, 5040, 5813, 1488, 4759, 4553, 4069, 3901,
+	5056, 5851, 5731, 5065, 5768, 4147, 1451, 6140, 3689, 4114, 4973,
+	5861, 4873, 4879, 5041, 5004, 1495, 4760, 4076, 5711, 6024, 6171,
+	5797, 4651, 1504, 4554, 4121, 4863, 5066, 5995, 3909, 5852, 5048,
+	6163, 1496, 1452, 5819, 5777, 6172, 4994, 5862, 5057, 5872, 1505,
+	6025, 5721, 4761, 4084, 6152, 5049, 5005, 4880, 5826, 3698, 4129,
+	5058, 6007, 5984, 1497, 3918, 6018, 6173, 5067, 4881, 4652, 1506,
+	5787, 5863, 5050, 4762, 4984, 5834, 6026, 5068, 6019, 5059, 5996,
+	4138, 4653, 4882, 6027, 4995, 6174, 1507, 5873, 6175, 5069, 4763,
+	5843, 5060, 5853, 6028, 5874, 4883, 4884, 5070, 6164, 5071, 6029,
+	6008, 6030, 5875, 6031}
+
+// Deuce-to-Seven lowball: flushes and straight flushes, other hands of five
+// unique ranks, and other hands in the order of products.
+var DeuceToSevenFlushes = [...]DeuceToSevenScore{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 7454, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 5864, 0, 0, 0, 0, 0, 0, 0, 5865, 0, 0, 0, 5866, 0,
+	5867, 7455, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5868,
+	0, 0, 0, 0, 0, 0, 0, 5869, 0, 0, 0, 5870, 0, 5871, 5872, 0, 0, 0,
+	0, 0, 0, 0, 0, 5873, 0, 0, 0, 5874, 0, 5875, 5876, 0, 0, 0, 0,
+	5877, 0, 5878, 5879, 0, 0, 5880, 5881, 0, 7456, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5882, 0, 0, 0, 0, 0, 0, 0,
+	5883, 0, 0, 0, 5884, 0, 5885, 5886, 0, 0, 0, 0, 0, 0, 0, 0, 5887,
+	0, 0, 0, 5888, 0, 5889, 5890, 0, 0, 0, 0, 5891, 0, 5892, 5893, 0,
+	0, 5894, 5895, 0, 5896, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5897, 0, 0,
+	0, 5898, 0, 5899, 5900, 0, 0, 0, 0, 5901, 0, 5902, 5903, 0, 0,
+	5904, 5905, 0, 5906, 0, 0, 0, 0, 0, 0, 5907, 0, 5908, 5909, 0, 0,
+	5910, 5911, 0, 5912, 0, 0, 0, 0, 5913, 5914, 0, 5915, 0, 0, 0,
+	7457, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 5916, 0, 0, 0, 0, 0, 0, 0, 5917, 0, 0, 0, 5918, 0, 5919,
+	5920, 0, 0, 0, 0, 0, 0, 0, 0, 5921, 0, 0, 0, 5922, 0, 5923, 5924,
+	0, 0, 0, 0, 5925, 0, 5926, 5927, 0, 0, 5928, 5929, 0, 5930, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 5931, 0, 0, 0, 5932, 0, 5933, 5934, 0, 0,
+	0, 0, 5935, 0, 5936, 5937, 0, 0, 5938, 5939, 0, 5940, 0, 0, 0, 0,
+	0, 0, 5941, 0, 5942, 5943, 0, 0, 5944, 5945, 0, 5946, 0, 0, 0, 0,
+	5947, 5948, 0, 5949, 0, 0, 0, 5950, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 5951, 0, 0, 0, 5952, 0, 5953, 5954, 0, 0, 0, 0, 5955,
+	0, 5956, 5957, 0, 0, 5958, 5959, 0, 5960, 0, 0, 0, 0, 0, 0, 5961,
+	0, 5962, 5963, 0, 0, 5964, 5965, 0, 5966, 0, 0, 0, 0, 5967, 5968,
+	0, 5969, 0, 0, 0, 5970, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5971, 0,
+	5972, 5973, 0, 0, 5974, 5975, 0, 5976, 0, 0, 0, 0, 5977, 5978, 0,
+	5979, 0, 0, 0, 5980, 0, 0, 0, 0, 0, 0, 0, 0, 5981, 5982, 0, 5983,
+	0, 0, 0, 5984, 0, 0, 0, 0, 0, 0, 0, 7458, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	5985, 0, 0, 0, 0, 0, 0, 0, 5986, 0, 0, 0, 5987, 0, 5988, 5989, 0,
+	0, 0, 0, 0, 0, 0, 0, 5990, 0, 0, 0, 5991, 0, 5992, 5993, 0, 0, 0,
+	0, 5994, 0, 5995, 5996, 0, 0, 5997, 5998, 0, 5999, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 6000, 0, 0, 0, 6001, 0, 6002, 6003, 0, 0, 0, 0,
+	6004, 0, 6005, 6006, 0, 0, 6007, 6008, 0, 6009, 0, 0, 0, 0, 0, 0,
+	6010, 0, 6011, 6012, 0, 0, 6013, 6014, 0, 6015, 0, 0, 0, 0, 6016,
+	6017, 0, 6018, 0, 0, 0, 6019, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 6020, 0, 0, 0, 6021, 0, 6022, 6023, 0, 0, 0, 0, 6024, 0,
+	6025, 6026, 0, 0, 6027, 6028, 0, 6029, 0, 0, 0, 0, 0, 0, 6030, 0,
+	6031, 6032, 0, 0, 6033, 6034, 0, 6035, 0, 0, 0, 0, 6036, 6037, 0,
+	6038, 0, 0, 0, 6039, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6040, 0, 6041,
+	6042, 0, 0, 6043, 6044, 0, 6045, 0, 0, 0, 0, 6046, 6047, 0, 6048,
+	0, 0, 0, 6049, 0, 0, 0, 0, 0, 0, 0, 0, 6050, 6051, 0, 6052, 0, 0,
+	0, 6053, 0, 0, 0, 0, 0, 0, 0, 6054, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6055, 0, 0, 0, 6056, 0, 6057,
+	6058, 0, 0, 0, 0, 6059, 0, 6060, 6061, 0, 0, 6062, 6063, 0, 6064,
+	0, 0, 0, 0, 0, 0, 6065, 0, 6066, 6067, 0, 0, 6068, 6069, 0, 6070,
+	0, 0, 0, 0, 6071, 6072, 0, 6073, 0, 0, 0, 6074, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 6075, 0, 6076, 6077, 0, 0, 6078, 6079, 0, 6080, 0, 0,
+	0, 0, 6081, 6082, 0, 6083, 0, 0, 0, 6084, 0, 0, 0, 0, 0, 0, 0, 0,
+	6085, 6086, 0, 6087, 0, 0, 0, 6088, 0, 0, 0, 0, 0, 0, 0, 6089, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6090, 0, 6091,
+	6092, 0, 0, 6093, 6094, 0, 6095, 0, 0, 0, 0, 6096, 6097, 0, 6098,
+	0, 0, 0, 6099, 0, 0, 0, 0, 0, 0, 0, 0, 6100, 6101, 0, 6102, 0, 0,
+	0, 6103, 0, 0, 0, 0, 0, 0, 0, 6104, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 6105, 6106, 0, 6107, 0, 0, 0, 6108, 0, 0, 0, 0,
+	0, 0, 0, 6109, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7459,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 6110, 0, 0, 0, 0, 0, 0, 0, 6111, 0, 0, 0, 6112, 0, 6113,
+	6114, 0, 0, 0, 0, 0, 0, 0, 0, 6115, 0, 0, 0, 6116, 0, 6117, 6118,
+	0, 0, 0, 0, 6119, 0, 6120, 6121, 0, 0, 6122, 6123, 0, 6124, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 6125, 0, 0, 0, 6126, 0, 6127, 6128, 0, 0,
+	0, 0, 6129, 0, 6130, 6131, 0, 0, 6132, 6133, 0, 6134, 0, 0, 0, 0,
+	0, 0, 6135, 0, 6136, 6137, 0, 0, 6138, 6139, 0, 6140, 0, 0, 0, 0,
+	6141, 6142, 0, 6143, 0, 0, 0, 6144, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 6145, 0, 0, 0, 6146, 0, 6147, 6148, 0, 0, 0, 0, 6149,
+	0, 6150, 6151, 0, 0, 6152, 6153, 0, 6154, 0, 0, 0, 0, 0, 0, 6155,
+	0, 6156, 6157, 0, 0, 6158, 6159, 0, 6160, 0, 0, 0, 0, 6161, 6162,
+	0, 6163, 0, 0, 0, 6164, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6165, 0,
+	6166, 6167, 0, 0, 6168, 6169, 0, 6170, 0, 0, 0, 0, 6171, 6172, 0,
+	6173, 0, 0, 0, 6174, 0, 0, 0, 0, 0, 0, 0, 0, 6175, 6176, 0, 6177,
+	0, 0, 0, 6178, 0, 0, 0, 0, 0, 0, 0, 6179, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6180, 0, 0, 0, 6181, 0,
+	6182, 6183, 0, 0, 0, 0, 6184, 0, 6185, 6186, 0, 0, 6187, 6188, 0,
+	6189, 0, 0, 0, 0, 0, 0, 6190, 0, 6191, 6192, 0, 0, 6193, 6194, 0,
+	6195, 0, 0, 0, 0, 6196, 6197, 0, 6198, 0, 0, 0, 6199, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 6200, 0, 6201, 6202, 0, 0, 6203, 6204, 0, 6205,
+	0, 0, 0, 0, 6206, 6207, 0, 6208, 0, 0, 0, 6209, 0, 0, 0, 0, 0, 0,
+	0, 0, 6210, 6211, 0, 6212, 0, 0, 0, 6213, 0, 0, 0, 0, 0, 0, 0,
+	6214, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6215,
+	0, 6216, 6217, 0, 0, 6218, 6219, 0, 6220, 0, 0, 0, 0, 6221, 6222,
+	0, 6223, 0, 0, 0, 6224, 0, 0, 0, 0, 0, 0, 0, 0, 6225, 6226, 0,
+	6227, 0, 0, 0, 6228, 0, 0, 0, 0, 0, 0, 0, 6229, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6230, 6231, 0, 6232, 0, 0, 0, 6233,
+	0, 0, 0, 0, 0, 0, 0, 6234, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 6235, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6236,
+	0, 0, 0, 6237, 0, 6238, 6239, 0, 0, 0, 0, 6240, 0, 6241, 6242, 0,
+	0, 6243, 6244, 0, 6245, 0, 0, 0, 0, 0, 0, 6246, 0, 6247, 6248, 0,
+	0, 6249, 6250, 0, 6251, 0, 0, 0, 0, 6252, 6253, 0, 6254, 0, 0, 0,
+	6255, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6256, 0, 6257, 6258, 0, 0,
+	6259, 6260, 0, 6261, 0, 0, 0, 0, 6262, 6263, 0, 6264, 0, 0, 0,
+	6265, 0, 0, 0, 0, 0, 0, 0, 0, 6266, 6267, 0, 6268, 0, 0, 0, 6269,
+	0, 0, 0, 0, 0, 0, 0, 6270, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 6271, 0, 6272, 6273, 0, 0, 6274, 6275, 0, 6276, 0,
+	0, 0, 0, 6277, 6278, 0, 6279, 0, 0, 0, 6280, 0, 0, 0, 0, 0, 0, 0,
+	0, 6281, 6282, 0, 6283, 0, 0, 0, 6284, 0, 0, 0, 0, 0, 0, 0, 6285,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6286, 6287, 0,
+	6288, 0, 0, 0, 6289, 0, 0, 0, 0, 0, 0, 0, 6290, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 6291, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 6292, 0, 6293, 6294, 0, 0, 6295, 6296, 0, 6297, 0, 0, 0, 0,
+	6298, 6299, 0, 6300, 0, 0, 0, 6301, 0, 0, 0, 0, 0, 0, 0, 0, 6302,
+	6303, 0, 6304, 0, 0, 0, 6305, 0, 0, 0, 0, 0, 0, 0, 6306, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6307, 6308, 0, 6309, 0, 0,
+	0, 6310, 0, 0, 0, 0, 0, 0, 0, 6311, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 6312, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6313, 6314, 0,
+	6315, 0, 0, 0, 6316, 0, 0, 0, 0, 0, 0, 0, 6317, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 6318, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7460,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6319, 0, 0, 0, 0, 0, 0, 0,
+	6320, 0, 0, 0, 6321, 0, 6322, 6323, 0, 0, 0, 0, 0, 0, 0, 0, 6324,
+	0, 0, 0, 6325, 0, 6326, 6327, 0, 0, 0, 0, 6328, 0, 6329, 6330, 0,
+	0, 6331, 6332, 0, 6333, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6334, 0, 0,
+	0, 6335, 0, 6336, 6337, 0, 0, 0, 0, 6338, 0, 6339, 6340, 0, 0,
+	6341, 6342, 0, 6343, 0, 0, 0, 0, 0, 0, 6344, 0, 6345, 6346, 0, 0,
+	6347, 6348, 0, 6349, 0, 0, 0, 0, 6350, 6351, 0, 6352, 0, 0, 0,
+	6353, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6354, 0, 0, 0,
+	6355, 0, 6356, 6357, 0, 0, 0, 0, 6358, 0, 6359, 6360, 0, 0, 6361,
+	6362, 0, 6363, 0, 0, 0, 0, 0, 0, 6364, 0, 6365, 6366, 0, 0, 6367,
+	6368, 0, 6369, 0, 0, 0, 0, 6370, 6371, 0, 6372, 0, 0, 0, 6373, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 6374, 0, 6375, 6376, 0, 0, 6377, 6378,
+	0, 6379, 0, 0, 0, 0, 6380, 6381, 0, 6382, 0, 0, 0, 6383, 0, 0, 0,
+	0, 0, 0, 0, 0, 6384, 6385, 0, 6386, 0, 0, 0, 6387, 0, 0, 0, 0, 0,
+	0, 0, 6388, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 6389, 0, 0, 0, 6390, 0, 6391, 6392, 0, 0, 0, 0, 6393,
+	0, 6394, 6395, 0, 0, 6396, 6397, 0, 6398, 0, 0, 0, 0, 0, 0, 6399,
+	0, 6400, 6401, 0, 0, 6402, 6403, 0, 6404, 0, 0, 0, 0, 6405, 6406,
+	0, 6407, 0, 0, 0, 6408, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6409, 0,
+	6410, 6411, 0, 0, 6412, 6413, 0, 6414, 0, 0, 0, 0, 6415, 6416, 0,
+	6417, 0, 0, 0, 6418, 0, 0, 0, 0, 0, 0, 0, 0, 6419, 6420, 0, 6421,
+	0, 0, 0, 6422, 0, 0, 0, 0, 0, 0, 0, 6423, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6424, 0, 6425, 6426, 0, 0, 6427,
+	6428, 0, 6429, 0, 0, 0, 0, 6430, 6431, 0, 6432, 0, 0, 0, 6433, 0,
+	0, 0, 0, 0, 0, 0, 0, 6434, 6435, 0, 6436, 0, 0, 0, 6437, 0, 0, 0,
+	0, 0, 0, 0, 6438, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	6439, 6440, 0, 6441, 0, 0, 0, 6442, 0, 0, 0, 0, 0, 0, 0, 6443, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6444, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6445, 0, 0, 0, 6446, 0, 6447, 6448,
+	0, 0, 0, 0, 6449, 0, 6450, 6451, 0, 0, 6452, 6453, 0, 6454, 0, 0,
+	0, 0, 0, 0, 6455, 0, 6456, 6457, 0, 0, 6458, 6459, 0, 6460, 0, 0,
+	0, 0, 6461, 6462, 0, 6463, 0, 0, 0, 6464, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 6465, 0, 6466, 6467, 0, 0, 6468, 6469, 0, 6470, 0, 0, 0, 0,
+	6471, 6472, 0, 6473, 0, 0, 0, 6474, 0, 0, 0, 0, 0, 0, 0, 0, 6475,
+	6476, 0, 6477, 0, 0, 0, 6478, 0, 0, 0, 0, 0, 0, 0, 6479, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6480, 0, 6481, 6482,
+	0, 0, 6483, 6484, 0, 6485, 0, 0, 0, 0, 6486, 6487, 0, 6488, 0, 0,
+	0, 6489, 0, 0, 0, 0, 0, 0, 0, 0, 6490, 6491, 0, 6492, 0, 0, 0,
+	6493, 0, 0, 0, 0, 0, 0, 0, 6494, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 6495, 6496, 0, 6497, 0, 0, 0, 6498, 0, 0, 0, 0, 0,
+	0, 0, 6499, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6500, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6501, 0, 6502, 6503, 0, 0, 6504,
+	6505, 0, 6506, 0, 0, 0, 0, 6507, 6508, 0, 6509, 0, 0, 0, 6510, 0,
+	0, 0, 0, 0, 0, 0, 0, 6511, 6512, 0, 6513, 0, 0, 0, 6514, 0, 0, 0,
+	0, 0, 0, 0, 6515, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	6516, 6517, 0, 6518, 0, 0, 0, 6519, 0, 0, 0, 0, 0, 0, 0, 6520, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6521, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 6522, 6523, 0, 6524, 0, 0, 0, 6525, 0, 0, 0, 0, 0, 0,
+	0, 6526, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6527, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 6528, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6529, 0, 0, 0, 6530, 0,
+	6531, 6532, 0, 0, 0, 0, 6533, 0, 6534, 6535, 0, 0, 6536, 6537, 0,
+	6538, 0, 0, 0, 0, 0, 0, 6539, 0, 6540, 6541, 0, 0, 6542, 6543, 0,
+	6544, 0, 0, 0, 0, 6545, 6546, 0, 6547, 0, 0, 0, 6548, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 6549, 0, 6550, 6551, 0, 0, 6552, 6553, 0, 6554,
+	0, 0, 0, 0, 6555, 6556, 0, 6557, 0, 0, 0, 6558, 0, 0, 0, 0, 0, 0,
+	0, 0, 6559, 6560, 0, 6561, 0, 0, 0, 6562, 0, 0, 0, 0, 0, 0, 0,
+	6563, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6564,
+	0, 6565, 6566, 0, 0, 6567, 6568, 0, 6569, 0, 0, 0, 0, 6570, 6571,
+	0, 6572, 0, 0, 0, 6573, 0, 0, 0, 0, 0, 0, 0, 0, 6574, 6575, 0,
+	6576, 0, 0, 0, 6577, 0, 0, 0, 0, 0, 0, 0, 6578, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6579, 6580, 0, 6581, 0, 0, 0, 6582,
+	0, 0, 0, 0, 0, 0, 0, 6583, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 6584, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6585, 0, 6586,
+	6587, 0, 0, 6588, 6589, 0, 6590, 0, 0, 0, 0, 6591, 6592, 0, 6593,
+	0, 0, 0, 6594, 0, 0, 0, 0, 0, 0, 0, 0, 6595, 6596, 0, 6597, 0, 0,
+	0, 6598, 0, 0, 0, 0, 0, 0, 0, 6599, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 6600, 6601, 0, 6602, 0, 0, 0, 6603, 0, 0, 0, 0,
+	0, 0, 0, 6604, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6605,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6606, 6607, 0, 6608, 0, 0, 0, 6609,
+	0, 0, 0, 0, 0, 0, 0, 6610, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 6611, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6612, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6613, 0, 6614, 6615,
+	0, 0, 6616, 6617, 0, 6618, 0, 0, 0, 0, 6619, 6620, 0, 6621, 0, 0,
+	0, 6622, 0, 0, 0, 0, 0, 0, 0, 0, 6623, 6624, 0, 6625, 0, 0, 0,
+	6626, 0, 0, 0, 0, 0, 0, 0, 6627, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 6628, 6629, 0, 6630, 0, 0, 0, 6631, 0, 0, 0, 0, 0,
+	0, 0, 6632, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6633, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 6634, 6635, 0, 6636, 0, 0, 0, 6637, 0,
+	0, 0, 0, 0, 0, 0, 6638, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 6639, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6640, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6641, 6642, 0, 6643, 0, 0, 0,
+	6644, 0, 0, 0, 0, 0, 0, 0, 6645, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 6646, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6647, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7461, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 6648, 0, 0, 0, 0, 0, 0, 0, 6649, 0, 0, 0, 6650, 0,
+	6651, 6652, 0, 0, 0, 0, 0, 0, 0, 0, 6653, 0, 0, 0, 6654, 0, 6655,
+	6656, 0, 0, 0, 0, 6657, 0, 6658, 6659, 0, 0, 6660, 6661, 0, 6662,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6663, 0, 0, 0, 6664, 0, 6665, 6666,
+	0, 0, 0, 0, 6667, 0, 6668, 6669, 0, 0, 6670, 6671, 0, 6672, 0, 0,
+	0, 0, 0, 0, 6673, 0, 6674, 6675, 0, 0, 6676, 6677, 0, 6678, 0, 0,
+	0, 0, 6679, 6680, 0, 6681, 0, 0, 0, 6682, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 6683, 0, 0, 0, 6684, 0, 6685, 6686, 0, 0, 0, 0,
+	6687, 0, 6688, 6689, 0, 0, 6690, 6691, 0, 6692, 0, 0, 0, 0, 0, 0,
+	6693, 0, 6694, 6695, 0, 0, 6696, 6697, 0, 6698, 0, 0, 0, 0, 6699,
+	6700, 0, 6701, 0, 0, 0, 6702, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6703,
+	0, 6704, 6705, 0, 0, 6706, 6707, 0, 6708, 0, 0, 0, 0, 6709, 6710,
+	0, 6711, 0, 0, 0, 6712, 0, 0, 0, 0, 0, 0, 0, 0, 6713, 6714, 0,
+	6715, 0, 0, 0, 6716, 0, 0, 0, 0, 0, 0, 0, 6717, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6718, 0, 0, 0,
+	6719, 0, 6720, 6721, 0, 0, 0, 0, 6722, 0, 6723, 6724, 0, 0, 6725,
+	6726, 0, 6727, 0, 0, 0, 0, 0, 0, 6728, 0, 6729, 6730, 0, 0, 6731,
+	6732, 0, 6733, 0, 0, 0, 0, 6734, 6735, 0, 6736, 0, 0, 0, 6737, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 6738, 0, 6739, 6740, 0, 0, 6741, 6742,
+	0, 6743, 0, 0, 0, 0, 6744, 6745, 0, 6746, 0, 0, 0, 6747, 0, 0, 0,
+	0, 0, 0, 0, 0, 6748, 6749, 0, 6750, 0, 0, 0, 6751, 0, 0, 0, 0, 0,
+	0, 0, 6752, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	6753, 0, 6754, 6755, 0, 0, 6756, 6757, 0, 6758, 0, 0, 0, 0, 6759,
+	6760, 0, 6761, 0, 0, 0, 6762, 0, 0, 0, 0, 0, 0, 0, 0, 6763, 6764,
+	0, 6765, 0, 0, 0, 6766, 0, 0, 0, 0, 0, 0, 0, 6767, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6768, 6769, 0, 6770, 0, 0, 0,
+	6771, 0, 0, 0, 0, 0, 0, 0, 6772, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 6773, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	6774, 0, 0, 0, 6775, 0, 6776, 6777, 0, 0, 0, 0, 6778, 0, 6779,
+	6780, 0, 0, 6781, 6782, 0, 6783, 0, 0, 0, 0, 0, 0, 6784, 0, 6785,
+	6786, 0, 0, 6787, 6788, 0, 6789, 0, 0, 0, 0, 6790, 6791, 0, 6792,
+	0, 0, 0, 6793, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6794, 0, 6795, 6796,
+	0, 0, 6797, 6798, 0, 6799, 0, 0, 0, 0, 6800, 6801, 0, 6802, 0, 0,
+	0, 6803, 0, 0, 0, 0, 0, 0, 0, 0, 6804, 6805, 0, 6806, 0, 0, 0,
+	6807, 0, 0, 0, 0, 0, 0, 0, 6808, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 6809, 0, 6810, 6811, 0, 0, 6812, 6813, 0,
+	6814, 0, 0, 0, 0, 6815, 6816, 0, 6817, 0, 0, 0, 6818, 0, 0, 0, 0,
+	0, 0, 0, 0, 6819, 6820, 0, 6821, 0, 0, 0, 6822, 0, 0, 0, 0, 0, 0,
+	0, 6823, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6824,
+	6825, 0, 6826, 0, 0, 0, 6827, 0, 0, 0, 0, 0, 0, 0, 6828, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6829, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 6830, 0, 6831, 6832, 0, 0, 6833, 6834, 0, 6835, 0, 0,
+	0, 0, 6836, 6837, 0, 6838, 0, 0, 0, 6839, 0, 0, 0, 0, 0, 0, 0, 0,
+	6840, 6841, 0, 6842, 0, 0, 0, 6843, 0, 0, 0, 0, 0, 0, 0, 6844, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6845, 6846, 0, 6847,
+	0, 0, 0, 6848, 0, 0, 0, 0, 0, 0, 0, 6849, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 6850, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6851,
+	6852, 0, 6853, 0, 0, 0, 6854, 0, 0, 0, 0, 0, 0, 0, 6855, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6856, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 6857, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 6858, 0, 0, 0, 6859, 0, 6860, 6861, 0, 0, 0,
+	0, 6862, 0, 6863, 6864, 0, 0, 6865, 6866, 0, 6867, 0, 0, 0, 0, 0,
+	0, 6868, 0, 6869, 6870, 0, 0, 6871, 6872, 0, 6873, 0, 0, 0, 0,
+	6874, 6875, 0, 6876, 0, 0, 0, 6877, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	6878, 0, 6879, 6880, 0, 0, 6881, 6882, 0, 6883, 0, 0, 0, 0, 6884,
+	6885, 0, 6886, 0, 0, 0, 6887, 0, 0, 0, 0, 0, 0, 0, 0, 6888, 6889,
+	0, 6890, 0, 0, 0, 6891, 0, 0, 0, 0, 0, 0, 0, 6892, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6893, 0, 6894, 6895, 0, 0,
+	6896, 6897, 0, 6898, 0, 0, 0, 0, 6899, 6900, 0, 6901, 0, 0, 0,
+	6902, 0, 0, 0, 0, 0, 0, 0, 0, 6903, 6904, 0, 6905, 0, 0, 0, 6906,
+	0, 0, 0, 0, 0, 0, 0, 6907, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 6908, 6909, 0, 6910, 0, 0, 0, 6911, 0, 0, 0, 0, 0, 0, 0,
+	6912, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6913, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 6914, 0, 6915, 6916, 0, 0, 6917, 6918,
+	0, 6919, 0, 0, 0, 0, 6920, 6921, 0, 6922, 0, 0, 0, 6923, 0, 0, 0,
+	0, 0, 0, 0, 0, 6924, 6925, 0, 6926, 0, 0, 0, 6927, 0, 0, 0, 0, 0,
+	0, 0, 6928, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6929,
+	6930, 0, 6931, 0, 0, 0, 6932, 0, 0, 0, 0, 0, 0, 0, 6933, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6934, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 6935, 6936, 0, 6937, 0, 0, 0, 6938, 0, 0, 0, 0, 0, 0, 0,
+	6939, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6940, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 6941, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 6942, 0, 6943, 6944, 0, 0, 6945, 6946, 0,
+	6947, 0, 0, 0, 0, 6948, 6949, 0, 6950, 0, 0, 0, 6951, 0, 0, 0, 0,
+	0, 0, 0, 0, 6952, 6953, 0, 6954, 0, 0, 0, 6955, 0, 0, 0, 0, 0, 0,
+	0, 6956, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6957,
+	6958, 0, 6959, 0, 0, 0, 6960, 0, 0, 0, 0, 0, 0, 0, 6961, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6962, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 6963, 6964, 0, 6965, 0, 0, 0, 6966, 0, 0, 0, 0, 0, 0, 0,
+	6967, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6968, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 6969, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 6970, 6971, 0, 6972, 0, 0, 0, 6973, 0, 0, 0, 0,
+	0, 0, 0, 6974, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6975,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 6976, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 6977, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 6978, 0, 0, 0, 6979, 0, 6980,
+	6981, 0, 0, 0, 0, 6982, 0, 6983, 6984, 0, 0, 6985, 6986, 0, 6987,
+	0, 0, 0, 0, 0, 0, 6988, 0, 6989, 6990, 0, 0, 6991, 6992, 0, 6993,
+	0, 0, 0, 0, 6994, 6995, 0, 6996, 0, 0, 0, 6997, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 6998, 0, 6999, 7000, 0, 0, 7001, 7002, 0, 7003, 0, 0,
+	0, 0, 7004, 7005, 0, 7006, 0, 0, 0, 7007, 0, 0, 0, 0, 0, 0, 0, 0,
+	7008, 7009, 0, 7010, 0, 0, 0, 7011, 0, 0, 0, 0, 0, 0, 0, 7012, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7013, 0, 7014,
+	7015, 0, 0, 7016, 7017, 0, 7018, 0, 0, 0, 0, 7019, 7020, 0, 7021,
+	0, 0, 0, 7022, 0, 0, 0, 0, 0, 0, 0, 0, 7023, 7024, 0, 7025, 0, 0,
+	0, 7026, 0, 0, 0, 0, 0, 0, 0, 7027, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 7028, 7029, 0, 7030, 0, 0, 0, 7031, 0, 0, 0, 0,
+	0, 0, 0, 7032, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7033,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7034, 0, 7035, 7036, 0, 0,
+	7037, 7038, 0, 7039, 0, 0, 0, 0, 7040, 7041, 0, 7042, 0, 0, 0,
+	7043, 0, 0, 0, 0, 0, 0, 0, 0, 7044, 7045, 0, 7046, 0, 0, 0, 7047,
+	0, 0, 0, 0, 0, 0, 0, 7048, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 7049, 7050, 0, 7051, 0, 0, 0, 7052, 0, 0, 0, 0, 0, 0, 0,
+	7053, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7054, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 7055, 7056, 0, 7057, 0, 0, 0, 7058, 0, 0, 0,
+	0, 0, 0, 0, 7059, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	7060, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7061, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7062, 0, 7063, 7064, 0, 0,
+	7065, 7066, 0, 7067, 0, 0, 0, 0, 7068, 7069, 0, 7070, 0, 0, 0,
+	7071, 0, 0, 0, 0, 0, 0, 0, 0, 7072, 7073, 0, 7074, 0, 0, 0, 7075,
+	0, 0, 0, 0, 0, 0, 0, 7076, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 7077, 7078, 0, 7079, 0, 0, 0, 7080, 0, 0, 0, 0, 0, 0, 0,
+	7081, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7082, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 7083, 7084, 0, 7085, 0, 0, 0, 7086, 0, 0, 0,
+	0, 0, 0, 0, 7087, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	7088, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7089, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7090, 7091, 0, 7092, 0, 0, 0,
+	7093, 0, 0, 0, 0, 0, 0, 0, 7094, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 7095, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7096, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7097, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7098, 0, 7099, 7100, 0,
+	0, 7101, 7102, 0, 7103, 0, 0, 0, 0, 7104, 7105, 0, 7106, 0, 0, 0,
+	7107, 0, 0, 0, 0, 0, 0, 0, 0, 7108, 7109, 0, 7110, 0, 0, 0, 7111,
+	0, 0, 0, 0, 0, 0, 0, 7112, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 7113, 7114, 0, 7115, 0, 0, 0, 7116, 0, 0, 0, 0, 0, 0, 0,
+	7117, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7118, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 7119, 7120, 0, 7121, 0, 0, 0, 7122, 0, 0, 0,
+	0, 0, 0, 0, 7123, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	7124, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7125, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7126, 7127, 0, 7128, 0, 0, 0,
+	7129, 0, 0, 0, 0, 0, 0, 0, 7130, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 7131, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7132, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7133, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7134, 7135, 0, 7136, 0, 0, 0,
+	7137, 0, 0, 0, 0, 0, 0, 0, 7138, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 7139, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7140, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7141, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 7462}
+
+var DeuceToSeven5 = [...]DeuceToSevenScore{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 5855, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 3, 0, 4, 5856, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0,
+	0, 6, 0, 0, 0, 7, 0, 8, 9, 0, 0, 0, 0, 0, 0, 0, 0, 10, 0, 0, 0,
+	11, 0, 12, 13, 0, 0, 0, 0, 14, 0, 15, 16, 0, 0, 17, 18, 0, 5857,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 19, 0, 0,
+	0, 0, 0, 0, 0, 20, 0, 0, 0, 21, 0, 22, 23, 0, 0, 0, 0, 0, 0, 0,
+	0, 24, 0, 0, 0, 25, 0, 26, 27, 0, 0, 0, 0, 28, 0, 29, 30, 0, 0,
+	31, 32, 0, 33, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 34, 0, 0, 0, 35, 0,
+	36, 37, 0, 0, 0, 0, 38, 0, 39, 40, 0, 0, 41, 42, 0, 43, 0, 0, 0,
+	0, 0, 0, 44, 0, 45, 46, 0, 0, 47, 48, 0, 49, 0, 0, 0, 0, 50, 51,
+	0, 52, 0, 0, 0, 5858, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 53, 0, 0, 0, 0, 0, 0, 0, 54, 0, 0, 0, 55,
+	0, 56, 57, 0, 0, 0, 0, 0, 0, 0, 0, 58, 0, 0, 0, 59, 0, 60, 61, 0,
+	0, 0, 0, 62, 0, 63, 64, 0, 0, 65, 66, 0, 67, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 68, 0, 0, 0, 69, 0, 70, 71, 0, 0, 0, 0, 72, 0, 73, 74,
+	0, 0, 75, 76, 0, 77, 0, 0, 0, 0, 0, 0, 78, 0, 79, 80, 0, 0, 81,
+	82, 0, 83, 0, 0, 0, 0, 84, 85, 0, 86, 0, 0, 0, 87, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 88, 0, 0, 0, 89, 0, 90, 91, 0, 0, 0,
+	0, 92, 0, 93, 94, 0, 0, 95, 96, 0, 97, 0, 0, 0, 0, 0, 0, 98, 0,
+	99, 100, 0, 0, 101, 102, 0, 103, 0, 0, 0, 0, 104, 105, 0, 106, 0,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 108, 0, 109, 110, 0, 0,
+	111, 112, 0, 113, 0, 0, 0, 0, 114, 115, 0, 116, 0, 0, 0, 117, 0,
+	0, 0, 0, 0, 0, 0, 0, 118, 119, 0, 120, 0, 0, 0, 121, 0, 0, 0, 0,
+	0, 0, 0, 5859, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 122, 0, 0, 0, 0, 0, 0, 0,
+	123, 0, 0, 0, 124, 0, 125, 126, 0, 0, 0, 0, 0, 0, 0, 0, 127, 0,
+	0, 0, 128, 0, 129, 130, 0, 0, 0, 0, 131, 0, 132, 133, 0, 0, 134,
+	135, 0, 136, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 137, 0, 0, 0, 138, 0,
+	139, 140, 0, 0, 0, 0, 141, 0, 142, 143, 0, 0, 144, 145, 0, 146,
+	0, 0, 0, 0, 0, 0, 147, 0, 148, 149, 0, 0, 150, 151, 0, 152, 0, 0,
+	0, 0, 153, 154, 0, 155, 0, 0, 0, 156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 157, 0, 0, 0, 158, 0, 159, 160, 0, 0, 0, 0, 161,
+	0, 162, 163, 0, 0, 164, 165, 0, 166, 0, 0, 0, 0, 0, 0, 167, 0,
+	168, 169, 0, 0, 170, 171, 0, 172, 0, 0, 0, 0, 173, 174, 0, 175,
+	0, 0, 0, 176, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 177, 0, 178, 179, 0,
+	0, 180, 181, 0, 182, 0, 0, 0, 0, 183, 184, 0, 185, 0, 0, 0, 186,
+	0, 0, 0, 0, 0, 0, 0, 0, 187, 188, 0, 189, 0, 0, 0, 190, 0, 0, 0,
+	0, 0, 0, 0, 191, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 192, 0, 0, 0, 193, 0, 194, 195, 0, 0, 0, 0,
+	196, 0, 197, 198, 0, 0, 199, 200, 0, 201, 0, 0, 0, 0, 0, 0, 202,
+	0, 203, 204, 0, 0, 205, 206, 0, 207, 0, 0, 0, 0, 208, 209, 0,
+	210, 0, 0, 0, 211, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 212, 0, 213,
+	214, 0, 0, 215, 216, 0, 217, 0, 0, 0, 0, 218, 219, 0, 220, 0, 0,
+	0, 221, 0, 0, 0, 0, 0, 0, 0, 0, 222, 223, 0, 224, 0, 0, 0, 225,
+	0, 0, 0, 0, 0, 0, 0, 226, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 227, 0, 228, 229, 0, 0, 230, 231, 0, 232, 0, 0, 0,
+	0, 233, 234, 0, 235, 0, 0, 0, 236, 0, 0, 0, 0, 0, 0, 0, 0, 237,
+	238, 0, 239, 0, 0, 0, 240, 0, 0, 0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 242, 243, 0, 244, 0, 0, 0,
+	245, 0, 0, 0, 0, 0, 0, 0, 246, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 5860, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 247, 0, 0, 0, 0, 0, 0, 0, 248, 0, 0, 0,
+	249, 0, 250, 251, 0, 0, 0, 0, 0, 0, 0, 0, 252, 0, 0, 0, 253, 0,
+	254, 255, 0, 0, 0, 0, 256, 0, 257, 258, 0, 0, 259, 260, 0, 261,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 262, 0, 0, 0, 263, 0, 264, 265, 0,
+	0, 0, 0, 266, 0, 267, 268, 0, 0, 269, 270, 0, 271, 0, 0, 0, 0, 0,
+	0, 272, 0, 273, 274, 0, 0, 275, 276, 0, 277, 0, 0, 0, 0, 278,
+	279, 0, 280, 0, 0, 0, 281, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 0, 0, 0, 283, 0, 284, 285, 0, 0, 0, 0, 286, 0, 287, 288,
+	0, 0, 289, 290, 0, 291, 0, 0, 0, 0, 0, 0, 292, 0, 293, 294, 0, 0,
+	295, 296, 0, 297, 0, 0, 0, 0, 298, 299, 0, 300, 0, 0, 0, 301, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302, 0, 303, 304, 0, 0, 305, 306, 0,
+	307, 0, 0, 0, 0, 308, 309, 0, 310, 0, 0, 0, 311, 0, 0, 0, 0, 0,
+	0, 0, 0, 312, 313, 0, 314, 0, 0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
+	316, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 317, 0, 0, 0, 318, 0, 319, 320, 0, 0, 0, 0, 321, 0, 322,
+	323, 0, 0, 324, 325, 0, 326, 0, 0, 0, 0, 0, 0, 327, 0, 328, 329,
+	0, 0, 330, 331, 0, 332, 0, 0, 0, 0, 333, 334, 0, 335, 0, 0, 0,
+	336, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 337, 0, 338, 339, 0, 0, 340,
+	341, 0, 342, 0, 0, 0, 0, 343, 344, 0, 345, 0, 0, 0, 346, 0, 0, 0,
+	0, 0, 0, 0, 0, 347, 348, 0, 349, 0, 0, 0, 350, 0, 0, 0, 0, 0, 0,
+	0, 351, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	352, 0, 353, 354, 0, 0, 355, 356, 0, 357, 0, 0, 0, 0, 358, 359,
+	0, 360, 0, 0, 0, 361, 0, 0, 0, 0, 0, 0, 0, 0, 362, 363, 0, 364,
+	0, 0, 0, 365, 0, 0, 0, 0, 0, 0, 0, 366, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 367, 368, 0, 369, 0, 0, 0, 370, 0, 0, 0,
+	0, 0, 0, 0, 371, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	372, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 373, 0, 0,
+	0, 374, 0, 375, 376, 0, 0, 0, 0, 377, 0, 378, 379, 0, 0, 380,
+	381, 0, 382, 0, 0, 0, 0, 0, 0, 383, 0, 384, 385, 0, 0, 386, 387,
+	0, 388, 0, 0, 0, 0, 389, 390, 0, 391, 0, 0, 0, 392, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 393, 0, 394, 395, 0, 0, 396, 397, 0, 398, 0, 0,
+	0, 0, 399, 400, 0, 401, 0, 0, 0, 402, 0, 0, 0, 0, 0, 0, 0, 0,
+	403, 404, 0, 405, 0, 0, 0, 406, 0, 0, 0, 0, 0, 0, 0, 407, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 408, 0, 409, 410,
+	0, 0, 411, 412, 0, 413, 0, 0, 0, 0, 414, 415, 0, 416, 0, 0, 0,
+	417, 0, 0, 0, 0, 0, 0, 0, 0, 418, 419, 0, 420, 0, 0, 0, 421, 0,
+	0, 0, 0, 0, 0, 0, 422, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 423, 424, 0, 425, 0, 0, 0, 426, 0, 0, 0, 0, 0, 0, 0, 427,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 428, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 429, 0, 430, 431, 0, 0, 432, 433, 0, 434, 0,
+	0, 0, 0, 435, 436, 0, 437, 0, 0, 0, 438, 0, 0, 0, 0, 0, 0, 0, 0,
+	439, 440, 0, 441, 0, 0, 0, 442, 0, 0, 0, 0, 0, 0, 0, 443, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 444, 445, 0, 446, 0, 0,
+	0, 447, 0, 0, 0, 0, 0, 0, 0, 448, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 449, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 450, 451, 0,
+	452, 0, 0, 0, 453, 0, 0, 0, 0, 0, 0, 0, 454, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 455, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5861, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 456, 0, 0, 0, 0, 0, 0, 0, 457,
+	0, 0, 0, 458, 0, 459, 460, 0, 0, 0, 0, 0, 0, 0, 0, 461, 0, 0, 0,
+	462, 0, 463, 464, 0, 0, 0, 0, 465, 0, 466, 467, 0, 0, 468, 469,
+	0, 470, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 471, 0, 0, 0, 472, 0, 473,
+	474, 0, 0, 0, 0, 475, 0, 476, 477, 0, 0, 478, 479, 0, 480, 0, 0,
+	0, 0, 0, 0, 481, 0, 482, 483, 0, 0, 484, 485, 0, 486, 0, 0, 0, 0,
+	487, 488, 0, 489, 0, 0, 0, 490, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 491, 0, 0, 0, 492, 0, 493, 494, 0, 0, 0, 0, 495, 0, 496,
+	497, 0, 0, 498, 499, 0, 500, 0, 0, 0, 0, 0, 0, 501, 0, 502, 503,
+	0, 0, 504, 505, 0, 506, 0, 0, 0, 0, 507, 508, 0, 509, 0, 0, 0,
+	510, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 511, 0, 512, 513, 0, 0, 514,
+	515, 0, 516, 0, 0, 0, 0, 517, 518, 0, 519, 0, 0, 0, 520, 0, 0, 0,
+	0, 0, 0, 0, 0, 521, 522, 0, 523, 0, 0, 0, 524, 0, 0, 0, 0, 0, 0,
+	0, 525, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 526, 0, 0, 0, 527, 0, 528, 529, 0, 0, 0, 0, 530, 0, 531,
+	532, 0, 0, 533, 534, 0, 535, 0, 0, 0, 0, 0, 0, 536, 0, 537, 538,
+	0, 0, 539, 540, 0, 541, 0, 0, 0, 0, 542, 543, 0, 544, 0, 0, 0,
+	545, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 546, 0, 547, 548, 0, 0, 549,
+	550, 0, 551, 0, 0, 0, 0, 552, 553, 0, 554, 0, 0, 0, 555, 0, 0, 0,
+	0, 0, 0, 0, 0, 556, 557, 0, 558, 0, 0, 0, 559, 0, 0, 0, 0, 0, 0,
+	0, 560, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	561, 0, 562, 563, 0, 0, 564, 565, 0, 566, 0, 0, 0, 0, 567, 568,
+	0, 569, 0, 0, 0, 570, 0, 0, 0, 0, 0, 0, 0, 0, 571, 572, 0, 573,
+	0, 0, 0, 574, 0, 0, 0, 0, 0, 0, 0, 575, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 576, 577, 0, 578, 0, 0, 0, 579, 0, 0, 0,
+	0, 0, 0, 0, 580, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	581, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 582, 0, 0,
+	0, 583, 0, 584, 585, 0, 0, 0, 0, 586, 0, 587, 588, 0, 0, 589,
+	590, 0, 591, 0, 0, 0, 0, 0, 0, 592, 0, 593, 594, 0, 0, 595, 596,
+	0, 597, 0, 0, 0, 0, 598, 599, 0, 600, 0, 0, 0, 601, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 602, 0, 603, 604, 0, 0, 605, 606, 0, 607, 0, 0,
+	0, 0, 608, 609, 0, 610, 0, 0, 0, 611, 0, 0, 0, 0, 0, 0, 0, 0,
+	612, 613, 0, 614, 0, 0, 0, 615, 0, 0, 0, 0, 0, 0, 0, 616, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 617, 0, 618, 619,
+	0, 0, 620, 621, 0, 622, 0, 0, 0, 0, 623, 624, 0, 625, 0, 0, 0,
+	626, 0, 0, 0, 0, 0, 0, 0, 0, 627, 628, 0, 629, 0, 0, 0, 630, 0,
+	0, 0, 0, 0, 0, 0, 631, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 632, 633, 0, 634, 0, 0, 0, 635, 0, 0, 0, 0, 0, 0, 0, 636,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 637, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 638, 0, 639, 640, 0, 0, 641, 642, 0, 643, 0,
+	0, 0, 0, 644, 645, 0, 646, 0, 0, 0, 647, 0, 0, 0, 0, 0, 0, 0, 0,
+	648, 649, 0, 650, 0, 0, 0, 651, 0, 0, 0, 0, 0, 0, 0, 652, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 653, 654, 0, 655, 0, 0,
+	0, 656, 0, 0, 0, 0, 0, 0, 0, 657, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 658, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 659, 660, 0,
+	661, 0, 0, 0, 662, 0, 0, 0, 0, 0, 0, 0, 663, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 664, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 665, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 666, 0, 0, 0, 667, 0, 668, 669, 0, 0, 0, 0, 670, 0, 671,
+	672, 0, 0, 673, 674, 0, 675, 0, 0, 0, 0, 0, 0, 676, 0, 677, 678,
+	0, 0, 679, 680, 0, 681, 0, 0, 0, 0, 682, 683, 0, 684, 0, 0, 0,
+	685, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 686, 0, 687, 688, 0, 0, 689,
+	690, 0, 691, 0, 0, 0, 0, 692, 693, 0, 694, 0, 0, 0, 695, 0, 0, 0,
+	0, 0, 0, 0, 0, 696, 697, 0, 698, 0, 0, 0, 699, 0, 0, 0, 0, 0, 0,
+	0, 700, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	701, 0, 702, 703, 0, 0, 704, 705, 0, 706, 0, 0, 0, 0, 707, 708,
+	0, 709, 0, 0, 0, 710, 0, 0, 0, 0, 0, 0, 0, 0, 711, 712, 0, 713,
+	0, 0, 0, 714, 0, 0, 0, 0, 0, 0, 0, 715, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 716, 717, 0, 718, 0, 0, 0, 719, 0, 0, 0,
+	0, 0, 0, 0, 720, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	721, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 722, 0, 723, 724, 0, 0,
+	725, 726, 0, 727, 0, 0, 0, 0, 728, 729, 0, 730, 0, 0, 0, 731, 0,
+	0, 0, 0, 0, 0, 0, 0, 732, 733, 0, 734, 0, 0, 0, 735, 0, 0, 0, 0,
+	0, 0, 0, 736, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	737, 738, 0, 739, 0, 0, 0, 740, 0, 0, 0, 0, 0, 0, 0, 741, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 742, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 743, 744, 0, 745, 0, 0, 0, 746, 0, 0, 0, 0, 0, 0, 0,
+	747, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 748, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 749, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 750, 0, 751, 752, 0, 0, 753, 754, 0, 755,
+	0, 0, 0, 0, 756, 757, 0, 758, 0, 0, 0, 759, 0, 0, 0, 0, 0, 0, 0,
+	0, 760, 761, 0, 762, 0, 0, 0, 763, 0, 0, 0, 0, 0, 0, 0, 764, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 765, 766, 0, 767, 0,
+	0, 0, 768, 0, 0, 0, 0, 0, 0, 0, 769, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 770, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 771, 772,
+	0, 773, 0, 0, 0, 774, 0, 0, 0, 0, 0, 0, 0, 775, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 776, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 777,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 778,
+	779, 0, 780, 0, 0, 0, 781, 0, 0, 0, 0, 0, 0, 0, 782, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 783, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	784, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	5862, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 785, 0, 0, 0, 0, 0, 0, 0,
+	786, 0, 0, 0, 787, 0, 788, 789, 0, 0, 0, 0, 0, 0, 0, 0, 790, 0,
+	0, 0, 791, 0, 792, 793, 0, 0, 0, 0, 794, 0, 795, 796, 0, 0, 797,
+	798, 0, 799, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 800, 0, 0, 0, 801, 0,
+	802, 803, 0, 0, 0, 0, 804, 0, 805, 806, 0, 0, 807, 808, 0, 809,
+	0, 0, 0, 0, 0, 0, 810, 0, 811, 812, 0, 0, 813, 814, 0, 815, 0, 0,
+	0, 0, 816, 817, 0, 818, 0, 0, 0, 819, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 820, 0, 0, 0, 821, 0, 822, 823, 0, 0, 0, 0, 824,
+	0, 825, 826, 0, 0, 827, 828, 0, 829, 0, 0, 0, 0, 0, 0, 830, 0,
+	831, 832, 0, 0, 833, 834, 0, 835, 0, 0, 0, 0, 836, 837, 0, 838,
+	0, 0, 0, 839, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 840, 0, 841, 842, 0,
+	0, 843, 844, 0, 845, 0, 0, 0, 0, 846, 847, 0, 848, 0, 0, 0, 849,
+	0, 0, 0, 0, 0, 0, 0, 0, 850, 851, 0, 852, 0, 0, 0, 853, 0, 0, 0,
+	0, 0, 0, 0, 854, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 855, 0, 0, 0, 856, 0, 857, 858, 0, 0, 0, 0,
+	859, 0, 860, 861, 0, 0, 862, 863, 0, 864, 0, 0, 0, 0, 0, 0, 865,
+	0, 866, 867, 0, 0, 868, 869, 0, 870, 0, 0, 0, 0, 871, 872, 0,
+	873, 0, 0, 0, 874, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 875, 0, 876,
+	877, 0, 0, 878, 879, 0, 880, 0, 0, 0, 0, 881, 882, 0, 883, 0, 0,
+	0, 884, 0, 0, 0, 0, 0, 0, 0, 0, 885, 886, 0, 887, 0, 0, 0, 888,
+	0, 0, 0, 0, 0, 0, 0, 889, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 890, 0, 891, 892, 0, 0, 893, 894, 0, 895, 0, 0, 0,
+	0, 896, 897, 0, 898, 0, 0, 0, 899, 0, 0, 0, 0, 0, 0, 0, 0, 900,
+	901, 0, 902, 0, 0, 0, 903, 0, 0, 0, 0, 0, 0, 0, 904, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 905, 906, 0, 907, 0, 0, 0,
+	908, 0, 0, 0, 0, 0, 0, 0, 909, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 910, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	911, 0, 0, 0, 912, 0, 913, 914, 0, 0, 0, 0, 915, 0, 916, 917, 0,
+	0, 918, 919, 0, 920, 0, 0, 0, 0, 0, 0, 921, 0, 922, 923, 0, 0,
+	924, 925, 0, 926, 0, 0, 0, 0, 927, 928, 0, 929, 0, 0, 0, 930, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 931, 0, 932, 933, 0, 0, 934, 935, 0,
+	936, 0, 0, 0, 0, 937, 938, 0, 939, 0, 0, 0, 940, 0, 0, 0, 0, 0,
+	0, 0, 0, 941, 942, 0, 943, 0, 0, 0, 944, 0, 0, 0, 0, 0, 0, 0,
+	945, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 946,
+	0, 947, 948, 0, 0, 949, 950, 0, 951, 0, 0, 0, 0, 952, 953, 0,
+	954, 0, 0, 0, 955, 0, 0, 0, 0, 0, 0, 0, 0, 956, 957, 0, 958, 0,
+	0, 0, 959, 0, 0, 0, 0, 0, 0, 0, 960, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 961, 962, 0, 963, 0, 0, 0, 964, 0, 0, 0, 0,
+	0, 0, 0, 965, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 966,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 967, 0, 968, 969, 0, 0, 970,
+	971, 0, 972, 0, 0, 0, 0, 973, 974, 0, 975, 0, 0, 0, 976, 0, 0, 0,
+	0, 0, 0, 0, 0, 977, 978, 0, 979, 0, 0, 0, 980, 0, 0, 0, 0, 0, 0,
+	0, 981, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 982, 983,
+	0, 984, 0, 0, 0, 985, 0, 0, 0, 0, 0, 0, 0, 986, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 987, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	988, 989, 0, 990, 0, 0, 0, 991, 0, 0, 0, 0, 0, 0, 0, 992, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 993, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 994, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 995, 0, 0, 0, 996, 0, 997, 998, 0, 0, 0,
+	0, 999, 0, 1000, 1001, 0, 0, 1002, 1003, 0, 1004, 0, 0, 0, 0, 0,
+	0, 1005, 0, 1006, 1007, 0, 0, 1008, 1009, 0, 1010, 0, 0, 0, 0,
+	1011, 1012, 0, 1013, 0, 0, 0, 1014, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1015, 0, 1016, 1017, 0, 0, 1018, 1019, 0, 1020, 0, 0, 0, 0, 1021,
+	1022, 0, 1023, 0, 0, 0, 1024, 0, 0, 0, 0, 0, 0, 0, 0, 1025, 1026,
+	0, 1027, 0, 0, 0, 1028, 0, 0, 0, 0, 0, 0, 0, 1029, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1030, 0, 1031, 1032, 0, 0,
+	1033, 1034, 0, 1035, 0, 0, 0, 0, 1036, 1037, 0, 1038, 0, 0, 0,
+	1039, 0, 0, 0, 0, 0, 0, 0, 0, 1040, 1041, 0, 1042, 0, 0, 0, 1043,
+	0, 0, 0, 0, 0, 0, 0, 1044, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1045, 1046, 0, 1047, 0, 0, 0, 1048, 0, 0, 0, 0, 0, 0, 0,
+	1049, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1050, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1051, 0, 1052, 1053, 0, 0, 1054, 1055,
+	0, 1056, 0, 0, 0, 0, 1057, 1058, 0, 1059, 0, 0, 0, 1060, 0, 0, 0,
+	0, 0, 0, 0, 0, 1061, 1062, 0, 1063, 0, 0, 0, 1064, 0, 0, 0, 0, 0,
+	0, 0, 1065, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1066,
+	1067, 0, 1068, 0, 0, 0, 1069, 0, 0, 0, 0, 0, 0, 0, 1070, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1071, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1072, 1073, 0, 1074, 0, 0, 0, 1075, 0, 0, 0, 0, 0, 0, 0,
+	1076, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1077, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1078, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1079, 0, 1080, 1081, 0, 0, 1082, 1083, 0,
+	1084, 0, 0, 0, 0, 1085, 1086, 0, 1087, 0, 0, 0, 1088, 0, 0, 0, 0,
+	0, 0, 0, 0, 1089, 1090, 0, 1091, 0, 0, 0, 1092, 0, 0, 0, 0, 0, 0,
+	0, 1093, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1094,
+	1095, 0, 1096, 0, 0, 0, 1097, 0, 0, 0, 0, 0, 0, 0, 1098, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1099, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1100, 1101, 0, 1102, 0, 0, 0, 1103, 0, 0, 0, 0, 0, 0, 0,
+	1104, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1105, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1106, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1107, 1108, 0, 1109, 0, 0, 0, 1110, 0, 0, 0, 0,
+	0, 0, 0, 1111, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1112,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1113, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1114, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1115, 0, 0, 0, 1116, 0, 1117,
+	1118, 0, 0, 0, 0, 1119, 0, 1120, 1121, 0, 0, 1122, 1123, 0, 1124,
+	0, 0, 0, 0, 0, 0, 1125, 0, 1126, 1127, 0, 0, 1128, 1129, 0, 1130,
+	0, 0, 0, 0, 1131, 1132, 0, 1133, 0, 0, 0, 1134, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1135, 0, 1136, 1137, 0, 0, 1138, 1139, 0, 1140, 0, 0,
+	0, 0, 1141, 1142, 0, 1143, 0, 0, 0, 1144, 0, 0, 0, 0, 0, 0, 0, 0,
+	1145, 1146, 0, 1147, 0, 0, 0, 1148, 0, 0, 0, 0, 0, 0, 0, 1149, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1150, 0, 1151,
+	1152, 0, 0, 1153, 1154, 0, 1155, 0, 0, 0, 0, 1156, 1157, 0, 1158,
+	0, 0, 0, 1159, 0, 0, 0, 0, 0, 0, 0, 0, 1160, 1161, 0, 1162, 0, 0,
+	0, 1163, 0, 0, 0, 0, 0, 0, 0, 1164, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1165, 1166, 0, 1167, 0, 0, 0, 1168, 0, 0, 0, 0,
+	0, 0, 0, 1169, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1170,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1171, 0, 1172, 1173, 0, 0,
+	1174, 1175, 0, 1176, 0, 0, 0, 0, 1177, 1178, 0, 1179, 0, 0, 0,
+	1180, 0, 0, 0, 0, 0, 0, 0, 0, 1181, 1182, 0, 1183, 0, 0, 0, 1184,
+	0, 0, 0, 0, 0, 0, 0, 1185, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1186, 1187, 0, 1188, 0, 0, 0, 1189, 0, 0, 0, 0, 0, 0, 0,
+	1190, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1191, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1192, 1193, 0, 1194, 0, 0, 0, 1195, 0, 0, 0,
+	0, 0, 0, 0, 1196, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1197, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1198, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1199, 0, 1200, 1201, 0, 0,
+	1202, 1203, 0, 1204, 0, 0, 0, 0, 1205, 1206, 0, 1207, 0, 0, 0,
+	1208, 0, 0, 0, 0, 0, 0, 0, 0, 1209, 1210, 0, 1211, 0, 0, 0, 1212,
+	0, 0, 0, 0, 0, 0, 0, 1213, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1214, 1215, 0, 1216, 0, 0, 0, 1217, 0, 0, 0, 0, 0, 0, 0,
+	1218, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1219, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1220, 1221, 0, 1222, 0, 0, 0, 1223, 0, 0, 0,
+	0, 0, 0, 0, 1224, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1225, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1227, 1228, 0, 1229, 0, 0, 0,
+	1230, 0, 0, 0, 0, 0, 0, 0, 1231, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1232, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1233, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1234, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1235, 0, 1236, 1237, 0,
+	0, 1238, 1239, 0, 1240, 0, 0, 0, 0, 1241, 1242, 0, 1243, 0, 0, 0,
+	1244, 0, 0, 0, 0, 0, 0, 0, 0, 1245, 1246, 0, 1247, 0, 0, 0, 1248,
+	0, 0, 0, 0, 0, 0, 0, 1249, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1250, 1251, 0, 1252, 0, 0, 0, 1253, 0, 0, 0, 0, 0, 0, 0,
+	1254, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1255, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1256, 1257, 0, 1258, 0, 0, 0, 1259, 0, 0, 0,
+	0, 0, 0, 0, 1260, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1261, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1262, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1263, 1264, 0, 1265, 0, 0, 0,
+	1266, 0, 0, 0, 0, 0, 0, 0, 1267, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1268, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1269, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1270, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1271, 1272, 0, 1273, 0, 0, 0,
+	1274, 0, 0, 0, 0, 0, 0, 0, 1275, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1276, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1277, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1278, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5863}
+
+var deuceToSevenValues = [...]DeuceToSevenScore{
+	7298, 7142, 7299, 7154, 7300, 4997, 7310, 4998, 7301, 4139, 7143,
+	7302, 4140, 5000, 5063, 7303, 4999, 4150, 7304, 5003, 7305, 5064,
+	7144, 4141, 7311, 5007, 1279, 5001, 4161, 5012, 7306, 4142, 7307,
+	7166, 5004, 5018, 7312, 4172, 7308, 5066, 4143, 5002, 1499, 7309,
+	1280, 7155, 5008, 4144, 5025, 4151, 5069, 5005, 5033, 5129, 5013,
+	1283, 4145, 4183, 5042, 7313, 5073, 5019, 1281, 5065, 5009, 7145,
+	4173, 5052, 1500, 1289, 5078, 4146, 1719, 7314, 5014, 1284, 4152,
+	4147, 7167, 1299, 5006, 5026, 1503, 5034, 5084, 7322, 5020, 4153,
+	7156, 4148, 7146, 7178, 7315, 1314, 1501, 1290, 4205, 1939, 4149,
+	5043, 5067, 5010, 1509, 7316, 1282, 5091, 4162, 1300, 5027, 1504,
+	5053, 1720, 5015, 5099, 4154, 1519, 1286, 5035, 1335, 5130, 5070,
+	5011, 1285, 1363, 7317, 7323, 4155, 1315, 1723, 5016, 5108, 5021,
+	4249, 5195, 1534, 5044, 5068, 1510, 4174, 4216, 4184, 5118, 1399,
+	1292, 5074, 5054, 4156, 7147, 7318, 1291, 5022, 1520, 4206, 1336,
+	4194, 5071, 1444, 4163, 1302, 7319, 4175, 1729, 5028, 5079, 1506,
+	5017, 1364, 1555, 5131, 1295, 1301, 5036, 5132, 1583, 1739, 1287,
+	7148, 1535, 4157, 4164, 1305, 7320, 5029, 1317, 4260, 7179, 4158,
+	5085, 1400, 5023, 5075, 1316, 5037, 1940, 5135, 5045, 7157, 7321,
+	1619, 4176, 1512, 4250, 4207, 5196, 1445, 1754, 1502, 4304, 5024,
+	1320, 5080, 5055, 2159, 1556, 4195, 1664, 4159, 4177, 1293, 1522,
+	1943, 4165, 1338, 5046, 1721, 5072, 1309, 1584, 5092, 5030, 1515,
+	1288, 1337, 1366, 1505, 4160, 5133, 1303, 5100, 5038, 7149, 5139,
+	5056, 4166, 4370, 1365, 5086, 1775, 7324, 5031, 1296, 1525, 4178,
+	1341, 1724, 1537, 7158, 1803, 1620, 1324, 5039, 4251, 5144, 7334,
+	1369, 4185, 5136, 1402, 1306, 5109, 1949, 5047, 5076, 1318, 2379,
+	2160, 5197, 1665, 4167, 1401, 4315, 1294, 1329, 7190, 5032, 1511,
+	1540, 1941, 1447, 4217, 5093, 5119, 1839, 5057, 1959, 5048, 5081,
+	4179, 5040, 4186, 1446, 1558, 5150, 1405, 1722, 4305, 1529, 1304,
+	5101, 1345, 1730, 5077, 1321, 1521, 4227, 4180, 7168, 1586, 1884,
+	1297, 5058, 4208, 1373, 4447, 1944, 5140, 1339, 1450, 1507, 1310,
+	4381, 4168, 1350, 1740, 5082, 7150, 1974, 1561, 5049, 1367, 1725,
+	5087, 7325, 1307, 4169, 5110, 1544, 1378, 1319, 2380, 5145, 1726,
+	5041, 7335, 4371, 1536, 5157, 4181, 1589, 1622, 4252, 4187, 1342,
+	5059, 5198, 1409, 4261, 4218, 7151, 5120, 5165, 7159, 1325, 1549,
+	5261, 1356, 4182, 1755, 1370, 5088, 1942, 4306, 1667, 7326, 1403,
+	4209, 4170, 1950, 1454, 1322, 4188, 1513, 1414, 4271, 2161, 1995,
+	1384, 7180, 5050, 5094, 5151, 1625, 2599, 5083, 1330, 7202, 1565,
+	5201, 1731, 1340, 1508, 1448, 1311, 4228, 2023, 1557, 5051, 4210,
+	5102, 4171, 5174, 1960, 1459, 1732, 1523, 2163, 1593, 5060, 4458,
+	1368, 1945, 1670, 1406, 5134, 1298, 7160, 1585, 1346, 1570, 1516,
+	1741, 4535, 4372, 4189, 1776, 5061, 5095, 1420, 5184, 1742, 1374,
+	1343, 5089, 4448, 1598, 7327, 4262, 1451, 1727, 1391, 2819, 1804,
+	1308, 5158, 2059, 5103, 1326, 7152, 5111, 1351, 1735, 1526, 4211,
+	2383, 1975, 1371, 1465, 5199, 1629, 5137, 1538, 1404, 4253, 4634,
+	5166, 1621, 5205, 1379, 1514, 5090, 2381, 4238, 7328, 7191, 7336,
+	1576, 2104, 1756, 5062, 2600, 5121, 1331, 2169, 1745, 1951, 1674,
+	1410, 1449, 1757, 1634, 4272, 2162, 4307, 4190, 1604, 4254, 1427,
+	1666, 1840, 5112, 4316, 5210, 1323, 1524, 5327, 5262, 5096, 1357,
+	5202, 7153, 1541, 1407, 4196, 4191, 1435, 2179, 1347, 5175, 1517,
+	1455, 1961, 1946, 1679, 1733, 4212, 1415, 4219, 1996, 1312, 5104,
+	1385, 1472, 7161, 1885, 1559, 7329, 5122, 4326, 1530, 1375, 4449,
+	5141, 5097, 1452, 1760, 4213, 4308, 7169, 4546, 2820, 2024, 1777,
+	1480, 1640, 1587, 5185, 1352, 4255, 1527, 1460, 1743, 2164, 5216,
+	3039, 4373, 5105, 4197, 1539, 1778, 1749, 1611, 4382, 1805, 4192,
+	4744, 1380, 4282, 1736, 1344, 5146, 2194, 4263, 1313, 4536, 1976,
+	1562, 1685, 5113, 1421, 1806, 1327, 2389, 4645, 5206, 1545, 1411,
+	1372, 2382, 4239, 5138, 4214, 1392, 5098, 4392, 4193, 7330, 2060,
+	1590, 1489, 4317, 1623, 1952, 4220, 1746, 2384, 5264, 1358, 4374,
+	5123, 1542, 1781, 1466, 5200, 1758, 5114, 2603, 4635, 1332, 5106,
+	2399, 1647, 7331, 1841, 1456, 1764, 5211, 4256, 7214, 1550, 1416,
+	7203, 4215, 5223, 1386, 5263, 2105, 1560, 5152, 1809, 4865, 2601,
+	1668, 1328, 1531, 1842, 1962, 2170, 1655, 4221, 4198, 4257, 1947,
+	5124, 2215, 7170, 1408, 4229, 5231, 1997, 1692, 1428, 1588, 1886,
+	1626, 1955, 4327, 1348, 1461, 1769, 1518, 4309, 5328, 2166, 1566,
+	3040, 5203, 1761, 2243, 2609, 1887, 7162, 1333, 4383, 2025, 1700,
+	1436, 5115, 2180, 7332, 1376, 4199, 4450, 2414, 5142, 1453, 4537,
+	2165, 5217, 1594, 1563, 1473, 4459, 2823, 1422, 1779, 1671, 1845,
+	1965, 1750, 1353, 5107, 1528, 4375, 1546, 4755, 1785, 1977, 1571,
+	4283, 4258, 1728, 4222, 1393, 3259, 2821, 5159, 5240, 1591, 1481,
+	5125, 1807, 7333, 1349, 1624, 4264, 1381, 7163, 2386, 5147, 1813,
+	1467, 7337, 4469, 1599, 4310, 1890, 2279, 4636, 4393, 5167, 2061,
+	1709, 4745, 1377, 7226, 1953, 4451, 1551, 1790, 5143, 1412, 4259,
+	2195, 2385, 5265, 5250, 2829, 4200, 1782, 1630, 4337, 1669, 4318,
+	1354, 2390, 2172, 3479, 4265, 5116, 5207, 7346, 1980, 1765, 1359,
+	2435, 1818, 5393, 4273, 1543, 4240, 1334, 2324, 5224, 1577, 1429,
+	2106, 1810, 4876, 1627, 1490, 2602, 1843, 1382, 1457, 1963, 5330,
+	2171, 5148, 1417, 5117, 1567, 7338, 1998, 1675, 1387, 2463, 1849,
+	4223, 5153, 1635, 1969, 2604, 5232, 1532, 1605, 5126, 2400, 1437,
+	2182, 5176, 1956, 5212, 1413, 1734, 1770, 2619, 5329, 2167, 1595,
+	4376, 7192, 1474, 4460, 1796, 2026, 4311, 1672, 4866, 4224, 4319,
+	1888, 1462, 5127, 2181, 5267, 1360, 1572, 1894, 3043, 4266, 1948,
+	1680, 2216, 1854, 3260, 4403, 4201, 1482, 1824, 4384, 4312, 5186,
+	4452, 1846, 7171, 1458, 1966, 1418, 1744, 2839, 2001, 7164, 1786,
+	4538, 3041, 1388, 1600, 1978, 1564, 5154, 1423, 1355, 2244, 2499,
+	2610, 1533, 4547, 2822, 4202, 5241, 1641, 1984, 4746, 1737, 1547,
+	2415, 1899, 2197, 2387, 4230, 5218, 2029, 1814, 1394, 1631, 4470,
+	2824, 5160, 2634, 2062, 1592, 1891, 1383, 4453, 2392, 4225, 3480,
+	5149, 1612, 1463, 7339, 4293, 5128, 3699, 5459, 3049, 1791, 4284,
+	1468, 7181, 2544, 2196, 4377, 1578, 4557, 5251, 4385, 4637, 1686,
+	1491, 4338, 1860, 5168, 4313, 1989, 2391, 2173, 1747, 4646, 1552,
+	4539, 1676, 7347, 1981, 4267, 1819, 2107, 7215, 1424, 1759, 1636,
+	2606, 4226, 4378, 2280, 1606, 1831, 2402, 7172, 5271, 4203, 1361,
+	7165, 7340, 1548, 1954, 5331, 4274, 5266, 1395, 1999, 2830, 4867,
+	5161, 2065, 1905, 4268, 1850, 1430, 4314, 1970, 1628, 2605, 1419,
+	4656, 2401, 2183, 1648, 2005, 5333, 1389, 7238, 1568, 5155, 2436,
+	1469, 5204, 1681, 2218, 5394, 4320, 2325, 5225, 2854, 4638, 1797,
+	2027, 4204, 2655, 5169, 3919, 1438, 4328, 5276, 1362, 5177, 1553,
+	1964, 4231, 1656, 2033, 4480, 5268, 1596, 2110, 1895, 1475, 1762,
+	4461, 2464, 2246, 2612, 2217, 1855, 1673, 1464, 4548, 4404, 5233,
+	4379, 1825, 2175, 1693, 1642, 1867, 2683, 3059, 2010, 1957, 1390,
+	2417, 1573, 5156, 2620, 7204, 2168, 7341, 4386, 2002, 3263, 3042,
+	2826, 1431, 1780, 1483, 2245, 4269, 2611, 5187, 1751, 1613, 7358,
+	4540, 1701, 1875, 4232, 1985, 3700, 1569, 2038, 1425, 7182, 3044,
+	2416, 1601, 1900, 2198, 3261, 4380, 2030, 1687, 1912, 2825, 1439,
+	1808, 2185, 4321, 2063, 5178, 4747, 3074, 2393, 1967, 4647, 1738,
+	1396, 5282, 4294, 4454, 2840, 4756, 5162, 2069, 1597, 7227, 1476,
+	4462, 1979, 1632, 4270, 2500, 2282, 2875, 4558, 4394, 2395, 3483,
+	5242, 1920, 1861, 4541, 1470, 5208, 2719, 1990, 1574, 1426, 2388,
+	7193, 4639, 2016, 4455, 3269, 2832, 5170, 1783, 1579, 1484, 7173,
+	2108, 2635, 5188, 1492, 2607, 1554, 2903, 2281, 3481, 1832, 2403,
+	1649, 5272, 1748, 1710, 2114, 1397, 1766, 4766, 5460, 2438, 3050,
+	5396, 5163, 2074, 1602, 1677, 2545, 2327, 4233, 1637, 7342, 2044,
+	4348, 5252, 2831, 1811, 1607, 3920, 2405, 2066, 1906, 4339, 2764,
+	4748, 1844, 1471, 5213, 4275, 2174, 4657, 1657, 2200, 2006, 5334,
+	1432, 5525, 4640, 7348, 1982, 2466, 2437, 1633, 5171, 2219, 5395,
+	4868, 2326, 7343, 5337, 4387, 1694, 3489, 4322, 4877, 5209, 1929,
+	5277, 2119, 1771, 2622, 5332, 4241, 1682, 5289, 4542, 2034, 4481,
+	1440, 1580, 2000, 2189, 4456, 2111, 2465, 5179, 2247, 1493, 2613,
+	1889, 1971, 4329, 1702, 2939, 2176, 4276, 2184, 1868, 4323, 1477,
+	4463, 2011, 3046, 1433, 1678, 2418, 1763, 1638, 2621, 4568, 5297,
+	2615, 1398, 2855, 4549, 5164, 2827, 2080, 1608, 5342, 2028, 2656,
+	4887, 1847, 1643, 1575, 5214, 2420, 7359, 3279, 2842, 1876, 4757,
+	1787, 1485, 5269, 2039, 4457, 5219, 5189, 1441, 3045, 4414, 4869,
+	2502, 4234, 5180, 2051, 3262, 4641, 4405, 2984, 1752, 7344, 1614,
+	5172, 1913, 2684, 2186, 3060, 1603, 3703, 1478, 4464, 4285, 1683,
+	2221, 5283, 3095, 2841, 1815, 2003, 2125, 2070, 3264, 4471, 2637,
+	4749, 1892, 1688, 4235, 2501, 4330, 2283, 4667, 4388, 2204, 2396,
+	4648, 1921, 1711, 1986, 3701, 3294, 5462, 4324, 3052, 1792, 1486,
+	2547, 4277, 5190, 2199, 2249, 7250, 4359, 3499, 2017, 3123, 4550,
+	7345, 5306, 2833, 1434, 2031, 7183, 4395, 1644, 2636, 2064, 3075,
+	2394, 4389, 4242, 3482, 5348, 1581, 4295, 2115, 7349, 5220, 4767,
+	5461, 2439, 1820, 3051, 1494, 5397, 2835, 2075, 1784, 2546, 4750,
+	2328, 4543, 2876, 2045, 4349, 1753, 7174, 2087, 1615, 1442, 3484,
+	2209, 4878, 2406, 1650, 5181, 4325, 3709, 2720, 1991, 1767, 1639,
+	7205, 2441, 5399, 2201, 1609, 5316, 2409, 3270, 1479, 5226, 7239,
+	3514, 2467, 1851, 1689, 2109, 5215, 1812, 3923, 2608, 4642, 2904,
+	4236, 5338, 5173, 2404, 2095, 4649, 4243, 5273, 1658, 1930, 1582,
+	4870, 2120, 2469, 1495, 2623, 5290, 3315, 2857, 2285, 2132, 1798,
+	1487, 5234, 2190, 4396, 2658, 3159, 3921, 1695, 2067, 5191, 2765,
+	1958, 7262, 1684, 2225, 7175, 1772, 4390, 2007, 2625, 5335, 5270,
+	5526, 1610, 1896, 4425, 3047, 4278, 2220, 7216, 1856, 4569, 5298,
+	7184, 2616, 4237, 4544, 2856, 2140, 1826, 3490, 2081, 5343, 2686,
+	1651, 2657, 1703, 3062, 4751, 4888, 5278, 1768, 5355, 2253, 5403,
+	4871, 4491, 2421, 4551, 2843, 2330, 2035, 5227, 3266, 4482, 3204,
+	1645, 2112, 4279, 4415, 3929, 2248, 2503, 2614, 2052, 2424, 1848,
+	3535, 1443, 1968, 4391, 2230, 2940, 2177, 5221, 5182, 4777, 1659,
+	2685, 3061, 2012, 2845, 4758, 1788, 2419, 5363, 1901, 4244, 2222,
+	4331, 1616, 2505, 4545, 4465, 5235, 2126, 3265, 2828, 1696, 1496,
+	2638, 5243, 3719, 3077, 4286, 4668, 2205, 1773, 4643, 2258, 2629,
+	7360, 3280, 4296, 5183, 3702, 4552, 5463, 1690, 2040, 3053, 1816,
+	4472, 2548, 1646, 2640, 2250, 5591, 4360, 2878, 1893, 4650, 4559,
+	1488, 4466, 2429, 5307, 3486, 2985, 5192, 2149, 1704, 1862, 2187,
+	2722, 5222, 1712, 3704, 3076, 5465, 3055, 1793, 5349, 2289, 2550,
+	3272, 5284, 3096, 4397, 1617, 2071, 4872, 5253, 2836, 4340, 3734,
+	4280, 3343, 2906, 2284, 2877, 1833, 4287, 4644, 2088, 2397, 5274,
+	3485, 2210, 4752, 5193, 2849, 3295, 4759, 1789, 2721, 1983, 1691,
+	2236, 2442, 1821, 5400, 5372, 3500, 2018, 5317, 2410, 3124, 3271,
+	1652, 2834, 4651, 4898, 5244, 1907, 2767, 4332, 4879, 2445, 7370,
+	3563, 2905, 4658, 2096, 2334, 5228, 5336, 4753, 2294, 5528, 2116,
+	7176, 1817, 3939, 7228, 2440, 4473, 4245, 5398, 2076, 2264, 2644,
+	2470, 1852, 4281, 2329, 4553, 1972, 2858, 2286, 2046, 2133, 4350,
+	3492, 1660, 1497, 2659, 4467, 3922, 2407, 5279, 1713, 2766, 3710,
+	2473, 5469, 5657, 4502, 2226, 1794, 5382, 5236, 2202, 2626, 7185,
+	1697, 5527, 3515, 2860, 5254, 4426, 2468, 1799, 1653, 4246, 4341,
+	2661, 3924, 1774, 1618, 2450, 5408, 3379, 5339, 2141, 2942, 2178,
+	3491, 1869, 1498, 2687, 2339, 3063, 3755, 5229, 5194, 7350, 4288,
+	2121, 5356, 2254, 1822, 5404, 3954, 1897, 4492, 2624, 5291, 1857,
+	3316, 2331, 3267, 1705, 4406, 4873, 4468, 2191, 3160, 1827, 1661,
+	4880, 2689, 3065, 2425, 4652, 2478, 7361, 2231, 3282, 2941, 1877,
+	4778, 5237, 2846, 7274, 2004, 1698, 4579, 3048, 5364, 1853, 1973,
+	2300, 4570, 5299, 2506, 2617, 3424, 2987, 4398, 1914, 4874, 2082,
+	4436, 5344, 2188, 4760, 4333, 7177, 1987, 3706, 3078, 2509, 3599,
+	2422, 2259, 2630, 1902, 5285, 3281, 3098, 2844, 5245, 2864, 1800,
+	3205, 2032, 1706, 2271, 2665, 4416, 3930, 2504, 2641, 4247, 7251,
+	2879, 2053, 3536, 3080, 2398, 2430, 3487, 2986, 1654, 1922, 2150,
+	3297, 4334, 4754, 2723, 4474, 7194, 2456, 3705, 5414, 5466, 1898,
+	3056, 2223, 2345, 2290, 1858, 2551, 3502, 5230, 3126, 3273, 4407,
+	3097, 2881, 2127, 4560, 1828, 3975, 1714, 4678, 2639, 3720, 2693,
+	5474, 3069, 1863, 4761, 1795, 4554, 2907, 2725, 4669, 1992, 2554,
+	3644, 2206, 1662, 2514, 3783, 5255, 2850, 4768, 3296, 5464, 4289,
+	3275, 3054, 7217, 2484, 5246, 2237, 2549, 4248, 2251, 5373, 5592,
+	4361, 5238, 4351, 3501, 3125, 1699, 2909, 5308, 4899, 1834, 2408,
+	4875, 2768, 7351, 1988, 3712, 2446, 1823, 7371, 4653, 4475, 2649,
+	5350, 2335, 1903, 2203, 3819, 2295, 5529, 3517, 4290, 4881, 2837,
+	2265, 3926, 2645, 2068, 1908, 1715, 2307, 3735, 7382, 2770, 3344,
+	3084, 5340, 4399, 3493, 2089, 1707, 2559, 4659, 2211, 1931, 2008,
+	7206, 3711, 2474, 5256, 4335, 5470, 5531, 7263, 4503, 4342, 2443,
+	5292, 5401, 5383, 3318, 2885, 5318, 2411, 4561, 2192, 3516, 2861,
+	3162, 3495, 7286, 2662, 1864, 3925, 2315, 2729, 7352, 1993, 3564,
+	2451, 4400, 5409, 4555, 2943, 2097, 2869, 2340, 4762, 1801, 2036,
+	4590, 5421, 4483, 3940, 2113, 4882, 2520, 2352, 2471, 7186, 5300,
+	2618, 3317, 2859, 2913, 2287, 2134, 5247, 1835, 5345, 4003, 2660,
+	3161, 2945, 5275, 4889, 1870, 2690, 4336, 3066, 2013, 5658, 2227,
+	2479, 1859, 2423, 1663, 3283, 4408, 2627, 1829, 3207, 2491, 5723,
+	7240, 5429, 4427, 4580, 4513, 4417, 3932, 4291, 1909, 2360, 2774,
+	5239, 2301, 3538, 4556, 2988, 3380, 2142, 4660, 4039, 4437, 2688,
+	1716, 2009, 7362, 3285, 3064, 3756, 1878, 5480, 3707, 5535, 1802,
+	2041, 2670, 5357, 2565, 2255, 2510, 2224, 5405, 3955, 4654, 4493,
+	3099, 2332, 5257, 3268, 2865, 4689, 3206, 2990, 4343, 3722, 2272,
+	2666, 1915, 3931, 5280, 7187, 2426, 1904, 2207, 4788, 3537, 3081,
+	1708, 2232, 4401, 4779, 3298, 2037, 7229, 7353, 3864, 4484, 3101,
+	2847, 2457, 1830, 5415, 2072, 4292, 2698, 5365, 2252, 5594, 3089,
+	4362, 2346, 3503, 2507, 3127, 5309, 3425, 2882, 2949, 4297, 4883,
+	1871, 4679, 3721, 2694, 1923, 5475, 3070, 2014, 3300, 3079, 2726,
+	2555, 5351, 4655, 3600, 2890, 2260, 2515, 2631, 4763, 4562, 3505,
+	2019, 3129, 3276, 2838, 2485, 1865, 2527, 5438, 1994, 3737, 3346,
+	2642, 5593, 2880, 2369, 5248, 2431, 2910, 3488, 4402, 2212, 2151,
+	3289, 1879, 4084, 3713, 2724, 2042, 2117, 4769, 5467, 2444, 3057,
+	5402, 2650, 2077, 2918, 2291, 2552, 4524, 4764, 5319, 1836, 2412,
+	3274, 3518, 4476, 2047, 7195, 2994, 2676, 1916, 3976, 2535, 3927,
+	4298, 2308, 3736, 3566, 7383, 2771, 3345, 3715, 2908, 3085, 5249,
+	3645, 2560, 1717, 3784, 5487, 5286, 3105, 2851, 4563, 3942, 2073,
+	1910, 5532, 3520, 2572, 5448, 2472, 2238, 1866, 3319, 2734, 5374,
+	4909, 4409, 2288, 5258, 2886, 4661, 4477, 4344, 5341, 3163, 4900,
+	2704, 3496, 1924, 5789, 5540, 2769, 3304, 2316, 2447, 2730, 5660,
+	1932, 2228, 7372, 3565, 2122, 2336, 2628, 1718, 2870, 3820, 7354,
+	3509, 2020, 5495, 2296, 3321, 5530, 3133, 4591, 1837, 4428, 5422,
+	3941, 2580, 5281, 3165, 2266, 2521, 2353, 2646, 3382, 5259, 2914,
+	3494, 4884, 4345, 4485, 2946, 3758, 2118, 4770, 2475, 5471, 5358,
+	2256, 5659, 4504, 5406, 3957, 2078, 1911, 4494, 2779, 5384, 2333,
+	4571, 7355, 2954, 2048, 4352, 3208, 2492, 7275, 1872, 4662, 2862,
+	5430, 4514, 2083, 3933, 2015, 5346, 2663, 2361, 2775, 4890, 5546,
+	2427, 4799, 3539, 2452, 2233, 5410, 3381, 2944, 4885, 7188, 4299,
+	2341, 3286, 3757, 2848, 5481, 5536, 3524, 5366, 3210, 2671, 2566,
+	3956, 7196, 3935, 2508, 2896, 3427, 4564, 2054, 7363, 4601, 3541,
+	4690, 1880, 4004, 2991, 3723, 4478, 2043, 2740, 4486, 2691, 1933,
+	3067, 2123, 4789, 2480, 3602, 2261, 2632, 3284, 5293, 3325, 2999,
+	4765, 1917, 3102, 5724, 5504, 2193, 2128, 4581, 3169, 1873, 2699,
+	2643, 3725, 5595, 3090, 2589, 4410, 2924, 2302, 1838, 2432, 3426,
+	4670, 2989, 2711, 5260, 5287, 2950, 4040, 3110, 4438, 4346, 3708,
+	3301, 5468, 3058, 2511, 2292, 3601, 2553, 2891, 4572, 5301, 5597,
+	3100, 4363, 3506, 2866, 1925, 3130, 3978, 7356, 2084, 4479, 7364,
+	3309, 2273, 2528, 2667, 1881, 2785, 5439, 3738, 4891, 3347, 4411,
+	2370, 3647, 4663, 3082, 4700, 2021, 3786, 5352, 3138, 3290, 2852,
+	3299, 7394, 4886, 3865, 5514, 2458, 3214, 1918, 2239, 5416, 4418,
+	4920, 5375, 2347, 2919, 3504, 3740, 2055, 4525, 3349, 3128, 3545,
+	2883, 7189, 2995, 2677, 2090, 3977, 4347, 4680, 2536, 5288, 4771,
+	2695, 3116, 5476, 2448, 3071, 7373, 3567, 2079, 3716, 2727, 2556,
+	2337, 3646, 4300, 2049, 2516, 3822, 7218, 2297, 3785, 7241, 5488,
+	3106, 3943, 7357, 2413, 2129, 3277, 2486, 3521, 2573, 1926, 2267,
+	5449, 2647, 3729, 4612, 2735, 7252, 4910, 4671, 3569, 2960, 2208,
+	2911, 1874, 2705, 2098, 2747, 7287, 5541, 3529, 2022, 4085, 3714,
+	3144, 3305, 2476, 5472, 5661, 4505, 3945, 5385, 2651, 4301, 5601,
+	7207, 3821, 3510, 5496, 3322, 3519, 2863, 3134, 5310, 2135, 2581,
+	1934, 2664, 3166, 3928, 2124, 2931, 2309, 4772, 7384, 2772, 4565,
+	2453, 3086, 5411, 3383, 4412, 5663, 5294, 7365, 3330, 2561, 1882,
+	2342, 3759, 2755, 2050, 4353, 3174, 5533, 3958, 4429, 2780, 3744,
+	3320, 3353, 2887, 3005, 2955, 4006, 2091, 1919, 3385, 3164, 2143,
+	3497, 2792, 2213, 5790, 2692, 3068, 3761, 5547, 4711, 2317, 4800,
+	2731, 4664, 2481, 4573, 5359, 5302, 5407, 3960, 7197, 5553, 2871,
+	2085, 5726, 5320, 5347, 4592, 3525, 4582, 5423, 4892, 3211, 2522,
+	2354, 1935, 2303, 3936, 2897, 3428, 3573, 4413, 2428, 2915, 4602,
+	3542, 4042, 4439, 2099, 1927, 4005, 5295, 2947, 2800, 3336, 3219,
+	2741, 4780, 4419, 3949, 3180, 2512, 7264, 3603, 2056, 5367, 3550,
+	4487, 5561, 3326, 3000, 2867, 4302, 2136, 3430, 3209, 2493, 5725,
+	5505, 2274, 5431, 2668, 3170, 4515, 3934, 3726, 2362, 2590, 2776,
+	2925, 3540, 3083, 5667, 2967, 2229, 4566, 2712, 4041, 3605, 3111,
+	4574, 5303, 2633, 2130, 3867, 3287, 4773, 5482, 2459, 5537, 5417,
+	4810, 2086, 7208, 2672, 2567, 4893, 2348, 4672, 4488, 4354, 5598,
+	2884, 3389, 2144, 3979, 4691, 2433, 4681, 2992, 3724, 3310, 2696,
+	5477, 2786, 2152, 3072, 3765, 2728, 3225, 2557, 3648, 2975, 4790,
+	7366, 2257, 5606, 7230, 7219, 3964, 1883, 2517, 4495, 4701, 3787,
+	3139, 2057, 5311, 4303, 7395, 3556, 3278, 3866, 2487, 5515, 3103,
+	3215, 3981, 2700, 4921, 5596, 3091, 3741, 3012, 5353, 3350, 2912,
+	3546, 4567, 2234, 3650, 2951, 4087, 4781, 1936, 3789, 3117, 2809,
+	2853, 2131, 3302, 2652, 3749, 7367, 3358, 5296, 4665, 4623, 2892,
+	3823, 2092, 5376, 4673, 3434, 3507, 2214, 5570, 3131, 4901, 2529,
+	3730, 2310, 5440, 3739, 4613, 7385, 2773, 3348, 3087, 2449, 3020,
+	2371, 7374, 3570, 1928, 2562, 2961, 3609, 2262, 5612, 5321, 4364,
+	3291, 2748, 3530, 4086, 3825, 3145, 5312, 5534, 3946, 3578, 4575,
+	5304, 2648, 3151, 5602, 2920, 2888, 4526, 2100, 4489, 5354, 3498,
+	2996, 2678, 5792, 2153, 4894, 2537, 2318, 4931, 2732, 2932, 3568,
+	3717, 2477, 5473, 5664, 4506, 4666, 3331, 2872, 4821, 2293, 2137,
+	3364, 5386, 4774, 2756, 5489, 4593, 3107, 5424, 5580, 3175, 2093,
+	3944, 3522, 4420, 2574, 3985, 2523, 2355, 5450, 5672, 2058, 4355,
+	3745, 2736, 4722, 3354, 4911, 2916, 3006, 4007, 2454, 3654, 5412,
+	3386, 2948, 2706, 2793, 3793, 5791, 5542, 5322, 4430, 3306, 3762,
+	4712, 5662, 2240, 3961, 7368, 3394, 5554, 2145, 3584, 4775, 3511,
+	4490, 5497, 2494, 5727, 3323, 3135, 5432, 2101, 4516, 3770, 4902,
+	4009, 2582, 3167, 2363, 2777, 4674, 5360, 4356, 7375, 3969, 3574,
+	4496, 3384, 4043, 2482, 3029, 2338, 1937, 3288, 2801, 3760, 3337,
+	3829, 5483, 2298, 3220, 2138, 5538, 5729, 3950, 2673, 2568, 3181,
+	3959, 7198, 2268, 5619, 4365, 2781, 3551, 5562, 5678, 5313, 2235,
+	3187, 2956, 4692, 3431, 2993, 4782, 4045, 4440, 5548, 4801, 5368,
+	7231, 4791, 5668, 2968, 7209, 2513, 7369, 3439, 3606, 1938, 3868,
+	3104, 3400, 2146, 3526, 4811, 2868, 2701, 3212, 7253, 3092, 3776,
+	5305, 2669, 3371, 3937, 2898, 3429, 3614, 2094, 5361, 2263, 5413,
+	3195, 3390, 4942, 4603, 2952, 3543, 4497, 4895, 2343, 3766, 2742,
+	3303, 3870, 3226, 2460, 2976, 5418, 5607, 3604, 3965, 2893, 5323,
+	2434, 3327, 3508, 3232, 3001, 3557, 3132, 2154, 7276, 4421, 4013,
+	5506, 2530, 3171, 5441, 4783, 3982, 4576, 7199, 3727, 2697, 2591,
+	3591, 5478, 2372, 3073, 2926, 3013, 2102, 4357, 5369, 2558, 2713,
+	3651, 3292, 4896, 3112, 4088, 2518, 3790, 3445, 3990, 2810, 5733,
+	4583, 2488, 3750, 3359, 2921, 4624, 4527, 2304, 3659, 2139, 5599,
+	3240, 3435, 2997, 2679, 3798, 5571, 3620, 3980, 4422, 4049, 4441,
+	2538, 3311, 2787, 4675, 4090, 3718, 5685, 2241, 3021, 3649, 5377,
+	3610, 4702, 2653, 3788, 5613, 5490, 3140, 3108, 7396, 4903, 3826,
+	4431, 4776, 3523, 5516, 2575, 3216, 5451, 2155, 2275, 7406, 4366,
+	4922, 3579, 2737, 4912, 3152, 5314, 3742, 3407, 2147, 7386, 4358,
+	3351, 3547, 3088, 2707, 3834, 2299, 5793, 5543, 2563, 4832, 3307,
+	3118, 4932, 3874, 7265, 5362, 2269, 5419, 3996, 7242, 4822, 2349,
+	3824, 3365, 3512, 5498, 3324, 3136, 5581, 2889, 3665, 2583, 3168,
+	3986, 3804, 4682, 5627, 3731, 4367, 5479, 5795, 4614, 5673, 4507,
+	4577, 4723, 5315, 2733, 5387, 3571, 2242, 2962, 3655, 4784, 5378,
+	3794, 2749, 3531, 2873, 4897, 3146, 4594, 4904, 5370, 5425, 3947,
+	2782, 2524, 5324, 2455, 5603, 3452, 7376, 3395, 3585, 2957, 2917,
+	2344, 3249, 3771, 4010, 3840, 4423, 4733, 4094, 5549, 4802, 2933,
+	3970, 2103, 3627, 5665, 2270, 2654, 3030, 3332, 3830, 2757, 4018,
+	3527, 3176, 3213, 2495, 5730, 5433, 4517, 3938, 5620, 2899, 2311,
+	4578, 3746, 5325, 2483, 7387, 2778, 3355, 5679, 4508, 4604, 3544,
+	3007, 3188, 4008, 2156, 3387, 5388, 2794, 4046, 2743, 5738, 7288,
+	5693, 4584, 3763, 4713, 5484, 5539, 4676, 2305, 2674, 2569, 3962,
+	3440, 3328, 3002, 5555, 4432, 4054, 5728, 7220, 3401, 5507, 4953,
+	3172, 4693, 4424, 5799, 3728, 2592, 3777, 3372, 2927, 2319, 5636,
+	2148, 4368, 3575, 3672, 3615, 2714, 3196, 4943, 7200, 4044, 3113,
+	3811, 2874, 2802, 3338, 3221, 7418, 7210, 2276, 5426, 3871, 4024,
+	4498, 3951, 3182, 5379, 2356, 2702, 5600, 3552, 3093, 5563, 3233,
+	4433, 4905, 3432, 4014, 3312, 2788, 3879, 2953, 2461, 7377, 3592,
+	5744, 3415, 4677, 4585, 5669, 2969, 2350, 4703, 4785, 3141, 3847,
+	3607, 2306, 2894, 7397, 3869, 3446, 5517, 3991, 3217, 5371, 5734,
+	4683, 4812, 5434, 4060, 7232, 4499, 4518, 4923, 2531, 2364, 5442,
+	5646, 4369, 3743, 3352, 3660, 3548, 3241, 5326, 2519, 3391, 3799,
+	3621, 4050, 3119, 3293, 3767, 2489, 5485, 4091, 5686, 7243, 3227,
+	3635, 2277, 2675, 2977, 5389, 5608, 3966, 4786, 2922, 3558, 4528,
+	7211, 4099, 2998, 3732, 2680, 7407, 4615, 3983, 2539, 3885, 3460,
+	2462, 3572, 3014, 5420, 3408, 2963, 4792, 2157, 3835, 3652, 2351,
+	2750, 4833, 3532, 4089, 3147, 3791, 5491, 3875, 2811, 3109, 3948,
+	3997, 5702, 2312, 4684, 2576, 3751, 2703, 7388, 5452, 3360, 5604,
+	3094, 4625, 2738, 7201, 2564, 3436, 4031, 3666, 5572, 4434, 3805,
+	2708, 5628, 2934, 5796, 5544, 3308, 3680, 2490, 5666, 3022, 3333,
+	4843, 2158, 3611, 2895, 5614, 2758, 3177, 5751, 3827, 3513, 5499,
+	4586, 5804, 3137, 5380, 2320, 2584, 5443, 4105, 3747, 4500, 3580,
+	3356, 3153, 3453, 3008, 2373, 4906, 3388, 4067, 4442, 2795, 3250,
+	5794, 4595, 3841, 7378, 3764, 4734, 4095, 4714, 4933, 5712, 2525,
+	2357, 3628, 3963, 3855, 2313, 5556, 4823, 2923, 2783, 3366, 7277,
+	4529, 4019, 5582, 2958, 4435, 2278, 2681, 3987, 5381, 5674, 3576,
+	4724, 5550, 4803, 3469, 4907, 3656, 2803, 3339, 3795, 3222, 3892,
+	2496, 5739, 5492, 7379, 5694, 4509, 7221, 3952, 3183, 3528, 5390,
+	2365, 5453, 5810, 4501, 3553, 4854, 2321, 5564, 2739, 4913, 3396,
+	3586, 2900, 3433, 4055, 4954, 4605, 4685, 3772, 2709, 4011, 5486,
+	5800, 5545, 4596, 2744, 5427, 5637, 2570, 5670, 2970, 3971, 3673,
+	2526, 2358, 3608, 3031, 3812, 4787, 3831, 4694, 5500, 3329, 3003,
+	4813, 7419, 4510, 4025, 5731, 5508, 5391, 3173, 5621, 4793, 2593,
+	4964, 5680, 2928, 3392, 3189, 2715, 4112, 3880, 3768, 4047, 3114,
+	3228, 2497, 5745, 5435, 3416, 2978, 5609, 4519, 3967, 3689, 2366,
+	2784, 3848, 3441, 5759, 3559, 7254, 2959, 3402, 4061, 3984, 2314,
+	3313, 3778, 7389, 2789, 3373, 5647, 3015, 5551, 7212, 3616, 4075,
+	4443, 2571, 3197, 3653, 4944, 4908, 4704, 3792, 3142, 2812, 7398,
+	2532, 4695, 3872, 7380, 5518, 3636, 3752, 3218, 3361, 4626, 2374,
+	4924, 2901, 3437, 5768, 3234, 4794, 5573, 4587, 4606, 3549, 4015,
+	5817, 4100, 2322, 2745, 3886, 3593, 3120, 3461, 3023, 4975, 4444,
+	3612, 5615, 4530, 3900, 3828, 4597, 3004, 3447, 5428, 3992, 5735,
+	2540, 5509, 5703, 4511, 2359, 3581, 3733, 3154, 5392, 4616, 2929,
+	3661, 3242, 4032, 4686, 3800, 7289, 2716, 3622, 2964, 5493, 4051,
+	3115, 4934, 2751, 3533, 2577, 4092, 7381, 5687, 3148, 3681, 2533,
+	5444, 4844, 4914, 4824, 3367, 3909, 2375, 5605, 5583, 2498, 5752,
+	5436, 5805, 7244, 3988, 3314, 7408, 2367, 2790, 5675, 4106, 4725,
+	2935, 3409, 3657, 4068, 7266, 3836, 4120, 4705, 3796, 5501, 3334,
+	4834, 3143, 7233, 7399, 2759, 3876, 2585, 5519, 3178, 2682, 5713,
+	4512, 3998, 2541, 3856, 7213, 3397, 3587, 3748, 3357, 4696, 3667,
+	3009, 3773, 7430, 4012, 4588, 3806, 7390, 2796, 5629, 3121, 5797,
+	3972, 4795, 4715, 2578, 5454, 3470, 3032, 4129, 4445, 3832, 4915,
+	3893, 5557, 5732, 2710, 5622, 5811, 5552, 4804, 4617, 4855, 5681,
+	3454, 3190, 3577, 2965, 5825, 3251, 4048, 2323, 2752, 3842, 3534,
+	7391, 2804, 4735, 3340, 4096, 3149, 3223, 2586, 3953, 3629, 3184,
+	2902, 3442, 7255, 7222, 3554, 3403, 4020, 5565, 5778, 2534, 4589,
+	5445, 3779, 3374, 2936, 2376, 3617, 4965, 3198, 4945, 5671, 2971,
+	5834, 3335, 4446, 4113, 4687, 5740, 2760, 5510, 5695, 3873, 3179,
+	4814, 2594, 3690, 2930, 7245, 4805, 3235, 4598, 5760, 5437, 3010,
+	4056, 4520, 4016, 4955, 3393, 2542, 2368, 2797, 5801, 3594, 3769,
+	4716, 5638, 3674, 3229, 4076, 2979, 5610, 3813, 3968, 5494, 3448,
+	5558, 3993, 4986, 5736, 7420, 4607, 3560, 2579, 4026, 5455, 2746,
+	4916, 3662, 3243, 4697, 5769, 7223, 3016, 3801, 3623, 4521, 4052,
+	5818, 7400, 4688, 3881, 2805, 3341, 5520, 4093, 3224, 5688, 4796,
+	5746, 7392, 2813, 3417, 4925, 3185, 4976, 3753, 2595, 3362, 3849,
+	3901, 3555, 5502, 4627, 5566, 3438, 2717, 2587, 4062, 7409, 5574,
+	3122, 5648, 3410, 7267, 2972, 3024, 3837, 4835, 3613, 5616, 5844,
+	3877, 4797, 3999, 4815, 3637, 4618, 2791, 3582, 3910, 2966, 3155,
+	3668, 4599, 5446, 4706, 3807, 4101, 5630, 3150, 5798, 7401, 4806,
+	2377, 3887, 7393, 4935, 3462, 3230, 2980, 5611, 4926, 4121, 4825,
+	3368, 3561, 5584, 5704, 2937, 3989, 4531, 3455, 5676, 3017, 4608,
+	4726, 4033, 3252, 2543, 7431, 3658, 5447, 3843, 7442, 4522, 4736,
+	4097, 3797, 2378, 2814, 3682, 3630, 3754, 4845, 3363, 7234, 4628,
+	4130, 3011, 4021, 3398, 3588, 5753, 4600, 5511, 5456, 5575, 5806,
+	3774, 2753, 2596, 4717, 4917, 4107, 4532, 3025, 3973, 2718, 4069,
+	5826, 5559, 4698, 3033, 5617, 5741, 5696, 3833, 5714, 7278, 3857,
+	3583, 5503, 5623, 3156, 4057, 4956, 5682, 2588, 5779, 3191, 5457,
+	3342, 4523, 5802, 2761, 4918, 4936, 5639, 3186, 3675, 3471, 4707,
+	3814, 5835, 5567, 4826, 3369, 3443, 3894, 7421, 5521, 4027, 5585,
+	3404, 2798, 4927, 5812, 4856, 3780, 5677, 2973, 7235, 3375, 4727,
+	3618, 4699, 3199, 4946, 3882, 5560, 4807, 7224, 5747, 3418, 4798,
+	3850, 3399, 3589, 4987, 3236, 4063, 3775, 4017, 2806, 4619, 5649,
+	3231, 2981, 7256, 3595, 3974, 4533, 4966, 3034, 2754, 3562, 4114,
+	5568, 4808, 3449, 3638, 3994, 5737, 3691, 5624, 3018, 5761, 5683,
+	5512, 3663, 3192, 3244, 4102, 3802, 3624, 2597, 4053, 5458, 2938,
+	3888, 3463, 4816, 4919, 4609, 5689, 4077, 4629, 3444, 2762, 5845,
+	5576, 3405, 5705, 3781, 7410, 3376, 3026, 4534, 4034, 5770, 3619,
+	5513, 3411, 5618, 3200, 4947, 2799, 5819, 3838, 2598, 4836, 4718,
+	3683, 3878, 4708, 4846, 4977, 4000, 3157, 7402, 3902, 5754, 5522,
+	3237, 5807, 3669, 4928, 7225, 4108, 3808, 5631, 2815, 3596, 7290,
+	4070, 4827, 3370, 2807, 4630, 7443, 5586, 3450, 3995, 5715, 5577,
+	7279, 7268, 3858, 4728, 7403, 3911, 3664, 5523, 3456, 3245, 3803,
+	3625, 7257, 4929, 3253, 3844, 4737, 4098, 5690, 2974, 3472, 4610,
+	3631, 3590, 4122, 3158, 3895, 4817, 4022, 5813, 7411, 4857, 4937,
+	3412, 3035, 7432, 3839, 7236, 4620, 4837, 5742, 5587, 4809, 5697,
+	2982, 5625, 4001, 2763, 5684, 4131, 3193, 4729, 3670, 4058, 4957,
+	3809, 5632, 5803, 3019, 4967, 5640, 4611, 3676, 5827, 4115, 3815,
+	3406, 4719, 2816, 7422, 3782, 4028, 3377, 3692, 7246, 4709, 5762,
+	3457, 3201, 4948, 7404, 5780, 5524, 3254, 3845, 3883, 4738, 4930,
+	3027, 4078, 5748, 3419, 3632, 2808, 5626, 5836, 3238, 3851, 7269,
+	4023, 3194, 4064, 3597, 5569, 5771, 5650, 5820, 3451, 4938, 5743,
+	5698, 4621, 4978, 3639, 4828, 4710, 3378, 3903, 3246, 7405, 4818,
+	3626, 4988, 4059, 3202, 7237, 4958, 4103, 7291, 5691, 7247, 3889,
+	5641, 3464, 3677, 3816, 3239, 7423, 2983, 7412, 4029, 5706, 3413,
+	3598, 3912, 4819, 4838, 4035, 3884, 4622, 4002, 5749, 3420, 3684,
+	3036, 4123, 4847, 3852, 2817, 3671, 5846, 3247, 5755, 3810, 4720,
+	5633, 4065, 5808, 7258, 4109, 5651, 5692, 5578, 7433, 4071, 3028,
+	3640, 5716, 7413, 3458, 4132, 3859, 2818, 3414, 3255, 3846, 4739,
+	4104, 4839, 4631, 3890, 3465, 3633, 5579, 4949, 5828, 3473, 4939,
+	7444, 3896, 4721, 5707, 4829, 5634, 5814, 5588, 4858, 5781, 4036,
+	7280, 5699, 4730, 3685, 5837, 4848, 4940, 3459, 4959, 5756, 5809,
+	3256, 4830, 5642, 4740, 4110, 3678, 5589, 3817, 3634, 4968, 4072,
+	7424, 4116, 4030, 7270, 5717, 3037, 3860, 3693, 4989, 5763, 4820,
+	7414, 5750, 3421, 5700, 4632, 3474, 3853, 4079, 7248, 3897, 4066,
+	4960, 3038, 5652, 5815, 4859, 5643, 3679, 5772, 3818, 5635, 5821,
+	3641, 7425, 3203, 4950, 4979, 5847, 3904, 4941, 3891, 3466, 4633,
+	7281, 3422, 4969, 5590, 3854, 4741, 4117, 5708, 4731, 4951, 3694,
+	5653, 4037, 5764, 3913, 3686, 3248, 4849, 3642, 4080, 7445, 5757,
+	4124, 5701, 7292, 4111, 4831, 3467, 4073, 5773, 7249, 5822, 7434,
+	5644, 5718, 4732, 3861, 5709, 4980, 4840, 3905, 4133, 7426, 4038,
+	3475, 3687, 4850, 7415, 3898, 5829, 5758, 3423, 5816, 4860, 4841,
+	4952, 3914, 4074, 5782, 5654, 5719, 3257, 3862, 7259, 4125, 5838,
+	3643, 4970, 3476, 4118, 7435, 3899, 3468, 3695, 3258, 7293, 5765,
+	4861, 7271, 4134, 4990, 5710, 4081, 4961, 5830, 7416, 3688, 4851,
+	5774, 7427, 7282, 4971, 5823, 5783, 4119, 4981, 4962, 3906, 3696,
+	5839, 5766, 5645, 5848, 5720, 3863, 4082, 7417, 3477, 3915, 4842,
+	5775, 4742, 4991, 5824, 4862, 4126, 4982, 3907, 5655, 7446, 7436,
+	5711, 4972, 4135, 4963, 3916, 4743, 5849, 7260, 3697, 5767, 5831,
+	7428, 4127, 4083, 5784, 5721, 7437, 4852, 5776, 5840, 7294, 4136,
+	7447, 5656, 4983, 3908, 7429, 5832, 4863, 4992, 5785, 3478, 3917,
+	5841, 7272, 7261, 4128, 3698, 7283, 7438, 5850, 4993, 4973, 4137,
+	5722, 5777, 5833, 4984, 4853, 5786, 7448, 5851, 7284, 5842, 7273,
+	3918, 4974, 4994, 7449, 4864, 7439, 4138, 7295, 7440, 5852, 4985,
+	5787, 5843, 5788, 7450, 7296, 4995, 4996, 5853, 7441, 5854, 7451,
+	7285, 7452, 7297, 7453}
@@ -0,0 +1,217 @@
+package cactuskev
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestLowball(t *testing.T) {
+	for _, tc := range []struct {
+		hand   string
+		a5     AceToFiveScore
+		a5cat  LowCategory
+		d7cat  LowCategory
+		d7best bool
+	}{
+		{"5c 4d 3h 2s Ac", 1, Wheel, NumberA, false},
+		{"6c 5d 4h 3s 2c", 6, Number6, LowStraight, false},
+		{"7c 5d 4h 3s 2c", 11, Number7, Number7, true},
+		{"7c 5c 4c 3c 2c", 11, Number7, LowFlush, false},
+		{"Kc Qd Jh Ts 9c", 1287, NumberK, LowStraight, false},
+		{"Ac Ad 2h 3s 4c", 1288, LowOnePair, LowOnePair, false},
+		{"Kc Kd Ks Kh Qc", 6175, LowFourOfAKind, LowFourOfAKind, false},
+		{"Ac Kc Qc Jc Tc", 0, NumberK, LowStraightFlush, false},
+	} {
+		h := mustParseFive(t, tc.hand)
+
+		a5 := h.EvalAceToFive()
+		if tc.a5 != 0 && a5 != tc.a5 {
+			t.Errorf("%v: expected Ace-to-Five %d, got %d", h, tc.a5, a5)
+		}
+		if c := a5.Category(); c != tc.a5cat {
+			t.Errorf("%v: expected Ace-to-Five %v, got %v", h, tc.a5cat, c)
+		}
+
+		d7 := h.EvalDeuceToSeven()
+		if c := d7.Category(); c != tc.d7cat {
+			t.Errorf("%v: expected Deuce-to-Seven %v, got %v", h, tc.d7cat, c)
+		}
+		if tc.d7best != (d7 == 1) {
+			t.Errorf("%v: unexpected Deuce-to-Seven %d", h, d7)
+		}
+	}
+
+	if s := mustParseFive(t, "Ah Kh Qh Jh Th").EvalDeuceToSeven(); s != 7462 {
+		t.Errorf("expected royal flush to be the worst Deuce-to-Seven hand, got %v", s)
+	}
+}
+
+func mustParseFive(t testing.TB, s string) *FiveCardHand {
+	h, err := ParseHand(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h.(*FiveCardHand)
+}
+
+// lowKey ranks a hand the slow way: the Category, then the values of its
+// ranks by significance, lower being better.
+func lowKey(h *FiveCardHand, aceLow bool) (key int, cat LowCategory) {
+	var (
+		counts [13]int
+		cards  = h.Cards()
+		suited = h.IsSuited()
+	)
+
+	value := func(r Rank) int {
+		if aceLow {
+			return (int(r) + 1) % 13
+		}
+		return int(r)
+	}
+
+	for _, c := range cards {
+		counts[value(c.Rank())]++
+	}
+
+	var vs []int
+	for v := range counts {
+		if counts[v] > 0 {
+			vs = append(vs, v)
+		}
+	}
+	sort.Slice(vs, func(i, j int) bool {
+		if counts[vs[i]] != counts[vs[j]] {
+			return counts[vs[i]] > counts[vs[j]]
+		}
+		return vs[i] > vs[j]
+	})
+
+	straight := len(vs) == 5 && vs[0]-vs[4] == 4 && !aceLow
+	flush := suited && !aceLow
+
+	switch {
+	case straight && flush:
+		cat = LowStraightFlush
+	case counts[vs[0]] == 4:
+		cat = LowFourOfAKind
+	case counts[vs[0]] == 3 && counts[vs[1]] == 2:
+		cat = LowFullHouse
+	case flush:
+		cat = LowFlush
+	case straight:
+		cat = LowStraight
+	case counts[vs[0]] == 3:
+		cat = LowThreeOfAKind
+	case counts[vs[0]] == 2 && counts[vs[1]] == 2:
+		cat = LowTwoPair
+	case counts[vs[0]] == 2:
+		cat = LowOnePair
+	case aceLow && vs[0] == 4:
+		cat = Wheel
+	case aceLow:
+		cat = Number6 + LowCategory(vs[0]-5)
+	default:
+		cat = Number7 + LowCategory(vs[0]-5)
+	}
+
+	key = int(cat)
+	for _, v := range vs {
+		key = key*13 + v
+	}
+	for i := len(vs); i < 5; i++ {
+		key *= 13
+	}
+
+	return key, cat
+}
+
+func TestLowballAllHands(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping all hands in short mode")
+	}
+
+	var (
+		deck = NewDeck()
+		a5   = make(map[AceToFiveScore]int)
+		d7   = make(map[DeuceToSevenScore]int)
+		h    FiveCardHand
+	)
+
+	for a := 0; a < 48; a++ {
+		for b := a + 1; b < 49; b++ {
+			for c := b + 1; c < 50; c++ {
+				for d := c + 1; d < 51; d++ {
+					for e := d + 1; e < 52; e++ {
+						h.A, h.B, h.C, h.D, h.E = deck[a], deck[b], deck[c], deck[d], deck[e]
+
+						s := h.EvalAceToFive()
+						key, cat := lowKey(&h, true)
+						if s.Category() != cat {
+							t.Fatalf("%v: expected Ace-to-Five %v, got %v", &h, cat, s)
+						}
+						if k, ok := a5[s]; ok && k != key {
+							t.Fatalf("%v: Ace-to-Five %v is shared by unequal hands", &h, s)
+						}
+						a5[s] = key
+
+						u := h.EvalDeuceToSeven()
+						if key, cat = lowKey(&h, false); u.Category() != cat {
+							t.Fatalf("%v: expected Deuce-to-Seven %v, got %v", &h, cat, u)
+						}
+						if k, ok := d7[u]; ok && k != key {
+							t.Fatalf("%v: Deuce-to-Seven %v is shared by unequal hands", &h, u)
+						}
+						d7[u] = key
+					}
+				}
+			}
+		}
+	}
+
+	if len(a5) != 6175 || len(d7) != 7462 {
+		t.Fatalf("expected 6175 and 7462 scores, got %d and %d", len(a5), len(d7))
+	}
+	for s := AceToFiveScore(2); s <= 6175; s++ {
+		if a5[s-1] >= a5[s] {
+			t.Errorf("Ace-to-Five %v is not worse than %v", s, s-1)
+		}
+	}
+	for s := DeuceToSevenScore(2); s <= 7462; s++ {
+		if d7[s-1] >= d7[s] {
+			t.Errorf("Deuce-to-Seven %v is not worse than %v", s, s-1)
+		}
+	}
+}
+
+func TestEvalRazz(t *testing.T) {
+	cards := mustParse(t, "Kc Kd 8h 5s 3c Ad 2h")
+	s, five := EvalRazz(cards)
+	if want := mustParseFive(t, "8h 5s 3c Ad 2h").EvalAceToFive(); s != want {
+		t.Errorf("expected %v, got %v from %v", want, s, five)
+	}
+
+	for i := 0; i < 1000; i++ {
+		cards := RandomHand(7).Cards()
+
+		s, five := EvalRazz(cards)
+		h := FiveCardHand{A: five[0], B: five[1], C: five[2], D: five[3], E: five[4]}
+		if h.EvalAceToFive() != s {
+			t.Errorf("%v: %v scores %v, expected %v", cards, five, h.EvalAceToFive(), s)
+		}
+
+		for _, c := range combinations[7] {
+			h := FiveCardHand{A: cards[c[0]], B: cards[c[1]], C: cards[c[2]], D: cards[c[3]], E: cards[c[4]]}
+			if s.Less(h.EvalAceToFive()) {
+				t.Errorf("%v: %v beats %v", cards, &h, s)
+			}
+		}
+	}
+}
+
+func BenchmarkAceToFive(b *testing.B) {
+	h := mustParseFive(b, "Ac Ad 2h 3s 4c")
+	for i := 0; i < b.N; i++ {
+		h.EvalAceToFive()
+	}
+}
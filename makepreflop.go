@@ -0,0 +1,287 @@
+//go:build ignore
+
+// makepreflop generates the preflop equity tables of preflop_arrays.go with
+// the seven-card evaluator. The heads-up table is exact: every board is
+// dealt, once for each way of renaming its suits, for every pair of hole
+// cards. The equities against 2 or more random hands are estimated by
+// RangeEquity from -trials random deals each. It takes about 25 minutes on
+// a single CPU, and only runs from go generate with -tags preflop.
+//
+// Usage:
+//
+//	go run makepreflop.go [-trials n] [-output file]
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+
+	cactuskev "github.com/martinolsen/cactuskev-go"
+)
+
+var (
+	output = flag.String("output", "preflop_arrays.go", "file to write, or - for stdout")
+	trials = flag.Int("trials", 500000, "random deals per equity against 2 or more hands")
+)
+
+const n = cactuskev.NumStartingHands
+
+// A combo is one pair of hole cards left live by a board.
+type combo struct {
+	cards [2]cactuskev.Card
+	set   cactuskev.CardSet
+	hand  cactuskev.StartingHand
+	score cactuskev.Score
+}
+
+// showdowns counts, for each pair of starting hands, the showdowns the first
+// wins and ties, over every board and every pair of their combos that share
+// no card.
+type showdowns struct {
+	wins, ties [n][n]int64
+}
+
+// headsUp deals every board, taking one board of each set of boards that
+// differ only by their suits and counting it for all of them.
+func headsUp() *showdowns {
+	var (
+		s     = new(showdowns)
+		board = make([]cactuskev.Card, 5)
+	)
+
+	cactuskev.AllCards.Combinations(5, func(b cactuskev.CardSet) {
+		if _, c, err := cactuskev.Canonicalize(nil, b.ToCards()); err != nil {
+			log.Fatal(err)
+		} else if cactuskev.FromCards(c...) != b {
+			return
+		}
+		copy(board, b.ToCards())
+		n, err := cactuskev.Isomorphs(nil, board)
+		if err != nil {
+			log.Fatal(err)
+		}
+		s.add(board, b, int64(n))
+	})
+
+	return s
+}
+
+// add counts the showdowns on one board w times.
+func (s *showdowns) add(board []cactuskev.Card, b cactuskev.CardSet, w int64) {
+	var (
+		live   = cactuskev.AllCards.Difference(b).ToCards()
+		combos = make([]combo, 0, 1081)
+		byCard [52][]int // combos holding each card, by Index
+	)
+
+	cactuskev.Combinations(live, 2, func(cards []cactuskev.Card) {
+		h := [2]cactuskev.Card{cards[0], cards[1]}
+		combos = append(combos, combo{
+			cards: h,
+			set:   cactuskev.FromCards(cards...),
+			hand:  cactuskev.StartingHandOf(h),
+			score: cactuskev.EvalSeven(h[0], h[1], board[0], board[1], board[2], board[3], board[4]),
+		})
+	})
+
+	// Worst first.
+	sort.Slice(combos, func(i, j int) bool { return combos[i].score > combos[j].score })
+
+	for i, c := range combos {
+		byCard[c.cards[0].Index()] = append(byCard[c.cards[0].Index()], i)
+		byCard[c.cards[1].Index()] = append(byCard[c.cards[1].Index()], i)
+	}
+
+	// Each combo beats every worse combo, and ties every combo of the
+	// same score, counted by starting hand ...
+	var worse, same [n]int64
+	for i := 0; i < len(combos); {
+		j := i
+		for j < len(combos) && combos[j].score == combos[i].score {
+			same[combos[j].hand]++
+			j++
+		}
+
+		for _, c := range combos[i:j] {
+			wins, ties := &s.wins[c.hand], &s.ties[c.hand]
+			for h := range worse {
+				wins[h] += w * worse[h]
+				ties[h] += w * same[h]
+			}
+		}
+
+		for _, c := range combos[i:j] {
+			worse[c.hand]++
+			same[c.hand]--
+		}
+		i = j
+	}
+
+	// ... but for the combos sharing a card with it, itself included.
+	for i, c := range combos {
+		uncount := func(k int) {
+			switch o := combos[k]; {
+			case o.score > c.score:
+				s.wins[c.hand][o.hand] -= w
+			case o.score == c.score:
+				s.ties[c.hand][o.hand] -= w
+			}
+		}
+
+		for _, k := range byCard[c.cards[0].Index()] {
+			uncount(k)
+		}
+		for _, k := range byCard[c.cards[1].Index()] {
+			if k != i { // only combo holding both cards
+				uncount(k)
+			}
+		}
+	}
+}
+
+// equity returns the share of the pot the first hand wins against the
+// second.
+func (s *showdowns) equity(a, b int) float64 {
+	total := s.wins[a][b] + s.wins[b][a] + s.ties[a][b]
+	return (float64(s.wins[a][b]) + float64(s.ties[a][b])/2) / float64(total)
+}
+
+// vsRandom returns the share of the pot hand wins against one random hand.
+func (s *showdowns) vsRandom(a int) float64 {
+	var won, total float64
+	for b := 0; b < n; b++ {
+		won += float64(s.wins[a][b]) + float64(s.ties[a][b])/2
+		total += float64(s.wins[a][b] + s.wins[b][a] + s.ties[a][b])
+	}
+	return won / total
+}
+
+// vsRandomHands estimates the share of the pot hand wins against opponents
+// random hands, dealing each of its combos in turn, the opponents' cards and
+// the board from the rest of the deck.
+func vsRandomHands(hand cactuskev.StartingHand, opponents int) float64 {
+	var (
+		shuffler = cactuskev.NewShuffler(int64(hand)*cactuskev.MaxPreflopOpponents + int64(opponents))
+		combos   = hand.Combos()
+		won      float64
+	)
+
+	for t := 0; t < *trials; t++ {
+		h := combos[t%len(combos)]
+
+		deck := cactuskev.NewDeck()
+		deck.RemoveAll(h[0], h[1])
+
+		// Deal the board and the opponents' cards by partial shuffle.
+		need := 5 + 2*opponents
+		for i := 0; i < need; i++ {
+			deck.Swap(i, i+shuffler.Intn(deck.Len()-i))
+		}
+		b := deck[:5]
+
+		var (
+			best    = cactuskev.EvalSeven(h[0], h[1], b[0], b[1], b[2], b[3], b[4])
+			winners = 1
+		)
+		for o := 0; o < opponents; o++ {
+			c := deck[5+2*o:]
+			switch s := cactuskev.EvalSeven(c[0], c[1], b[0], b[1], b[2], b[3], b[4]); {
+			case s == best:
+				winners++
+			case best.Less(s):
+				winners = 0
+			}
+			if winners == 0 {
+				break
+			}
+		}
+
+		if winners > 0 {
+			won += 1 / float64(winners)
+		}
+	}
+
+	return won / float64(*trials)
+}
+
+// writeTable writes the rows of a table of equities, one row per starting
+// hand.
+func writeTable(w *bytes.Buffer, decl string, rows [][]float64) {
+	const width = 65
+
+	fmt.Fprintf(w, "%s{\n", decl)
+
+	for i, row := range rows {
+		fmt.Fprintf(w, "\t{ // %v\n", cactuskev.StartingHand(i))
+
+		var line []byte
+		for _, v := range row {
+			tok := strconv.FormatFloat(v, 'f', 6, 64) + ","
+			if len(line) > 0 && len(line)+1+len(tok) > width {
+				fmt.Fprintf(w, "\t\t%s\n", line)
+				line = line[:0]
+			}
+			if len(line) > 0 {
+				line = append(line, ' ')
+			}
+			line = append(line, tok...)
+		}
+		fmt.Fprintf(w, "\t\t%s\n\t},\n", line)
+	}
+
+	w.WriteString("}\n")
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("makepreflop: ")
+	flag.Parse()
+
+	log.Print("dealing every heads-up board")
+	s := headsUp()
+
+	var (
+		heads  = make([][]float64, n)
+		random = make([][]float64, n)
+	)
+	for a := 0; a < n; a++ {
+		heads[a] = make([]float64, n)
+		for b := 0; b < n; b++ {
+			heads[a][b] = s.equity(a, b)
+		}
+
+		h := cactuskev.StartingHand(a)
+		log.Printf("dealing %v against random hands", h)
+
+		random[a] = []float64{s.vsRandom(a)}
+		for o := 2; o <= cactuskev.MaxPreflopOpponents; o++ {
+			random[a] = append(random[a], vsRandomHands(h, o))
+		}
+	}
+
+	var w bytes.Buffer
+	w.WriteString(`// Code generated by "go run makepreflop.go"; DO NOT EDIT.
+
+package cactuskev
+
+// preflopHeadsUp[a][b] is the equity of StartingHand a all in preflop
+// against b.
+`)
+	writeTable(&w, "var preflopHeadsUp = [NumStartingHands][NumStartingHands]float32", heads)
+	w.WriteString(`
+// preflopVsRandom[a][i] is the equity of StartingHand a all in preflop
+// against i+1 random hands.
+`)
+	writeTable(&w, "var preflopVsRandom = [NumStartingHands][MaxPreflopOpponents]float32", random)
+
+	if *output == "-" {
+		os.Stdout.Write(w.Bytes())
+	} else if err := os.WriteFile(*output, w.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
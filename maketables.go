@@ -0,0 +1,512 @@
+//go:build ignore
+
+// maketables generates the lookup tables of arrays.go by enumerating every
+// equivalence class of five-card poker hands, best first. With -tables
+// lowball, it generates the Ace-to-Five and Deuce-to-Seven tables of
+// lowball_arrays.go instead, and with -tables shortdeck, the Short Deck
+// tables of shortdeck_arrays.go.
+//
+// Usage:
+//
+//	go run maketables.go [-tables high|lowball|shortdeck] [-output file]
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+)
+
+var (
+	output = flag.String("output", "", "file to write, or - for stdout (default arrays.go or <tables>_arrays.go)")
+	which  = flag.String("tables", "high", "tables to generate: high, lowball or shortdeck")
+)
+
+const nranks = 13
+
+var primes = [nranks]int{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41}
+
+// A class is one equivalence class of five-card hands: either five unique
+// ranks, with or without a flush, or a multiset of ranks with a pair or more.
+type class struct {
+	flush bool
+	ranks []int // most significant first, repeated ranks included
+}
+
+func (c class) bits() (b int) {
+	for _, r := range c.ranks {
+		b |= 1 << uint(r)
+	}
+	return b
+}
+
+func (c class) product() int {
+	p := 1
+	for _, r := range c.ranks {
+		p *= primes[r]
+	}
+	return p
+}
+
+// straights lists the rank bits of every straight, highest first, ending
+// with the wheel (A-2-3-4-5).
+func straights() []int {
+	var s []int
+	for top := nranks - 1; top >= 4; top-- {
+		s = append(s, 0x1f<<uint(top-4))
+	}
+	return append(s, 0x100f)
+}
+
+// uniques lists the rank bits of every set of five unique ranks that is not
+// a straight, highest first.
+func uniques() []int {
+	straight := make(map[int]bool)
+	for _, b := range straights() {
+		straight[b] = true
+	}
+
+	var u []int
+	for b := 1<<nranks - 1; b > 0; b-- {
+		if popcount(b) == 5 && !straight[b] {
+			u = append(u, b)
+		}
+	}
+	return u
+}
+
+func popcount(b int) (n int) {
+	for ; b != 0; b &= b - 1 {
+		n++
+	}
+	return n
+}
+
+func ranksOf(b int) []int {
+	var r []int
+	for i := nranks - 1; i >= 0; i-- {
+		if b&(1<<uint(i)) != 0 {
+			r = append(r, i)
+		}
+	}
+	return r
+}
+
+// A ranking says how ranks compare. value orders the ranks of a hand, the
+// group with the highest value being the most significant, and low says
+// whether the hand with the lower values is the better. Ranks valued -1 are
+// not in the deck.
+type ranking struct {
+	value [nranks]int
+	low   bool
+}
+
+var (
+	aceHigh      = ranking{value: [nranks]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}}
+	aceToFive    = ranking{value: [nranks]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 0}, low: true}
+	deuceToSeven = ranking{value: aceHigh.value, low: true}
+	shortDeck    = ranking{value: [nranks]int{-1, -1, -1, -1, 4, 5, 6, 7, 8, 9, 10, 11, 12}}
+)
+
+// order lists the ranks in the deck, best first.
+func (k ranking) order() []int {
+	var o []int
+	for r := range k.value {
+		if k.value[r] >= 0 {
+			o = append(o, r)
+		}
+	}
+	sort.Slice(o, func(i, j int) bool {
+		if k.low {
+			return k.value[o[i]] < k.value[o[j]]
+		}
+		return k.value[o[i]] > k.value[o[j]]
+	})
+	return o
+}
+
+// groups lists every way of picking distinct ranks for groups of the given
+// sizes, e.g. {3, 1, 1} for three of a kind with two kickers. Groups of the
+// same size are picked most significant first, and each pattern is ordered
+// best first.
+func groups(k ranking, sizes ...int) []class {
+	var (
+		out  []class
+		pick func(i int, used int, ranks []int)
+	)
+
+	pick = func(i int, used int, ranks []int) {
+		if i == len(sizes) {
+			out = append(out, class{ranks: append([]int(nil), ranks...)})
+			return
+		}
+
+		for _, r := range k.order() {
+			if used&(1<<uint(r)) != 0 {
+				continue
+			}
+
+			// a group that follows one of the same size must be lower
+			if i > 0 && sizes[i] == sizes[i-1] && k.value[r] > k.value[ranks[len(ranks)-1]] {
+				continue
+			}
+
+			next := ranks
+			for n := 0; n < sizes[i]; n++ {
+				next = append(next, r)
+			}
+			pick(i+1, used|1<<uint(r), next)
+		}
+	}
+	pick(0, 0, nil)
+
+	return out
+}
+
+func bitsClasses(bits []int, flush bool) []class {
+	c := make([]class, len(bits))
+	for i, b := range bits {
+		c[i] = class{flush: flush, ranks: ranksOf(b)}
+	}
+	return c
+}
+
+// high lists the classes of standard high poker, best first.
+func high() []class {
+	var c []class
+	c = append(c, bitsClasses(straights(), true)...)  // straight flush
+	c = append(c, groups(aceHigh, 4, 1)...)           // four of a kind
+	c = append(c, groups(aceHigh, 3, 2)...)           // full house
+	c = append(c, bitsClasses(uniques(), true)...)    // flush
+	c = append(c, bitsClasses(straights(), false)...) // straight
+	c = append(c, groups(aceHigh, 3, 1, 1)...)        // three of a kind
+	c = append(c, groups(aceHigh, 2, 2, 1)...)        // two pair
+	c = append(c, groups(aceHigh, 2, 1, 1, 1)...)     // one pair
+	c = append(c, bitsClasses(uniques(), false)...)   // high card
+	return c
+}
+
+// lowAceToFive lists the classes of Ace-to-Five lowball, best first. Aces
+// are low, and straights and flushes do not count.
+func lowAceToFive() []class {
+	var c []class
+	c = append(c, groups(aceToFive, 1, 1, 1, 1, 1)...) // no pair
+	c = append(c, groups(aceToFive, 2, 1, 1, 1)...)    // one pair
+	c = append(c, groups(aceToFive, 2, 2, 1)...)       // two pair
+	c = append(c, groups(aceToFive, 3, 1, 1)...)       // three of a kind
+	c = append(c, groups(aceToFive, 3, 2)...)          // full house
+	c = append(c, groups(aceToFive, 4, 1)...)          // four of a kind
+	return c
+}
+
+// lowDeuceToSeven lists the classes of Deuce-to-Seven lowball, best first.
+// Aces are high, and straights and flushes count against the hand, with
+// A-2-3-4-5 being no straight but ace high.
+func lowDeuceToSeven() []class {
+	var (
+		c         []class
+		straights = straights()[:nranks-4] // without the wheel
+		straight  = make(map[int]bool)
+		lows      []int
+	)
+
+	for _, b := range straights {
+		straight[b] = true
+	}
+	for _, u := range groups(deuceToSeven, 1, 1, 1, 1, 1) {
+		if !straight[u.bits()] {
+			lows = append(lows, u.bits())
+		}
+	}
+	for i, j := 0, len(straights)-1; i < j; i, j = i+1, j-1 {
+		straights[i], straights[j] = straights[j], straights[i]
+	}
+
+	c = append(c, bitsClasses(lows, false)...)         // no pair
+	c = append(c, groups(deuceToSeven, 2, 1, 1, 1)...) // one pair
+	c = append(c, groups(deuceToSeven, 2, 2, 1)...)    // two pair
+	c = append(c, groups(deuceToSeven, 3, 1, 1)...)    // three of a kind
+	c = append(c, bitsClasses(straights, false)...)    // straight
+	c = append(c, bitsClasses(lows, true)...)          // flush
+	c = append(c, groups(deuceToSeven, 3, 2)...)       // full house
+	c = append(c, groups(deuceToSeven, 4, 1)...)       // four of a kind
+	c = append(c, bitsClasses(straights, true)...)     // straight flush
+	return c
+}
+
+// shortDeckHigh lists the classes of Short Deck, which is played without
+// the deuces to fives, best first. A flush beats a full house, and
+// A-6-7-8-9 is the lowest straight.
+func shortDeckHigh() []class {
+	var (
+		c         []class
+		straights []int
+		straight  = make(map[int]bool)
+		uniques   []int
+	)
+
+	for top := nranks - 1; top >= 8; top-- {
+		straights = append(straights, 0x1f<<uint(top-4))
+	}
+	straights = append(straights, 0x10f0)
+	for _, b := range straights {
+		straight[b] = true
+	}
+	for _, u := range groups(shortDeck, 1, 1, 1, 1, 1) {
+		if !straight[u.bits()] {
+			uniques = append(uniques, u.bits())
+		}
+	}
+
+	c = append(c, bitsClasses(straights, true)...)  // straight flush
+	c = append(c, groups(shortDeck, 4, 1)...)       // four of a kind
+	c = append(c, bitsClasses(uniques, true)...)    // flush
+	c = append(c, groups(shortDeck, 3, 2)...)       // full house
+	c = append(c, bitsClasses(straights, false)...) // straight
+	c = append(c, groups(shortDeck, 3, 1, 1)...)    // three of a kind
+	c = append(c, groups(shortDeck, 2, 2, 1)...)    // two pair
+	c = append(c, groups(shortDeck, 2, 1, 1, 1)...) // one pair
+	c = append(c, bitsClasses(uniques, false)...)   // high card
+	return c
+}
+
+// tables numbers classes from 1, best first, and builds the lookup tables
+// indexed by rank bits (flushes and unique ranks) or by prime product.
+func tables(classes []class) (flushes, unique5 []int, products []int, values []int) {
+	flushes = make([]int, 0x1f00+1)
+	unique5 = make([]int, 0x1f00+1)
+
+	byProduct := make(map[int]int)
+	for i, c := range classes {
+		score := i + 1
+		switch {
+		case c.flush:
+			flushes[c.bits()] = score
+		case popcount(c.bits()) == 5:
+			unique5[c.bits()] = score
+		default:
+			byProduct[c.product()] = score
+		}
+	}
+
+	for p := range byProduct {
+		products = append(products, p)
+	}
+	sort.Ints(products)
+	for _, p := range products {
+		values = append(values, byProduct[p])
+	}
+
+	return flushes, unique5, products, values
+}
+
+// writeArray writes the elements of a composite literal, wrapped the way
+// arrays.go has always been.
+func writeArray(w *bytes.Buffer, decl string, a []int) {
+	const width = 65
+
+	fmt.Fprintf(w, "%s{\n", decl)
+
+	var line []byte
+	for i, v := range a {
+		tok := strconv.Itoa(v)
+		if i < len(a)-1 {
+			tok += ","
+		} else {
+			tok += "}"
+		}
+
+		if len(line) > 0 && len(line)+1+len(tok) > width {
+			fmt.Fprintf(w, "\t%s\n", line)
+			line = line[:0]
+		}
+		if len(line) > 0 {
+			line = append(line, ' ')
+		}
+		line = append(line, tok...)
+	}
+	fmt.Fprintf(w, "\t%s\n", line)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("maketables: ")
+	flag.Parse()
+
+	var w bytes.Buffer
+
+	switch *which {
+	case "high":
+		writeHigh(&w)
+		if *output == "" {
+			*output = "arrays.go"
+		}
+	case "lowball":
+		writeLowball(&w)
+		if *output == "" {
+			*output = "lowball_arrays.go"
+		}
+	case "shortdeck":
+		writeShortDeck(&w)
+		if *output == "" {
+			*output = "shortdeck_arrays.go"
+		}
+	default:
+		log.Fatalf("unknown tables %q", *which)
+	}
+
+	if *output == "-" {
+		os.Stdout.Write(w.Bytes())
+	} else if err := os.WriteFile(*output, w.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeHigh(w *bytes.Buffer) {
+	flushes, unique5, products, values := tables(high())
+
+	w.WriteString(`package cactuskev
+
+/*
+** this is a table lookup for all "flush" hands (e.g.  both
+** flushes and straight-flushes.  entries containing a zero
+** mean that combination is not possible with a five-card
+** flush hand.
+ */
+`)
+	writeArray(w, "var Flushes = [...]Score", flushes)
+	w.WriteString(`
+/*
+** this is a table lookup for all non-flush hands consisting
+** of five unique ranks (i.e.  either Straights or High Card
+** hands).  it's similar to the above "flushes" array.
+ */
+`)
+	writeArray(w, "var Unique5 = [...]Score", unique5)
+	w.WriteString("\n")
+	writeArray(w, "var products = [...]int", products)
+	w.WriteString("\n")
+	writeArray(w, "var values = []Score", values)
+	w.WriteString(`
+/*
+** each of the thirteen card ranks has its own prime number
+**
+** deuce = 2
+** trey  = 3
+** four  = 5
+** five  = 7
+** ...
+** king  = 37
+** ace   = 41
+ */
+`)
+	fmt.Fprintf(w, "var Primes = [...]int{%s}\n", join(primes[:]))
+
+	w.WriteString("\nvar Perm7 = [21][5]int{\n")
+	perms := combinations(7, 5)
+	for i, p := range perms {
+		end := ","
+		if i == len(perms)-1 {
+			end = "}"
+		}
+		fmt.Fprintf(w, "\t{%s}%s\n", join(p), end)
+	}
+}
+
+func writeLowball(w *bytes.Buffer) {
+	_, _, products, _ := tables(high())
+
+	w.WriteString(`// Code generated by "go run maketables.go -tables lowball"; DO NOT EDIT.
+
+package cactuskev
+
+// Ace-to-Five lowball: hands of five unique ranks, flushes or not, by rank
+// bits, and other hands in the order of products.
+`)
+	_, unique5, p, values := tables(lowAceToFive())
+	checkProducts(products, p)
+	writeArray(w, "var AceToFive5 = [...]AceToFiveScore", unique5)
+	w.WriteString("\n")
+	writeArray(w, "var aceToFiveValues = [...]AceToFiveScore", values)
+
+	w.WriteString(`
+// Deuce-to-Seven lowball: flushes and straight flushes, other hands of five
+// unique ranks, and other hands in the order of products.
+`)
+	flushes, unique5, p, values := tables(lowDeuceToSeven())
+	checkProducts(products, p)
+	writeArray(w, "var DeuceToSevenFlushes = [...]DeuceToSevenScore", flushes)
+	w.WriteString("\n")
+	writeArray(w, "var DeuceToSeven5 = [...]DeuceToSevenScore", unique5)
+	w.WriteString("\n")
+	writeArray(w, "var deuceToSevenValues = [...]DeuceToSevenScore", values)
+}
+
+func writeShortDeck(w *bytes.Buffer) {
+	flushes, unique5, products, values := tables(shortDeckHigh())
+
+	w.WriteString(`// Code generated by "go run maketables.go -tables shortdeck"; DO NOT EDIT.
+
+package cactuskev
+
+// Short Deck: flushes and straight flushes, other hands of five unique ranks,
+// and the products of other hands with their values.
+`)
+	writeArray(w, "var ShortDeckFlushes = [...]ShortDeckScore", flushes)
+	w.WriteString("\n")
+	writeArray(w, "var ShortDeck5 = [...]ShortDeckScore", unique5)
+	w.WriteString("\n")
+	writeArray(w, "var shortDeckProducts = [...]int", products)
+	w.WriteString("\n")
+	writeArray(w, "var shortDeckValues = [...]ShortDeckScore", values)
+}
+
+// checkProducts makes sure a table of values lines up with products.
+func checkProducts(want, got []int) {
+	if len(want) != len(got) {
+		log.Fatalf("%d products, expected %d", len(got), len(want))
+	}
+	for i := range want {
+		if want[i] != got[i] {
+			log.Fatalf("product %d is %d, expected %d", i, got[i], want[i])
+		}
+	}
+}
+
+func join(a []int) string {
+	var b []byte
+	for i, v := range a {
+		if i > 0 {
+			b = append(b, ", "...)
+		}
+		b = strconv.AppendInt(b, int64(v), 10)
+	}
+	return string(b)
+}
+
+// combinations lists every way of choosing k of n indices, in lexicographic
+// order.
+func combinations(n, k int) [][]int {
+	var (
+		out  [][]int
+		c    = make([]int, k)
+		pick func(i, from int)
+	)
+
+	pick = func(i, from int) {
+		if i == k {
+			out = append(out, append([]int(nil), c...))
+			return
+		}
+		for c[i] = from; c[i] <= n-k+i; c[i]++ {
+			pick(i+1, c[i]+1)
+		}
+	}
+	pick(0, 0)
+
+	return out
+}
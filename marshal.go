@@ -0,0 +1,448 @@
+package cactuskev
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Cards are marshaled as text in ASCII notation ("Kd"), and in binary as a
+// single byte holding the card's Index. A Joker is "Jk" as text and 52 in
+// binary. Hands and decks are marshaled as a
+// sequence of cards: space separated as text, a JSON array of cards, or one
+// byte per card. Scores are marshaled as numbers and categories by name.
+
+var suitLetters = map[Suit]byte{Club: 'c', Diamond: 'd', Heart: 'h', Spade: 's'}
+
+func (s Suit) MarshalText() ([]byte, error) {
+	l, ok := suitLetters[s]
+	if !ok {
+		return nil, fmt.Errorf("unknown suit: %d", s)
+	}
+
+	return []byte{l}, nil
+}
+
+func (s *Suit) UnmarshalText(text []byte) error {
+	r, n := utf8.DecodeRune(text)
+	suit, ok := suitRunes[unicode.ToLower(r)]
+	if !ok || n != len(text) {
+		return fmt.Errorf("unknown suit: %q", text)
+	}
+
+	*s = suit
+	return nil
+}
+
+func (s Suit) MarshalJSON() ([]byte, error) { return marshalJSONText(s) }
+
+func (s *Suit) UnmarshalJSON(data []byte) error { return unmarshalJSONText(data, s) }
+
+func (s Suit) MarshalBinary() ([]byte, error) {
+	if _, ok := suitLetters[s]; !ok {
+		return nil, fmt.Errorf("unknown suit: %d", s)
+	}
+
+	return []byte{byte(suitIndex(s))}, nil
+}
+
+func (s *Suit) UnmarshalBinary(data []byte) error {
+	if len(data) != 1 || int(data[0]) >= len(suits) {
+		return fmt.Errorf("invalid suit encoding: %x", data)
+	}
+
+	*s = suits[data[0]]
+	return nil
+}
+
+func (r Rank) MarshalText() ([]byte, error) {
+	if r > Ace {
+		return nil, fmt.Errorf("unknown rank: %d", r)
+	}
+
+	return []byte(r.String()), nil
+}
+
+func (r *Rank) UnmarshalText(text []byte) error {
+	if string(text) == "10" {
+		*r = Ten
+		return nil
+	}
+
+	c, n := utf8.DecodeRune(text)
+	rank, ok := rankRunes[unicode.ToUpper(c)]
+	if !ok || n != len(text) {
+		return fmt.Errorf("unknown rank: %q", text)
+	}
+
+	*r = rank
+	return nil
+}
+
+func (r Rank) MarshalJSON() ([]byte, error) { return marshalJSONText(r) }
+
+func (r *Rank) UnmarshalJSON(data []byte) error { return unmarshalJSONText(data, r) }
+
+func (r Rank) MarshalBinary() ([]byte, error) {
+	if r > Ace {
+		return nil, fmt.Errorf("unknown rank: %d", r)
+	}
+
+	return []byte{byte(r)}, nil
+}
+
+func (r *Rank) UnmarshalBinary(data []byte) error {
+	if len(data) != 1 || Rank(data[0]) > Ace {
+		return fmt.Errorf("invalid rank encoding: %x", data)
+	}
+
+	*r = Rank(data[0])
+	return nil
+}
+
+// jokerText and jokerByte encode a Joker, which has no rank or suit.
+const (
+	jokerText = "Jk"
+	jokerByte = 52
+)
+
+func (c Card) MarshalText() ([]byte, error) {
+	if c == Joker {
+		return []byte(jokerText), nil
+	}
+	if !c.Valid() {
+		return nil, fmt.Errorf("%w: %v", ErrCard, c)
+	}
+
+	return []byte{c.Rank().String()[0], suitLetters[c.Suit()]}, nil
+}
+
+func (c *Card) UnmarshalText(text []byte) error {
+	card, err := ParseCard(string(text))
+	if err != nil {
+		return err
+	}
+
+	*c = card
+	return nil
+}
+
+func (c Card) MarshalJSON() ([]byte, error) { return marshalJSONText(c) }
+
+func (c *Card) UnmarshalJSON(data []byte) error { return unmarshalJSONText(data, c) }
+
+func (c Card) MarshalBinary() ([]byte, error) {
+	if c == Joker {
+		return []byte{jokerByte}, nil
+	}
+	if !c.Valid() {
+		return nil, fmt.Errorf("%w: %v", ErrCard, c)
+	}
+
+	return []byte{byte(c.Index())}, nil
+}
+
+func (c *Card) UnmarshalBinary(data []byte) error {
+	if len(data) != 1 || data[0] > jokerByte {
+		return fmt.Errorf("invalid card encoding: %x", data)
+	}
+
+	if data[0] == jokerByte {
+		*c = Joker
+	} else {
+		*c = CardAt(int(data[0]))
+	}
+	return nil
+}
+
+func (s Score) MarshalText() ([]byte, error) {
+	return []byte(strconv.Itoa(int(s))), nil
+}
+
+func (s *Score) UnmarshalText(text []byte) error {
+	n, err := strconv.ParseInt(string(text), 10, 16)
+	if err != nil {
+		return err
+	}
+
+	return s.set(n)
+}
+
+func (s Score) MarshalJSON() ([]byte, error) { return s.MarshalText() }
+
+func (s *Score) UnmarshalJSON(data []byte) error { return s.UnmarshalText(data) }
+
+func (s Score) MarshalBinary() ([]byte, error) {
+	return binary.BigEndian.AppendUint16(nil, uint16(s)), nil
+}
+
+func (s *Score) UnmarshalBinary(data []byte) error {
+	if len(data) != 2 {
+		return fmt.Errorf("invalid score encoding: %x", data)
+	}
+
+	return s.set(int64(int16(binary.BigEndian.Uint16(data))))
+}
+
+func (s *Score) set(n int64) error {
+	if n < -13 || n == 0 || n > 7462 {
+		return fmt.Errorf("score out of range: %d", n)
+	}
+
+	*s = Score(n)
+	return nil
+}
+
+func (c Category) MarshalText() ([]byte, error) {
+	if c < FiveOfAKind || c >= numCategories {
+		return nil, fmt.Errorf("unknown Category %d", c)
+	}
+
+	return []byte(c.String()), nil
+}
+
+func (c *Category) UnmarshalText(text []byte) error {
+	for cat := FiveOfAKind; cat < numCategories; cat++ {
+		if strings.EqualFold(cat.String(), string(text)) {
+			*c = cat
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown Category %q", text)
+}
+
+func (c Category) MarshalJSON() ([]byte, error) { return marshalJSONText(c) }
+
+func (c *Category) UnmarshalJSON(data []byte) error { return unmarshalJSONText(data, c) }
+
+func (c Category) MarshalBinary() ([]byte, error) {
+	if _, err := c.MarshalText(); err != nil {
+		return nil, err
+	}
+
+	return []byte{byte(c)}, nil
+}
+
+func (c *Category) UnmarshalBinary(data []byte) error {
+	if len(data) != 1 || Category(data[0]) >= numCategories {
+		return fmt.Errorf("invalid Category encoding: %x", data)
+	}
+
+	*c = Category(data[0])
+	return nil
+}
+
+func (h FiveCardHand) MarshalText() ([]byte, error) { return marshalCardsText(h.Cards()) }
+
+func (h *FiveCardHand) UnmarshalText(text []byte) error { return unmarshalHandText(h, text) }
+
+func (h FiveCardHand) MarshalJSON() ([]byte, error) { return json.Marshal(h.Cards()) }
+
+func (h *FiveCardHand) UnmarshalJSON(data []byte) error { return unmarshalHandJSON(h, data) }
+
+func (h FiveCardHand) MarshalBinary() ([]byte, error) { return marshalCardsBinary(h.Cards()) }
+
+func (h *FiveCardHand) UnmarshalBinary(data []byte) error { return unmarshalHandBinary(h, data) }
+
+func (h SixCardHand) MarshalText() ([]byte, error) { return marshalCardsText(h.Cards()) }
+
+func (h *SixCardHand) UnmarshalText(text []byte) error { return unmarshalHandText(h, text) }
+
+func (h SixCardHand) MarshalJSON() ([]byte, error) { return json.Marshal(h.Cards()) }
+
+func (h *SixCardHand) UnmarshalJSON(data []byte) error { return unmarshalHandJSON(h, data) }
+
+func (h SixCardHand) MarshalBinary() ([]byte, error) { return marshalCardsBinary(h.Cards()) }
+
+func (h *SixCardHand) UnmarshalBinary(data []byte) error { return unmarshalHandBinary(h, data) }
+
+func (h SevenCardHand) MarshalText() ([]byte, error) { return marshalCardsText(h.Cards()) }
+
+func (h *SevenCardHand) UnmarshalText(text []byte) error { return unmarshalHandText(h, text) }
+
+func (h SevenCardHand) MarshalJSON() ([]byte, error) { return json.Marshal(h.Cards()) }
+
+func (h *SevenCardHand) UnmarshalJSON(data []byte) error { return unmarshalHandJSON(h, data) }
+
+func (h SevenCardHand) MarshalBinary() ([]byte, error) { return marshalCardsBinary(h.Cards()) }
+
+func (h *SevenCardHand) UnmarshalBinary(data []byte) error { return unmarshalHandBinary(h, data) }
+
+func (d Deck) MarshalText() ([]byte, error) { return marshalCardsText(d) }
+
+func (d *Deck) UnmarshalText(text []byte) error {
+	deck, err := ParseDeck(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = deck
+	return nil
+}
+
+func (d Deck) MarshalJSON() ([]byte, error) { return json.Marshal([]Card(d)) }
+
+func (d *Deck) UnmarshalJSON(data []byte) error {
+	var cards []Card
+	if err := json.Unmarshal(data, &cards); err != nil {
+		return err
+	}
+
+	if err := checkDistinct(cards); err != nil {
+		return err
+	}
+
+	*d = cards
+	return nil
+}
+
+func (d Deck) MarshalBinary() ([]byte, error) { return marshalCardsBinary(d) }
+
+func (d *Deck) UnmarshalBinary(data []byte) error {
+	cards, err := unmarshalCardsBinary(data)
+	if err != nil {
+		return err
+	}
+
+	*d = cards
+	return nil
+}
+
+func marshalJSONText(v interface{ MarshalText() ([]byte, error) }) ([]byte, error) {
+	text, err := v.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+func unmarshalJSONText(data []byte, v interface{ UnmarshalText([]byte) error }) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	return v.UnmarshalText([]byte(s))
+}
+
+func marshalCardsText(cards []Card) ([]byte, error) {
+	var text []byte
+
+	for i, c := range cards {
+		t, err := c.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+
+		if i > 0 {
+			text = append(text, ' ')
+		}
+		text = append(text, t...)
+	}
+
+	return text, nil
+}
+
+func marshalCardsBinary(cards []Card) ([]byte, error) {
+	data := make([]byte, len(cards))
+
+	for i, c := range cards {
+		b, err := c.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		data[i] = b[0]
+	}
+
+	return data, nil
+}
+
+func unmarshalCardsBinary(data []byte) ([]Card, error) {
+	cards := make([]Card, len(data))
+
+	for i := range data {
+		if err := cards[i].UnmarshalBinary(data[i : i+1]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkDistinct(cards); err != nil {
+		return nil, err
+	}
+
+	return cards, nil
+}
+
+// checkDistinct returns an error if a card is given twice. A game may have
+// any number of Jokers.
+func checkDistinct(cards []Card) error {
+	var (
+		seen   CardSet
+		others Deck // other cards a CardSet cannot hold
+	)
+
+	for _, c := range cards {
+		if c == Joker {
+			continue
+		}
+		if seen.Contains(c) || others.Contains(c) {
+			return fmt.Errorf("%w: %v", ErrDuplicate, c)
+		}
+		if c.Valid() {
+			seen = seen.Add(c)
+		} else {
+			others = append(others, c)
+		}
+	}
+
+	return nil
+}
+
+func setCards(h Hand, cards []Card) error {
+	if len(cards) != h.Len() {
+		return fmt.Errorf("expected %d cards, got %d", h.Len(), len(cards))
+	}
+
+	if err := checkDistinct(cards); err != nil {
+		return err
+	}
+
+	for i, c := range cards {
+		h.SetCard(i, c)
+	}
+
+	return nil
+}
+
+func unmarshalHandText(h Hand, text []byte) error {
+	cards, err := parseCards(string(text))
+	if err != nil {
+		return err
+	}
+
+	return setCards(h, cards)
+}
+
+func unmarshalHandJSON(h Hand, data []byte) error {
+	var cards []Card
+	if err := json.Unmarshal(data, &cards); err != nil {
+		return err
+	}
+
+	return setCards(h, cards)
+}
+
+func unmarshalHandBinary(h Hand, data []byte) error {
+	cards, err := unmarshalCardsBinary(data)
+	if err != nil {
+		return err
+	}
+
+	return setCards(h, cards)
+}
@@ -0,0 +1,153 @@
+package cactuskev
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type marshaler interface {
+	encoding.TextMarshaler
+	encoding.BinaryMarshaler
+	json.Marshaler
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	five := NewFiveCardHand()
+	six := NewSixCardHand()
+	seven := NewSevenCardHand()
+	wild := NewSevenCardHand()
+	for i, c := range NewDeck()[10:17] {
+		if i < 5 {
+			five.SetCard(i, c)
+		}
+		if i < 6 {
+			six.SetCard(i, c)
+		}
+		seven.SetCard(i, c)
+		wild.SetCard(i, c)
+	}
+	wild.SetCard(5, Joker)
+	wild.SetCard(6, Joker)
+
+	tests := []struct {
+		in  marshaler
+		out interface{}
+	}{
+		{NewCard(Diamond, King), new(Card)},
+		{Joker, new(Card)},
+		{Score(323), new(Score)},
+		{Flush, new(Category)},
+		{Suit(Heart), new(Suit)},
+		{Ten, new(Rank)},
+		{*five, new(FiveCardHand)},
+		{*six, new(SixCardHand)},
+		{*seven, new(SevenCardHand)},
+		{*wild, new(SevenCardHand)},
+		{NewDeck(), new(Deck)},
+		{append(NewDeck(), Joker, Joker), new(Deck)},
+	}
+
+	for _, test := range tests {
+		text, err := test.in.MarshalText()
+		if err != nil {
+			t.Errorf("%v: %v", test.in, err)
+			continue
+		}
+		if err := test.out.(encoding.TextUnmarshaler).UnmarshalText(text); err != nil {
+			t.Errorf("%v: %v", test.in, err)
+		} else if v := reflect.ValueOf(test.out).Elem().Interface(); !reflect.DeepEqual(v, test.in) {
+			t.Errorf("text: expected %v, got %v", test.in, v)
+		}
+
+		data, err := test.in.MarshalBinary()
+		if err != nil {
+			t.Errorf("%v: %v", test.in, err)
+			continue
+		}
+		if err := test.out.(encoding.BinaryUnmarshaler).UnmarshalBinary(data); err != nil {
+			t.Errorf("%v: %v", test.in, err)
+		} else if v := reflect.ValueOf(test.out).Elem().Interface(); !reflect.DeepEqual(v, test.in) {
+			t.Errorf("binary: expected %v, got %v", test.in, v)
+		}
+
+		js, err := json.Marshal(test.in)
+		if err != nil {
+			t.Errorf("%v: %v", test.in, err)
+			continue
+		}
+		if err := json.Unmarshal(js, test.out); err != nil {
+			t.Errorf("%s: %v", js, err)
+		} else if v := reflect.ValueOf(test.out).Elem().Interface(); !reflect.DeepEqual(v, test.in) {
+			t.Errorf("json: expected %v, got %v", test.in, v)
+		}
+	}
+}
+
+func TestMarshalFormats(t *testing.T) {
+	c := NewCard(Diamond, King)
+
+	if text, _ := c.MarshalText(); string(text) != "Kd" {
+		t.Errorf(`expected "Kd", got %q`, text)
+	}
+
+	if data, _ := c.MarshalBinary(); len(data) != 1 || int(data[0]) != c.Index() {
+		t.Errorf("expected single byte %d, got %x", c.Index(), data)
+	}
+
+	if js, _ := json.Marshal([]Card{c, NewCard(Spade, Ace)}); string(js) != `["Kd","As"]` {
+		t.Errorf("unexpected JSON %s", js)
+	}
+
+	if js, _ := json.Marshal(struct {
+		S Score
+		C Category
+	}{323, Flush}); string(js) != `{"S":323,"C":"Flush"}` {
+		t.Errorf("unexpected JSON %s", js)
+	}
+
+	if text, _ := Joker.MarshalText(); string(text) != "Jk" {
+		t.Errorf(`expected "Jk", got %q`, text)
+	}
+
+	if data, _ := Joker.MarshalBinary(); len(data) != 1 || data[0] != 52 {
+		t.Errorf("expected single byte 52, got %x", data)
+	}
+
+	for _, s := range []string{"Jk", "jk", "JK", "Joker", "joker"} {
+		if c, err := ParseCard(s); err != nil || c != Joker {
+			t.Errorf("%q: expected Joker, got %v (%v)", s, c, err)
+		}
+	}
+
+	for i, c := range NewDeck() {
+		if c.Index() != i || CardAt(i) != c {
+			t.Errorf("%v: expected index %d, got %d", c, i, c.Index())
+		}
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	var (
+		c Card
+		h FiveCardHand
+		s Score
+	)
+
+	if err := c.UnmarshalBinary([]byte{53}); err == nil {
+		t.Errorf("expected error for card index 53")
+	}
+
+	if err := h.UnmarshalText([]byte("As Ks Qs Js")); err == nil {
+		t.Errorf("expected error for four-card hand")
+	}
+
+	if err := h.UnmarshalBinary([]byte{0, 1, 2, 3, 3}); err == nil {
+		t.Errorf("expected error for duplicate card")
+	}
+
+	if err := json.Unmarshal([]byte("9999"), &s); err == nil {
+		t.Errorf("expected error for out of range score")
+	}
+}
@@ -0,0 +1,86 @@
+package cactuskev
+
+import (
+	"fmt"
+)
+
+// EvalOmaha returns the Score of the best Omaha hand made of exactly two of 4
+// to 6 hole cards and exactly three of 3 to 5 board cards. It panics for
+// other numbers of cards; see TryEvalOmaha.
+func EvalOmaha(hole, board []Card) Score {
+	score, _ := EvalOmahaBest(hole, board)
+	return score
+}
+
+// EvalOmahaBest is EvalOmaha that also returns the five cards that play, the
+// two hole cards first. It panics for unsupported numbers of cards.
+func EvalOmahaBest(hole, board []Card) (Score, [5]Card) {
+	score, five, err := TryEvalOmaha(hole, board)
+	if err != nil {
+		panic(err)
+	}
+
+	return score, five
+}
+
+// TryEvalOmaha is like EvalOmahaBest, but returns an error rather than
+// panicking if the numbers of hole or board cards are not supported.
+func TryEvalOmaha(hole, board []Card) (Score, [5]Card, error) {
+	if n := len(hole); n < 4 || n > 6 {
+		return 0, [5]Card{}, fmt.Errorf("omaha hand of %d hole cards not supported", n)
+	}
+	if n := len(board); n < 3 || n > 5 {
+		return 0, [5]Card{}, fmt.Errorf("omaha board of %d cards not supported", n)
+	}
+
+	score, five := evalOmaha(hole, board)
+	return score, five, nil
+}
+
+// omahaCombinations[n][m] lists every way of choosing two of n hole cards
+// and three of m board cards, as indices into the hole cards followed by the
+// board.
+var omahaCombinations = func() (t [7][6][][5]int) {
+	for n := 4; n < len(t); n++ {
+		for m := 3; m < len(t[n]); m++ {
+			for i := 0; i < n; i++ {
+				for j := i + 1; j < n; j++ {
+					for k := 0; k < m; k++ {
+						for l := k + 1; l < m; l++ {
+							for o := l + 1; o < m; o++ {
+								t[n][m] = append(t[n][m], [5]int{i, j, n + k, n + l, n + o})
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return t
+}()
+
+// evalOmaha tries every two hole cards with every three board cards.
+func evalOmaha(hole, board []Card) (Score, [5]Card) {
+	var (
+		h     FiveCardHand
+		best  = Score(9999)
+		five  [5]Card
+		cards = omahaCards(hole, board)
+	)
+
+	for _, c := range omahaCombinations[len(hole)][len(board)] {
+		h.A, h.B, h.C, h.D, h.E = cards[c[0]], cards[c[1]], cards[c[2]], cards[c[3]], cards[c[4]]
+
+		if q := h.Eval(); best.Less(q) {
+			best, five = q, [5]Card{h.A, h.B, h.C, h.D, h.E}
+		}
+	}
+
+	return best, five
+}
+
+// omahaCards returns the hole cards followed by the board.
+func omahaCards(hole, board []Card) (cards [11]Card) {
+	copy(cards[copy(cards[:], hole):], board)
+	return cards
+}
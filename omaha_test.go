@@ -0,0 +1,80 @@
+package cactuskev
+
+import (
+	"testing"
+)
+
+func TestEvalOmaha(t *testing.T) {
+	for _, tc := range []struct {
+		hole, board string
+		want        Category
+	}{
+		// Four hearts in hand and none on the board is no flush.
+		{"Ah Kh Qh Jh", "2c 7d 9s", HighCard},
+		// One heart in hand does not make a flush with four on the board.
+		{"Ah Kc Qd Js", "2h 7h 9h 3h 5c", HighCard},
+		// Nor does a single deuce make a full house with trips on the board.
+		{"As 2c 3d 8s", "Kh Kd Kc 2h 9c", ThreeOfAKind},
+		{"As 2c 3d 8s", "Ah Ad Ac Kc Kd", FourOfAKind},
+		// Two pair in hand with one of each on the board is only trips.
+		{"Ks Kh Qs Qh", "Kd Qd 2c", ThreeOfAKind},
+		{"Ks Kh Qs Qh 9c", "Kd Kc 2c", FourOfAKind},
+		{"Th 9h 2c 3d 4s 5c", "Jh Qh Kh 2d 7s", StraightFlush},
+	} {
+		hole, board := mustParse(t, tc.hole), mustParse(t, tc.board)
+
+		score, five := EvalOmahaBest(hole, board)
+		if c := score.Category(); c != tc.want {
+			t.Errorf("%v on %v: expected %v, got %v (%v)", hole, board, tc.want, c, five)
+		}
+
+		if s := EvalOmaha(hole, board); s != score {
+			t.Errorf("%v on %v: EvalOmaha gave %v, EvalOmahaBest %v", hole, board, s, score)
+		}
+
+		h := NewFiveCardHand()
+		for i, c := range five {
+			h.SetCard(i, c)
+		}
+		if s := h.Eval(); s != score {
+			t.Errorf("%v scores %v, expected %v", five, s, score)
+		}
+		if !contains(hole, five[0]) || !contains(hole, five[1]) ||
+			!contains(board, five[2]) || !contains(board, five[3]) || !contains(board, five[4]) {
+			t.Errorf("%v does not play two hole cards and three board cards", five)
+		}
+	}
+}
+
+func contains(cards []Card, c Card) bool {
+	for _, d := range cards {
+		if d == c {
+			return true
+		}
+	}
+	return false
+}
+
+func TestEvalOmahaSizes(t *testing.T) {
+	for _, tc := range []struct{ hole, board int }{{3, 5}, {7, 5}, {4, 2}, {4, 6}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected panic for %d hole and %d board cards", tc.hole, tc.board)
+				}
+			}()
+			d := NewDeck()
+			if _, _, err := TryEvalOmaha(d[:tc.hole], d[tc.hole:tc.hole+tc.board]); err == nil {
+				t.Errorf("expected error for %d hole and %d board cards", tc.hole, tc.board)
+			}
+			EvalOmaha(d[:tc.hole], d[tc.hole:tc.hole+tc.board])
+		}()
+	}
+}
+
+func BenchmarkEvalOmaha(b *testing.B) {
+	hole, board := mustParse(b, "As Kd 7h 7c"), mustParse(b, "2c 7d 9h Jc 3s")
+	for i := 0; i < b.N; i++ {
+		EvalOmaha(hole, board)
+	}
+}
@@ -0,0 +1,155 @@
+package cactuskev
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ParseError records a failure to parse card notation, along with the
+// offending token and its byte offset in the input.
+type ParseError struct {
+	Input string
+	Token string
+	Pos   int
+	Err   error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parse %q: %v %q at position %d", e.Input, e.Err, e.Token, e.Pos)
+}
+
+func (e *ParseError) Unwrap() error { return e.Err }
+
+var rankRunes = map[rune]Rank{
+	'2': Deuce, '3': Trey, '4': Four, '5': Five, '6': Six, '7': Seven, '8': Eight,
+	'9': Nine, 'T': Ten, 'J': Jack, 'Q': Queen, 'K': King, 'A': Ace,
+}
+
+var suitRunes = map[rune]Suit{
+	'c': Club, 'd': Diamond, 'h': Heart, 's': Spade,
+	'♣': Club, '♦': Diamond, '♥': Heart, '♠': Spade,
+	'♧': Club, '♢': Diamond, '♡': Heart, '♤': Spade,
+}
+
+// ParseCard parses a single card such as "Kd", "10h" or "K♦", or a Joker as
+// "Jk" or "Joker". Rank and suit letters are case insensitive.
+func ParseCard(s string) (Card, error) {
+	cards, err := parseCards(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(cards) != 1 {
+		return 0, fmt.Errorf("parse %q: expected 1 card, got %d", s, len(cards))
+	}
+
+	return cards[0], nil
+}
+
+// ParseHand parses a hand such as "As Ks Qs Js Ts", "As,Ks,Qs,Js,Ts" or
+// "AsKsQsJsTs". See ParseCard for the card notation.
+func ParseHand(s string) (Hand, error) {
+	cards, err := parseCards(s)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := NewHandErr(len(cards))
+	if err != nil {
+		return nil, err
+	}
+
+	for i, c := range cards {
+		h.SetCard(i, c)
+	}
+
+	return h, nil
+}
+
+// ParseDeck parses any number of distinct cards into a Deck, in the order
+// they are given.
+func ParseDeck(s string) (Deck, error) {
+	cards, err := parseCards(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return Deck(cards), nil
+}
+
+func isSeparator(r rune) bool {
+	switch r {
+	case ',', ';', '[', ']':
+		return true
+	}
+
+	return unicode.IsSpace(r)
+}
+
+func parseCards(s string) ([]Card, error) {
+	var (
+		cards []Card
+		seen  = make(map[Card]bool)
+	)
+
+	for i := 0; i < len(s); {
+		if r, n := utf8.DecodeRuneInString(s[i:]); isSeparator(r) {
+			i += n
+			continue
+		}
+
+		c, n, err := scanCard(s, i)
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[c] && c != Joker {
+			return nil, &ParseError{Input: s, Token: s[i : i+n], Pos: i, Err: ErrDuplicate}
+		}
+		seen[c] = true
+
+		cards = append(cards, c)
+		i += n
+	}
+
+	return cards, nil
+}
+
+// scanCard reads one card at byte offset pos of s and returns it together
+// with the number of bytes consumed.
+func scanCard(s string, pos int) (Card, int, error) {
+	var (
+		rank Rank
+		n    int
+	)
+
+	for _, name := range []string{"Joker", jokerText} {
+		if len(s)-pos >= len(name) && strings.EqualFold(s[pos:pos+len(name)], name) {
+			return Joker, len(name), nil
+		}
+	}
+
+	if len(s)-pos >= 2 && s[pos:pos+2] == "10" {
+		rank, n = Ten, 2
+	} else {
+		r, size := utf8.DecodeRuneInString(s[pos:])
+		rr, ok := rankRunes[unicode.ToUpper(r)]
+		if !ok {
+			return 0, 0, &ParseError{Input: s, Token: s[pos : pos+size], Pos: pos, Err: ErrRank}
+		}
+		rank, n = rr, size
+	}
+
+	r, size := utf8.DecodeRuneInString(s[pos+n:])
+	suit, ok := suitRunes[unicode.ToLower(r)]
+	if !ok {
+		if isSeparator(r) {
+			size = 0
+		}
+		return 0, 0, &ParseError{Input: s, Token: s[pos : pos+n+size], Pos: pos, Err: ErrSuit}
+	}
+
+	return NewCard(suit, rank), n + size, nil
+}
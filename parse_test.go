@@ -0,0 +1,109 @@
+package cactuskev
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestParseCard(t *testing.T) {
+	tests := []struct {
+		s string
+		c Card
+	}{
+		{"Kd", NewCard(Diamond, King)},
+		{"K♦", NewCard(Diamond, King)},
+		{"kD", NewCard(Diamond, King)},
+		{"Th", NewCard(Heart, Ten)},
+		{"10h", NewCard(Heart, Ten)},
+		{" As ", NewCard(Spade, Ace)},
+		{"2♧", NewCard(Club, Deuce)},
+	}
+
+	for _, test := range tests {
+		if c, err := ParseCard(test.s); err != nil {
+			t.Errorf("%q: %v", test.s, err)
+		} else if c != test.c {
+			t.Errorf("%q: expected %v, got %v", test.s, test.c, c)
+		}
+	}
+
+	for _, c := range NewDeck() {
+		if p, err := ParseCard(c.String()); err != nil || p != c {
+			t.Errorf("%v: round trip gave %v, %v", c, p, err)
+		}
+	}
+}
+
+func TestParseHand(t *testing.T) {
+	for _, s := range []string{
+		"As Ks Qs Js Ts",
+		"As,Ks,Qs,Js,Ts",
+		"AsKsQsJs10s",
+		"[A♠ K♠ Q♠ J♠ T♠]",
+	} {
+		h, err := ParseHand(s)
+		if err != nil {
+			t.Errorf("%q: %v", s, err)
+			continue
+		}
+
+		if score := h.Eval(); score != 1 {
+			t.Errorf("%q: expected royal flush, got %v", s, score)
+		}
+	}
+
+	if h, err := ParseHand("2c 3c 4c 5c 6c 7c 8c"); err != nil {
+		t.Error(err)
+	} else if h.Len() != 7 {
+		t.Errorf("expected 7 cards, got %d", h.Len())
+	}
+
+	if _, err := ParseHand("As Ks"); err == nil {
+		t.Errorf("expected error for two-card hand")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		s     string
+		err   error
+		token string
+		pos   int
+	}{
+		{"As Kx", ErrSuit, "Kx", 3},
+		{"AsZs", ErrRank, "Z", 2},
+		{"As K", ErrSuit, "K", 3},
+		{"As Ks As", ErrDuplicate, "As", 6},
+		{"1s", ErrRank, "1", 0},
+	}
+
+	for _, test := range tests {
+		_, err := ParseDeck(test.s)
+
+		var perr *ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("%q: expected *ParseError, got %v", test.s, err)
+			continue
+		}
+
+		if !errors.Is(err, test.err) {
+			t.Errorf("%q: expected %v, got %v", test.s, test.err, perr.Err)
+		}
+
+		if perr.Token != test.token || perr.Pos != test.pos {
+			t.Errorf("%q: expected %q at %d, got %q at %d", test.s, test.token, test.pos, perr.Token, perr.Pos)
+		}
+	}
+}
+
+func TestParseDeck(t *testing.T) {
+	d, err := ParseDeck(fmt.Sprint(NewDeck()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Len() != 52 {
+		t.Errorf("expected 52 cards, got %d", d.Len())
+	}
+}
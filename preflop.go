@@ -0,0 +1,128 @@
+package cactuskev
+
+import (
+	"fmt"
+)
+
+// A StartingHand is one of the 169 kinds of hold'em hole cards, such as AKs,
+// that differ only by the suits of the cards. Its value is its position in
+// the usual 13 by 13 chart, read row by row: pairs down the diagonal from
+// AA, suited hands above it and offsuit hands below.
+type StartingHand uint8
+
+// NumStartingHands is the number of StartingHands.
+const NumStartingHands = 169
+
+// MaxPreflopOpponents is the most random opponents PreflopEquityVsRandom
+// knows the equity against.
+const MaxPreflopOpponents = 9
+
+// StartingHandOf returns the StartingHand of hole cards.
+func StartingHandOf(hole [2]Card) StartingHand {
+	high, low := hole[0].Rank(), hole[1].Rank()
+	if low > high {
+		high, low = low, high
+	}
+
+	row, col := int(Ace-high), int(Ace-low)
+	if hole[0].Suit() != hole[1].Suit() {
+		row, col = col, row
+	}
+
+	return StartingHand(row*13 + col)
+}
+
+// ParseStartingHand parses a starting hand such as "AA", "AKs" or "72o".
+func ParseStartingHand(s string) (StartingHand, error) {
+	h, err := parseStartingHand(s)
+	if err != nil {
+		return 0, err
+	}
+	if !h.pair() && !h.suited && !h.offsuit {
+		return 0, fmt.Errorf("%w hand %q: suited or offsuit?", ErrRange, s)
+	}
+
+	return StartingHandOf(h.combos()[0]), nil
+}
+
+// MustParseStartingHand is like ParseStartingHand, but panics on error.
+func MustParseStartingHand(s string) StartingHand {
+	h, err := ParseStartingHand(s)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// startingHand returns h as a hand to list the combos of.
+func (h StartingHand) startingHand() startingHand {
+	row, col := Rank(h/13), Rank(h%13)
+
+	switch {
+	case row < col:
+		return startingHand{high: Ace - row, low: Ace - col, suited: true}
+	case row > col:
+		return startingHand{high: Ace - col, low: Ace - row, offsuit: true}
+	default:
+		return startingHand{high: Ace - row, low: Ace - row}
+	}
+}
+
+// Combos returns every pair of hole cards of h, the higher ranked card
+// first: 6 for a pair, 4 suited or 12 offsuit.
+func (h StartingHand) Combos() [][2]Card {
+	return h.startingHand().combos()
+}
+
+func (h StartingHand) String() string {
+	if h >= NumStartingHands {
+		return fmt.Sprintf("StartingHand(%d)", uint8(h))
+	}
+
+	s := h.startingHand()
+	switch {
+	case s.suited:
+		return s.high.String() + s.low.String() + "s"
+	case s.offsuit:
+		return s.high.String() + s.low.String() + "o"
+	default:
+		return s.high.String() + s.low.String()
+	}
+}
+
+// PreflopEquity returns the share of the pot hand wins on average all in
+// before the flop against other, ties split evenly, over every combination
+// of their combos that share no card and every board. It is looked up in a
+// table generated by makepreflop.go. It returns an error unless both are
+// below NumStartingHands.
+func PreflopEquity(hand, other StartingHand) (float64, error) {
+	if err := checkStartingHands(hand, other); err != nil {
+		return 0, err
+	}
+	return float64(preflopHeadsUp[hand][other]), nil
+}
+
+// PreflopEquityVsRandom returns the share of the pot hand wins on average all
+// in before the flop against 1 to MaxPreflopOpponents opponents holding
+// random cards. Against one opponent the share is exact; against more it
+// was estimated from random deals, to within about a tenth of a percent. It
+// returns an error wrapping ErrIndex for other numbers of opponents, or if
+// hand is not below NumStartingHands.
+func PreflopEquityVsRandom(hand StartingHand, opponents int) (float64, error) {
+	if err := checkStartingHands(hand); err != nil {
+		return 0, err
+	}
+	if opponents < 1 || opponents > MaxPreflopOpponents {
+		return 0, fmt.Errorf("%w: %d opponents not supported", ErrIndex, opponents)
+	}
+	return float64(preflopVsRandom[hand][opponents-1]), nil
+}
+
+func checkStartingHands(hands ...StartingHand) error {
+	for _, h := range hands {
+		if h >= NumStartingHands {
+			return fmt.Errorf("%w: %v", ErrIndex, h)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,5928 @@
+// Code generated by "go run makepreflop.go"; DO NOT EDIT.
+
+package cactuskev
+
+// preflopHeadsUp[a][b] is the equity of StartingHand a all in preflop
+// against b.
+var preflopHeadsUp = [NumStartingHands][NumStartingHands]float32{
+	{ // AA
+		0.500000, 0.878595, 0.874609, 0.870623, 0.866637, 0.884323,
+		0.880337, 0.880344, 0.882321, 0.866931, 0.870931, 0.874931,
+		0.878930, 0.931719, 0.819461, 0.829357, 0.825314, 0.821270,
+		0.827270, 0.839173, 0.835130, 0.835009, 0.837582, 0.841640,
+		0.845697, 0.849755, 0.927362, 0.871198, 0.815471, 0.808876,
+		0.804833, 0.810832, 0.822736, 0.835137, 0.830965, 0.833538,
+		0.837596, 0.841654, 0.845711, 0.923005, 0.866823, 0.849290,
+		0.811481, 0.788395, 0.794395, 0.806299, 0.818699, 0.830972,
+		0.829495, 0.833552, 0.837610, 0.841668, 0.918648, 0.862448,
+		0.844915, 0.827382, 0.807491, 0.777958, 0.789861, 0.802262,
+		0.814535, 0.829502, 0.829509, 0.833566, 0.837624, 0.937818,
+		0.868701, 0.851168, 0.833635, 0.816102, 0.808606, 0.779402,
+		0.791803, 0.804076, 0.819043, 0.835494, 0.835501, 0.839559,
+		0.933461, 0.881442, 0.863909, 0.846376, 0.828843, 0.817810,
+		0.804617, 0.775366, 0.787639, 0.802606, 0.819058, 0.835509,
+		0.835516, 0.933470, 0.877082, 0.877076, 0.859543, 0.842010,
+		0.830978, 0.813445, 0.804808, 0.775254, 0.790221, 0.806673,
+		0.823124, 0.839576, 0.935618, 0.876953, 0.872578, 0.872573,
+		0.855039, 0.844007, 0.826474, 0.813325, 0.804575, 0.775012,
+		0.791464, 0.807915, 0.824367, 0.918979, 0.879729, 0.875354,
+		0.870980, 0.870974, 0.859941, 0.842409, 0.829260, 0.813078,
+		0.808886, 0.789064, 0.805517, 0.821968, 0.923361, 0.884136,
+		0.879761, 0.875387, 0.871012, 0.877507, 0.859974, 0.846825,
+		0.830644, 0.828141, 0.813285, 0.809590, 0.826044, 0.927753,
+		0.888555, 0.884181, 0.879806, 0.875431, 0.877557, 0.877552,
+		0.864402, 0.848221, 0.845719, 0.850126, 0.817710, 0.830125,
+		0.932155, 0.892991, 0.888616, 0.884241, 0.879867, 0.881992,
+		0.877618, 0.881996, 0.865815, 0.863313, 0.867720, 0.872139,
+		0.822167,
+	},
+	{ // AKs
+		0.121405, 0.500000, 0.712772, 0.710101, 0.707430, 0.711934,
+		0.709430, 0.706956, 0.709286, 0.698170, 0.702430, 0.706720,
+		0.711039, 0.524921, 0.341064, 0.714286, 0.711615, 0.708944,
+		0.727256, 0.724839, 0.722480, 0.723082, 0.727341, 0.731804,
+		0.736325, 0.740904, 0.754463, 0.755913, 0.460485, 0.635281,
+		0.632231, 0.644504, 0.652831, 0.661890, 0.658256, 0.661156,
+		0.664567, 0.668038, 0.671568, 0.751480, 0.752931, 0.664448,
+		0.459612, 0.620078, 0.632350, 0.640677, 0.649736, 0.658260,
+		0.658106, 0.661517, 0.664988, 0.668518, 0.748497, 0.749948,
+		0.661185, 0.648391, 0.459370, 0.620197, 0.628524, 0.637583,
+		0.646107, 0.658109, 0.658467, 0.661938, 0.665468, 0.753860,
+		0.770289, 0.674124, 0.661330, 0.648536, 0.474220, 0.628824,
+		0.625951, 0.634475, 0.646477, 0.658993, 0.659410, 0.662940,
+		0.751050, 0.767568, 0.682945, 0.670152, 0.657358, 0.658121,
+		0.475241, 0.613976, 0.622500, 0.634502, 0.647017, 0.659592,
+		0.660068, 0.748271, 0.764908, 0.692424, 0.679631, 0.666837,
+		0.655038, 0.642423, 0.476897, 0.610585, 0.622588, 0.635104,
+		0.647678, 0.660311, 0.750761, 0.765508, 0.688569, 0.688567,
+		0.675773, 0.663974, 0.651359, 0.638805, 0.476941, 0.610946,
+		0.623462, 0.636037, 0.648670, 0.738602, 0.769985, 0.691542,
+		0.688279, 0.688276, 0.676477, 0.663862, 0.651308, 0.639171,
+		0.480344, 0.613382, 0.625959, 0.638592, 0.743225, 0.774816,
+		0.695194, 0.691931, 0.688668, 0.689660, 0.677044, 0.664490,
+		0.652354, 0.641582, 0.485868, 0.629453, 0.642090, 0.747894,
+		0.779723, 0.698924, 0.695661, 0.692397, 0.690129, 0.690304,
+		0.677750, 0.665614, 0.654842, 0.658554, 0.492061, 0.645656,
+		0.752615, 0.784712, 0.702738, 0.699475, 0.696211, 0.693943,
+		0.690858, 0.691094, 0.678958, 0.668186, 0.671898, 0.675687,
+		0.498933,
+	},
+	{ // AQs
+		0.125391, 0.287228, 0.500000, 0.708149, 0.705478, 0.706625,
+		0.704081, 0.705273, 0.704239, 0.693082, 0.697303, 0.701552,
+		0.705831, 0.298770, 0.318455, 0.714176, 0.617683, 0.614633,
+		0.626513, 0.634839, 0.633092, 0.629459, 0.632358, 0.635770,
+		0.639241, 0.642771, 0.524921, 0.755812, 0.342749, 0.699717,
+		0.697046, 0.715358, 0.712941, 0.724354, 0.721631, 0.725890,
+		0.730353, 0.734874, 0.739453, 0.749579, 0.646699, 0.739977,
+		0.458845, 0.622024, 0.633994, 0.642321, 0.652802, 0.661101,
+		0.660947, 0.664358, 0.667829, 0.671359, 0.746596, 0.643436,
+		0.736995, 0.650301, 0.458603, 0.621841, 0.630168, 0.640649,
+		0.648947, 0.660950, 0.661308, 0.664779, 0.668309, 0.748287,
+		0.655965, 0.757327, 0.662922, 0.650128, 0.473029, 0.618536,
+		0.629017, 0.634210, 0.646213, 0.658728, 0.659145, 0.662675,
+		0.745435, 0.664787, 0.754606, 0.671743, 0.658950, 0.647145,
+		0.474049, 0.617042, 0.622235, 0.634237, 0.646753, 0.659327,
+		0.659803, 0.746780, 0.663012, 0.766963, 0.682781, 0.669988,
+		0.658183, 0.645567, 0.481072, 0.614455, 0.626458, 0.638973,
+		0.651548, 0.664181, 0.745584, 0.659151, 0.763918, 0.691482,
+		0.678689, 0.663578, 0.650962, 0.642910, 0.480153, 0.611762,
+		0.624279, 0.636853, 0.649487, 0.733385, 0.662124, 0.768395,
+		0.691194, 0.691191, 0.676081, 0.663465, 0.655413, 0.640009,
+		0.483555, 0.614199, 0.626775, 0.639408, 0.737967, 0.665776,
+		0.773226, 0.694847, 0.691583, 0.689263, 0.676648, 0.668595,
+		0.653192, 0.642420, 0.489080, 0.630270, 0.642906, 0.742594,
+		0.669506, 0.778133, 0.698577, 0.695313, 0.689732, 0.689908,
+		0.681855, 0.666452, 0.655680, 0.659392, 0.495272, 0.646473,
+		0.747274, 0.673320, 0.783122, 0.702390, 0.699127, 0.693546,
+		0.690461, 0.695199, 0.679796, 0.669024, 0.672736, 0.676525,
+		0.502144,
+	},
+	{ // AJs
+		0.129377, 0.289899, 0.291851, 0.500000, 0.698716, 0.700627,
+		0.698043, 0.696293, 0.698926, 0.684404, 0.688585, 0.692794,
+		0.697032, 0.301711, 0.322075, 0.589701, 0.712725, 0.617776,
+		0.629656, 0.637983, 0.633129, 0.633329, 0.633175, 0.636587,
+		0.640057, 0.643587, 0.303384, 0.618109, 0.319749, 0.698962,
+		0.606475, 0.618355, 0.626682, 0.635113, 0.632605, 0.632451,
+		0.635863, 0.639334, 0.642864, 0.524921, 0.754222, 0.739222,
+		0.345064, 0.685148, 0.703460, 0.701043, 0.712456, 0.723504,
+		0.724438, 0.728902, 0.733423, 0.738002, 0.739443, 0.646663,
+		0.634659, 0.724041, 0.457836, 0.623787, 0.632114, 0.642293,
+		0.652014, 0.663791, 0.664149, 0.667620, 0.671150, 0.741963,
+		0.659192, 0.647188, 0.744374, 0.652037, 0.472261, 0.617377,
+		0.627556, 0.637276, 0.648565, 0.661080, 0.661497, 0.665027,
+		0.739071, 0.668014, 0.656010, 0.741652, 0.660859, 0.645748,
+		0.473282, 0.615580, 0.625301, 0.636590, 0.649105, 0.661679,
+		0.662156, 0.737202, 0.662926, 0.664934, 0.754001, 0.671579,
+		0.656468, 0.643853, 0.479880, 0.617521, 0.626193, 0.638709,
+		0.651283, 0.663916, 0.740130, 0.663256, 0.662381, 0.765973,
+		0.681839, 0.666728, 0.654113, 0.646054, 0.484328, 0.615632,
+		0.628149, 0.640723, 0.653356, 0.724244, 0.662962, 0.662087,
+		0.766805, 0.694107, 0.678475, 0.665860, 0.655016, 0.644114,
+		0.486766, 0.615015, 0.627592, 0.640225, 0.728785, 0.666614,
+		0.665739, 0.771636, 0.694499, 0.691657, 0.679042, 0.668199,
+		0.657297, 0.643258, 0.492291, 0.631086, 0.643723, 0.733373,
+		0.670344, 0.669469, 0.776543, 0.698229, 0.692127, 0.692302,
+		0.681459, 0.670557, 0.656518, 0.660230, 0.498483, 0.647289,
+		0.738011, 0.674158, 0.673283, 0.781532, 0.702043, 0.695941,
+		0.692855, 0.694803, 0.683901, 0.669862, 0.673574, 0.677363,
+		0.505356,
+	},
+	{ // ATs
+		0.133363, 0.292570, 0.294522, 0.301284, 0.500000, 0.691513,
+		0.688888, 0.686811, 0.686500, 0.675646, 0.676462, 0.680631,
+		0.684829, 0.304652, 0.325694, 0.590518, 0.590097, 0.711274,
+		0.632799, 0.641126, 0.635784, 0.633366, 0.637045, 0.637403,
+		0.640874, 0.644404, 0.306324, 0.618947, 0.323368, 0.580544,
+		0.697511, 0.621498, 0.629825, 0.638257, 0.632643, 0.636321,
+		0.636680, 0.640150, 0.643680, 0.313249, 0.618383, 0.608176,
+		0.321736, 0.683749, 0.610198, 0.618525, 0.626956, 0.634627,
+		0.635598, 0.635956, 0.639427, 0.642957, 0.524921, 0.752632,
+		0.737632, 0.722632, 0.348011, 0.691562, 0.689145, 0.700558,
+		0.711606, 0.726311, 0.727450, 0.731971, 0.736550, 0.732237,
+		0.662419, 0.650415, 0.638412, 0.731420, 0.471494, 0.618834,
+		0.629013, 0.638432, 0.651631, 0.663921, 0.664338, 0.667868,
+		0.729303, 0.671240, 0.659237, 0.647233, 0.728699, 0.647136,
+		0.472515, 0.617038, 0.626457, 0.639656, 0.651946, 0.664520,
+		0.664997, 0.727076, 0.665632, 0.668161, 0.656157, 0.741048,
+		0.657856, 0.645241, 0.479113, 0.616059, 0.629260, 0.641061,
+		0.653635, 0.666269, 0.726791, 0.663170, 0.662295, 0.664303,
+		0.753011, 0.667799, 0.655184, 0.644340, 0.483136, 0.618698,
+		0.627884, 0.640458, 0.653092, 0.715029, 0.667067, 0.666192,
+		0.665317, 0.768860, 0.681625, 0.669010, 0.658167, 0.647259,
+		0.490941, 0.618885, 0.631461, 0.644095, 0.715884, 0.667452,
+		0.666578, 0.665703, 0.770046, 0.694573, 0.681958, 0.670593,
+		0.656900, 0.647363, 0.495502, 0.631903, 0.644539, 0.720430,
+		0.671182, 0.670307, 0.669433, 0.774953, 0.695043, 0.695218,
+		0.683853, 0.670160, 0.660623, 0.661068, 0.501695, 0.648106,
+		0.725028, 0.674996, 0.674121, 0.673246, 0.779942, 0.698856,
+		0.695771, 0.697197, 0.683504, 0.673967, 0.674412, 0.678201,
+		0.508567,
+	},
+	{ // A9s
+		0.115677, 0.288066, 0.293375, 0.299373, 0.308487, 0.500000,
+		0.667049, 0.665250, 0.664612, 0.650815, 0.655298, 0.656102,
+		0.660260, 0.300427, 0.319518, 0.574553, 0.574132, 0.573770,
+		0.711044, 0.629508, 0.624166, 0.621260, 0.622321, 0.626512,
+		0.626929, 0.630459, 0.305764, 0.602540, 0.317192, 0.564579,
+		0.564217, 0.697282, 0.618207, 0.626639, 0.620536, 0.621598,
+		0.625788, 0.626206, 0.629736, 0.311858, 0.601976, 0.591770,
+		0.315560, 0.554723, 0.683577, 0.606966, 0.615397, 0.623068,
+		0.620933, 0.625124, 0.625542, 0.629072, 0.321353, 0.601472,
+		0.591265, 0.581117, 0.314622, 0.669930, 0.595784, 0.604215,
+		0.611886, 0.623036, 0.624519, 0.624937, 0.628467, 0.524922,
+		0.752885, 0.737885, 0.722945, 0.708064, 0.336003, 0.675914,
+		0.687326, 0.698374, 0.713080, 0.727990, 0.729186, 0.733765,
+		0.705999, 0.659368, 0.647364, 0.635420, 0.623535, 0.714397,
+		0.456997, 0.613707, 0.623126, 0.636023, 0.649735, 0.662084,
+		0.662560, 0.704072, 0.653759, 0.656288, 0.644344, 0.632459,
+		0.726746, 0.641753, 0.463595, 0.612240, 0.625138, 0.638850,
+		0.651199, 0.663832, 0.703427, 0.650777, 0.649902, 0.652490,
+		0.640604, 0.738710, 0.651696, 0.640331, 0.467618, 0.611960,
+		0.625673, 0.637533, 0.650167, 0.688453, 0.651882, 0.651007,
+		0.650191, 0.652317, 0.754550, 0.665205, 0.653841, 0.640148,
+		0.474999, 0.616674, 0.625919, 0.638553, 0.693431, 0.656458,
+		0.655583, 0.654767, 0.654011, 0.770753, 0.679712, 0.668347,
+		0.654654, 0.645111, 0.484926, 0.630495, 0.643132, 0.694291,
+		0.656921, 0.656046, 0.655231, 0.654474, 0.772015, 0.692737,
+		0.681372, 0.667158, 0.654830, 0.659776, 0.490155, 0.643645,
+		0.698848, 0.660735, 0.659860, 0.659044, 0.658288, 0.777004,
+		0.693290, 0.694716, 0.680502, 0.668174, 0.673120, 0.673643,
+		0.497027,
+	},
+	{ // A8s
+		0.119663, 0.290570, 0.295919, 0.301957, 0.311112, 0.332951,
+		0.500000, 0.644198, 0.644076, 0.629952, 0.631492, 0.635963,
+		0.636756, 0.303182, 0.319297, 0.569051, 0.569014, 0.568652,
+		0.585160, 0.723424, 0.626486, 0.623580, 0.624153, 0.625726,
+		0.629976, 0.630453, 0.308560, 0.596975, 0.320386, 0.561930,
+		0.561568, 0.578076, 0.709662, 0.628959, 0.623240, 0.623813,
+		0.625387, 0.629637, 0.630113, 0.314695, 0.596805, 0.589123,
+		0.319275, 0.552458, 0.568966, 0.695957, 0.618101, 0.625772,
+		0.623149, 0.624722, 0.628972, 0.629449, 0.324232, 0.596300,
+		0.588618, 0.578864, 0.318337, 0.559532, 0.682311, 0.606919,
+		0.614590, 0.625740, 0.624117, 0.628367, 0.628844, 0.347513,
+		0.613658, 0.605977, 0.596222, 0.586134, 0.324127, 0.672902,
+		0.600117, 0.607788, 0.618938, 0.630600, 0.632142, 0.632619,
+		0.524923, 0.766295, 0.751294, 0.736354, 0.721473, 0.711353,
+		0.340529, 0.675429, 0.686477, 0.701183, 0.716093, 0.731061,
+		0.732315, 0.681491, 0.656130, 0.658659, 0.647108, 0.635222,
+		0.628127, 0.713794, 0.462828, 0.614186, 0.627084, 0.640494,
+		0.654265, 0.666673, 0.681406, 0.653147, 0.652666, 0.655253,
+		0.643368, 0.636273, 0.725757, 0.642240, 0.466851, 0.613417,
+		0.626829, 0.640600, 0.653008, 0.666073, 0.653731, 0.653250,
+		0.652434, 0.655081, 0.647985, 0.741598, 0.655750, 0.641535,
+		0.474231, 0.615212, 0.628986, 0.640905, 0.667838, 0.655516,
+		0.655035, 0.654219, 0.653462, 0.660378, 0.757792, 0.669939,
+		0.655725, 0.643397, 0.483735, 0.633562, 0.642867, 0.672822,
+		0.660170, 0.659689, 0.658873, 0.658116, 0.662149, 0.774071,
+		0.684523, 0.670309, 0.657981, 0.662921, 0.494330, 0.647515,
+		0.673693, 0.660717, 0.660236, 0.659420, 0.658663, 0.662696,
+		0.775415, 0.697632, 0.683418, 0.670568, 0.672724, 0.677748,
+		0.500238,
+	},
+	{ // A7s
+		0.119656, 0.293044, 0.294727, 0.303707, 0.313189, 0.334750,
+		0.355802, 0.500000, 0.615313, 0.601940, 0.603153, 0.604681,
+		0.609142, 0.305913, 0.322916, 0.569867, 0.569830, 0.569468,
+		0.585976, 0.597362, 0.721973, 0.626723, 0.627295, 0.628381,
+		0.630013, 0.634322, 0.307401, 0.597807, 0.318814, 0.554449,
+		0.554471, 0.570979, 0.582364, 0.720109, 0.622853, 0.623426,
+		0.624511, 0.626144, 0.630453, 0.316741, 0.597636, 0.581537,
+		0.320598, 0.547446, 0.563954, 0.575339, 0.706404, 0.625385,
+		0.623145, 0.624230, 0.625863, 0.630172, 0.326637, 0.597131,
+		0.581425, 0.573803, 0.320180, 0.554903, 0.566289, 0.692757,
+		0.614586, 0.625736, 0.623625, 0.625258, 0.629567, 0.349619,
+		0.614490, 0.598783, 0.591161, 0.581466, 0.325971, 0.561234,
+		0.683349, 0.607784, 0.618934, 0.630596, 0.629033, 0.633342,
+		0.371966, 0.626355, 0.610649, 0.603027, 0.593331, 0.588032,
+		0.326422, 0.669819, 0.596721, 0.607871, 0.619533, 0.631255,
+		0.632856, 0.524927, 0.764708, 0.762662, 0.747721, 0.732840,
+		0.722720, 0.707959, 0.344208, 0.676033, 0.690740, 0.705650,
+		0.720618, 0.735643, 0.650434, 0.656374, 0.652269, 0.654857,
+		0.643365, 0.636269, 0.624503, 0.714398, 0.462869, 0.614544,
+		0.627955, 0.641424, 0.655254, 0.635919, 0.656958, 0.652853,
+		0.652430, 0.655077, 0.647982, 0.636216, 0.730238, 0.642603,
+		0.470249, 0.615850, 0.629321, 0.643151, 0.637326, 0.658222,
+		0.654117, 0.653694, 0.652938, 0.660374, 0.648608, 0.746433,
+		0.656792, 0.643943, 0.479753, 0.631280, 0.645114, 0.639097,
+		0.660084, 0.655979, 0.655557, 0.654800, 0.658833, 0.661078,
+		0.762703, 0.671059, 0.658209, 0.660365, 0.489924, 0.649761,
+		0.644092, 0.664822, 0.660717, 0.660295, 0.659538, 0.663571,
+		0.662933, 0.779064, 0.685727, 0.672877, 0.675033, 0.680050,
+		0.501199,
+	},
+	{ // A6s
+		0.117679, 0.290714, 0.295761, 0.301074, 0.313500, 0.335388,
+		0.355924, 0.384687, 0.500000, 0.567410, 0.569611, 0.571285,
+		0.575705, 0.303426, 0.319282, 0.569864, 0.565994, 0.566016,
+		0.582524, 0.593909, 0.593725, 0.721551, 0.627213, 0.628298,
+		0.629443, 0.631030, 0.308568, 0.597803, 0.318807, 0.554445,
+		0.554467, 0.570975, 0.582360, 0.593728, 0.718235, 0.624163,
+		0.625249, 0.626393, 0.627980, 0.314018, 0.593698, 0.581527,
+		0.315399, 0.539145, 0.556036, 0.567422, 0.578790, 0.716429,
+		0.620353, 0.621438, 0.622582, 0.624169, 0.327119, 0.593587,
+		0.581415, 0.565375, 0.317876, 0.549071, 0.560457, 0.571824,
+		0.702782, 0.622944, 0.621217, 0.622361, 0.623948, 0.350460,
+		0.610945, 0.598773, 0.583126, 0.575563, 0.324188, 0.555786,
+		0.567154, 0.693374, 0.616525, 0.628188, 0.626136, 0.627723,
+		0.372249, 0.622810, 0.610639, 0.594991, 0.587428, 0.582523,
+		0.324639, 0.557838, 0.679844, 0.605462, 0.617125, 0.628846,
+		0.627237, 0.403021, 0.622492, 0.622489, 0.606842, 0.599279,
+		0.594374, 0.584404, 0.326340, 0.669698, 0.597514, 0.609176,
+		0.620897, 0.632980, 0.524936, 0.764269, 0.760632, 0.758645,
+		0.743764, 0.733644, 0.718883, 0.707829, 0.345023, 0.676887,
+		0.691798, 0.706766, 0.721792, 0.598683, 0.656886, 0.653623,
+		0.649577, 0.652224, 0.645522, 0.633756, 0.625318, 0.715271,
+		0.463984, 0.614009, 0.627480, 0.641310, 0.601169, 0.658150,
+		0.654887, 0.650841, 0.650478, 0.657914, 0.646148, 0.637710,
+		0.731466, 0.641973, 0.473487, 0.628950, 0.642784, 0.603101,
+		0.659492, 0.656228, 0.652182, 0.651819, 0.655852, 0.658618,
+		0.650180, 0.747736, 0.656240, 0.657874, 0.483658, 0.644709,
+		0.608046, 0.661329, 0.658065, 0.654019, 0.653656, 0.657689,
+		0.657051, 0.663045, 0.764088, 0.670901, 0.672535, 0.674659,
+		0.494920,
+	},
+	{ // A5s
+		0.133069, 0.301830, 0.306918, 0.315596, 0.324354, 0.349185,
+		0.370048, 0.398060, 0.432590, 0.500000, 0.550885, 0.553231,
+		0.554311, 0.315417, 0.333382, 0.574730, 0.571680, 0.567869,
+		0.584761, 0.596147, 0.595962, 0.596245, 0.724336, 0.640035,
+		0.641179, 0.642324, 0.320600, 0.602771, 0.332907, 0.560131,
+		0.556321, 0.573212, 0.584598, 0.595966, 0.593195, 0.721021,
+		0.636985, 0.638129, 0.639274, 0.329704, 0.599507, 0.587336,
+		0.333125, 0.544831, 0.561722, 0.573108, 0.584476, 0.593258,
+		0.717763, 0.633994, 0.635139, 0.636283, 0.338914, 0.595462,
+		0.583290, 0.571177, 0.330411, 0.546460, 0.558229, 0.569597,
+		0.578378, 0.716014, 0.630243, 0.631387, 0.632531, 0.365461,
+		0.613213, 0.601041, 0.588929, 0.572947, 0.339617, 0.555643,
+		0.567011, 0.575793, 0.706606, 0.637214, 0.635546, 0.636690,
+		0.387609, 0.625078, 0.612907, 0.600794, 0.585206, 0.582433,
+		0.340589, 0.558079, 0.566861, 0.693076, 0.626535, 0.638256,
+		0.636204, 0.417562, 0.624760, 0.624757, 0.612645, 0.597057,
+		0.594283, 0.584707, 0.342290, 0.560660, 0.682931, 0.618586,
+		0.630307, 0.642029, 0.454770, 0.625060, 0.621796, 0.621852,
+		0.606264, 0.603491, 0.593915, 0.587274, 0.343772, 0.669826,
+		0.610354, 0.622075, 0.633796, 0.524956, 0.767227, 0.763591,
+		0.760014, 0.758086, 0.747966, 0.733205, 0.722150, 0.707961,
+		0.364651, 0.692045, 0.706935, 0.721822, 0.580541, 0.670141,
+		0.666877, 0.663673, 0.659687, 0.667123, 0.655751, 0.647312,
+		0.638578, 0.731232, 0.479960, 0.644701, 0.658232, 0.583210,
+		0.671482, 0.668219, 0.665015, 0.661028, 0.665454, 0.668220,
+		0.659782, 0.651048, 0.747421, 0.674063, 0.490130, 0.659774,
+		0.584522, 0.672848, 0.669585, 0.666381, 0.662394, 0.666820,
+		0.666183, 0.672277, 0.663543, 0.763632, 0.688414, 0.690126,
+		0.500981,
+	},
+	{ // A4s
+		0.129069, 0.297570, 0.302697, 0.311415, 0.323538, 0.344702,
+		0.368508, 0.396847, 0.430389, 0.449115, 0.500000, 0.527619,
+		0.530201, 0.310828, 0.329749, 0.573906, 0.570857, 0.567866,
+		0.580925, 0.592694, 0.592510, 0.592793, 0.604806, 0.725788,
+		0.640356, 0.641500, 0.316053, 0.601926, 0.329273, 0.559308,
+		0.556317, 0.569376, 0.581145, 0.592513, 0.589743, 0.601757,
+		0.722472, 0.637306, 0.638450, 0.325198, 0.598662, 0.586490,
+		0.329492, 0.544827, 0.557886, 0.569656, 0.581023, 0.589805,
+		0.598766, 0.719214, 0.634315, 0.635459, 0.338063, 0.595458,
+		0.583286, 0.571174, 0.330404, 0.546456, 0.558225, 0.569593,
+		0.578375, 0.598888, 0.716014, 0.631383, 0.632528, 0.360719,
+		0.609275, 0.597103, 0.584991, 0.572938, 0.334418, 0.547342,
+		0.559094, 0.567876, 0.588388, 0.718504, 0.632012, 0.633156,
+		0.386072, 0.621534, 0.609362, 0.597250, 0.585196, 0.574004,
+		0.338285, 0.552247, 0.561029, 0.581542, 0.704974, 0.634722,
+		0.633054, 0.416385, 0.621216, 0.621213, 0.609100, 0.597047,
+		0.586248, 0.578804, 0.340507, 0.555212, 0.575725, 0.694829,
+		0.627157, 0.638879, 0.452513, 0.621515, 0.618252, 0.618308,
+		0.606254, 0.595456, 0.588012, 0.581764, 0.341990, 0.567491,
+		0.681725, 0.618925, 0.630646, 0.472065, 0.633809, 0.630546,
+		0.627341, 0.627457, 0.616658, 0.609214, 0.602966, 0.594232,
+		0.336863, 0.684258, 0.610169, 0.621949, 0.524992, 0.768854,
+		0.765217, 0.761640, 0.758122, 0.760955, 0.746194, 0.735140,
+		0.720951, 0.723192, 0.368963, 0.708418, 0.723310, 0.555521,
+		0.670637, 0.667374, 0.664169, 0.661024, 0.661827, 0.664593,
+		0.656549, 0.647814, 0.638481, 0.749047, 0.486912, 0.658951,
+		0.558472, 0.672003, 0.668740, 0.665536, 0.662390, 0.663193,
+		0.662949, 0.669043, 0.660309, 0.651035, 0.765258, 0.689280,
+		0.497763,
+	},
+	{ // A3s
+		0.125069, 0.293280, 0.298448, 0.307206, 0.319369, 0.343898,
+		0.364037, 0.395319, 0.428715, 0.446769, 0.472381, 0.500000,
+		0.527222, 0.306208, 0.326115, 0.573083, 0.570033, 0.567042,
+		0.580921, 0.588858, 0.589057, 0.589340, 0.601354, 0.604825,
+		0.727239, 0.640677, 0.311474, 0.601080, 0.325640, 0.558484,
+		0.555493, 0.569373, 0.577309, 0.589061, 0.586290, 0.598304,
+		0.601775, 0.723923, 0.637627, 0.320661, 0.597817, 0.585645,
+		0.325858, 0.544004, 0.557883, 0.565820, 0.577571, 0.586353,
+		0.595313, 0.598784, 0.720665, 0.634636, 0.333567, 0.594613,
+		0.582441, 0.570328, 0.326770, 0.546452, 0.554389, 0.566140,
+		0.574922, 0.595435, 0.595853, 0.717465, 0.631704, 0.359877,
+		0.609271, 0.597100, 0.584987, 0.572934, 0.334411, 0.547338,
+		0.559090, 0.567872, 0.588385, 0.600355, 0.718504, 0.633153,
+		0.381339, 0.617596, 0.605424, 0.593312, 0.581258, 0.573995,
+		0.333086, 0.543946, 0.552728, 0.573625, 0.585594, 0.716872,
+		0.629520, 0.414858, 0.617671, 0.617668, 0.605555, 0.593502,
+		0.586238, 0.570376, 0.338203, 0.548996, 0.569893, 0.581863,
+		0.706727, 0.635345, 0.450826, 0.617970, 0.614707, 0.614763,
+		0.602710, 0.595446, 0.579584, 0.575468, 0.339685, 0.561659,
+		0.573630, 0.693623, 0.627112, 0.469642, 0.630264, 0.627001,
+		0.623797, 0.623912, 0.616648, 0.601179, 0.597063, 0.588329,
+		0.335080, 0.565255, 0.696159, 0.618799, 0.497375, 0.633976,
+		0.630712, 0.627508, 0.624363, 0.629268, 0.613799, 0.609683,
+		0.600948, 0.592009, 0.341314, 0.699987, 0.622356, 0.525053,
+		0.770504, 0.766868, 0.763291, 0.759773, 0.761016, 0.759208,
+		0.748154, 0.733965, 0.736206, 0.740361, 0.373954, 0.724809,
+		0.555300, 0.671158, 0.667895, 0.664690, 0.661545, 0.663190,
+		0.659322, 0.665416, 0.656682, 0.647802, 0.651572, 0.766909,
+		0.494544,
+	},
+	{ // A2s
+		0.121070, 0.288961, 0.294169, 0.302968, 0.315171, 0.339740,
+		0.363244, 0.390858, 0.424295, 0.445689, 0.469799, 0.472778,
+		0.500000, 0.301556, 0.322481, 0.572259, 0.569209, 0.566219,
+		0.580098, 0.588855, 0.585221, 0.585504, 0.597902, 0.601373,
+		0.604844, 0.728690, 0.306864, 0.600235, 0.322006, 0.557661,
+		0.554670, 0.568549, 0.577306, 0.585225, 0.582454, 0.594852,
+		0.598323, 0.601794, 0.725374, 0.316092, 0.596972, 0.584800,
+		0.322224, 0.543180, 0.557059, 0.565816, 0.573735, 0.582517,
+		0.591861, 0.595332, 0.598803, 0.722117, 0.329040, 0.593767,
+		0.581596, 0.569483, 0.323137, 0.545629, 0.554385, 0.562305,
+		0.571086, 0.591983, 0.592400, 0.595871, 0.718916, 0.355391,
+		0.608426, 0.596254, 0.584142, 0.572089, 0.330777, 0.547335,
+		0.555254, 0.564036, 0.584932, 0.596902, 0.597320, 0.719955,
+		0.380507, 0.617592, 0.605420, 0.593308, 0.581254, 0.573991,
+		0.333079, 0.543942, 0.552724, 0.573621, 0.585591, 0.597561,
+		0.716872, 0.410135, 0.613733, 0.613730, 0.601617, 0.589564,
+		0.582300, 0.570366, 0.333004, 0.538609, 0.561975, 0.573945,
+		0.585915, 0.718625, 0.446145, 0.614032, 0.610769, 0.610825,
+		0.598772, 0.591508, 0.579574, 0.564907, 0.334487, 0.553742,
+		0.565713, 0.577683, 0.705521, 0.468598, 0.626720, 0.623456,
+		0.620252, 0.620367, 0.613104, 0.601169, 0.589028, 0.580294,
+		0.333297, 0.559807, 0.571838, 0.708057, 0.494691, 0.630431,
+		0.627168, 0.623963, 0.620818, 0.625723, 0.613789, 0.601648,
+		0.592913, 0.586500, 0.339531, 0.575392, 0.711889, 0.497922,
+		0.634161, 0.630898, 0.627693, 0.624548, 0.626193, 0.626427,
+		0.614286, 0.605551, 0.599197, 0.602968, 0.346496, 0.715734,
+		0.525147, 0.772188, 0.768552, 0.764974, 0.761457, 0.762700,
+		0.759302, 0.761201, 0.747012, 0.749253, 0.753408, 0.757579,
+		0.379642,
+	},
+	{ // AKo
+		0.068281, 0.475079, 0.701230, 0.698289, 0.695348, 0.699573,
+		0.696818, 0.694087, 0.696574, 0.684583, 0.689172, 0.693792,
+		0.698444, 0.500000, 0.301195, 0.704234, 0.701286, 0.698337,
+		0.717536, 0.714907, 0.712293, 0.712890, 0.717618, 0.722436,
+		0.727316, 0.732260, 0.743939, 0.747739, 0.432423, 0.610702,
+		0.607478, 0.619997, 0.628445, 0.637915, 0.634056, 0.637271,
+		0.640881, 0.644553, 0.648288, 0.740666, 0.744467, 0.648337,
+		0.431491, 0.594861, 0.607380, 0.615828, 0.625298, 0.634060,
+		0.634048, 0.637657, 0.641329, 0.645064, 0.737394, 0.741194,
+		0.644816, 0.631216, 0.431232, 0.594763, 0.603211, 0.612680,
+		0.621443, 0.634051, 0.634433, 0.638105, 0.641841, 0.742415,
+		0.762413, 0.658137, 0.644538, 0.630938, 0.447288, 0.603286,
+		0.600311, 0.609073, 0.621682, 0.634684, 0.635129, 0.638865,
+		0.739326, 0.759421, 0.667132, 0.653533, 0.639934, 0.640625,
+		0.448385, 0.587889, 0.596651, 0.609260, 0.622263, 0.635329,
+		0.635837, 0.736273, 0.756492, 0.677317, 0.663718, 0.650119,
+		0.637302, 0.623898, 0.450161, 0.584294, 0.596903, 0.609906,
+		0.622972, 0.636101, 0.738911, 0.757072, 0.673138, 0.673129,
+		0.659530, 0.646713, 0.633310, 0.619971, 0.450114, 0.584676,
+		0.597679, 0.610745, 0.623874, 0.725850, 0.761996, 0.676501,
+		0.672980, 0.672971, 0.660154, 0.646750, 0.633412, 0.620345,
+		0.453828, 0.587581, 0.600648, 0.613777, 0.730793, 0.767160,
+		0.680425, 0.676904, 0.673383, 0.674156, 0.660753, 0.647414,
+		0.634348, 0.623484, 0.459688, 0.604336, 0.617467, 0.735779,
+		0.772399, 0.684425, 0.680904, 0.677383, 0.674644, 0.674831,
+		0.661493, 0.648426, 0.637562, 0.641550, 0.466245, 0.621227,
+		0.740812, 0.777715, 0.688505, 0.684984, 0.681463, 0.678724,
+		0.675399, 0.675650, 0.662583, 0.651720, 0.655707, 0.659770,
+		0.473508,
+	},
+	{ // KK
+		0.180539, 0.658936, 0.681545, 0.677925, 0.674306, 0.680482,
+		0.680703, 0.677084, 0.680718, 0.666618, 0.670251, 0.673885,
+		0.677519, 0.698805, 0.500000, 0.861319, 0.857333, 0.853348,
+		0.871033, 0.884210, 0.880224, 0.880231, 0.879980, 0.883980,
+		0.887980, 0.891979, 0.717892, 0.913010, 0.819348, 0.822964,
+		0.818921, 0.821969, 0.825858, 0.837762, 0.833718, 0.833465,
+		0.837522, 0.841580, 0.845638, 0.713946, 0.908653, 0.864378,
+		0.815358, 0.802483, 0.805531, 0.809421, 0.821325, 0.833725,
+		0.829421, 0.833479, 0.837536, 0.841594, 0.710000, 0.904296,
+		0.860003, 0.842470, 0.811368, 0.789094, 0.792984, 0.804887,
+		0.817288, 0.829428, 0.829435, 0.833493, 0.837550, 0.716420,
+		0.923449, 0.863177, 0.845644, 0.828111, 0.808166, 0.782525,
+		0.790589, 0.802989, 0.815130, 0.831581, 0.831588, 0.835646,
+		0.716672, 0.937705, 0.867163, 0.849630, 0.832097, 0.821052,
+		0.808712, 0.779343, 0.791744, 0.803884, 0.820336, 0.836787,
+		0.836794, 0.712728, 0.933349, 0.879906, 0.862373, 0.844840,
+		0.829643, 0.817753, 0.804726, 0.775308, 0.787449, 0.803900,
+		0.820352, 0.836803, 0.716681, 0.933360, 0.875549, 0.875543,
+		0.858010, 0.842813, 0.830923, 0.813392, 0.804922, 0.775066,
+		0.791518, 0.807970, 0.824421, 0.701523, 0.933090, 0.875281,
+		0.870906, 0.870901, 0.855704, 0.843814, 0.826283, 0.813136,
+		0.804845, 0.774829, 0.791282, 0.807733, 0.705501, 0.937473,
+		0.879688, 0.875313, 0.870938, 0.873269, 0.861379, 0.843848,
+		0.830701, 0.812906, 0.809244, 0.795355, 0.811809, 0.709492,
+		0.941864, 0.884107, 0.879732, 0.875357, 0.873319, 0.878957,
+		0.861425, 0.848279, 0.830484, 0.834891, 0.813669, 0.815890,
+		0.713499, 0.946266, 0.888543, 0.884168, 0.879793, 0.877754,
+		0.879023, 0.879019, 0.865873, 0.848078, 0.852485, 0.856904,
+		0.818126,
+	},
+	{ // KQs
+		0.170643, 0.285714, 0.285824, 0.410299, 0.409482, 0.425447,
+		0.430949, 0.430133, 0.430136, 0.425270, 0.426094, 0.426917,
+		0.427741, 0.295766, 0.138681, 0.500000, 0.708525, 0.705773,
+		0.706811, 0.710499, 0.707984, 0.705498, 0.706129, 0.710349,
+		0.714599, 0.718877, 0.295876, 0.524921, 0.353386, 0.708404,
+		0.705653, 0.710056, 0.724206, 0.721806, 0.719465, 0.720269,
+		0.724692, 0.729173, 0.733711, 0.436767, 0.749609, 0.749237,
+		0.463302, 0.633376, 0.643216, 0.652393, 0.660779, 0.669897,
+		0.666899, 0.670311, 0.673782, 0.677312, 0.435876, 0.746545,
+		0.746173, 0.661952, 0.463060, 0.631063, 0.640240, 0.648626,
+		0.657744, 0.666903, 0.667261, 0.670732, 0.674262, 0.452688,
+		0.748124, 0.751422, 0.672383, 0.659590, 0.474426, 0.628587,
+		0.634201, 0.640214, 0.649372, 0.661887, 0.662305, 0.665835,
+		0.458812, 0.752468, 0.767090, 0.682013, 0.669220, 0.657395,
+		0.487179, 0.632668, 0.629855, 0.639013, 0.651529, 0.664103,
+		0.664579, 0.457925, 0.749648, 0.764389, 0.690896, 0.678103,
+		0.663319, 0.662016, 0.488716, 0.617940, 0.627100, 0.639615,
+		0.652189, 0.664822, 0.457929, 0.746862, 0.761752, 0.700439,
+		0.687645, 0.669556, 0.658997, 0.646443, 0.490854, 0.615248,
+		0.627764, 0.640339, 0.652972, 0.452748, 0.747497, 0.762566,
+		0.697232, 0.697229, 0.679139, 0.668580, 0.656026, 0.643536,
+		0.494823, 0.616261, 0.628837, 0.641470, 0.453673, 0.752079,
+		0.767357, 0.700884, 0.697621, 0.692322, 0.681763, 0.669209,
+		0.656718, 0.644601, 0.500111, 0.632332, 0.644968, 0.454616,
+		0.756707, 0.772223, 0.704614, 0.701351, 0.692791, 0.695023,
+		0.682469, 0.669978, 0.657861, 0.661573, 0.506028, 0.648535,
+		0.455583, 0.761387, 0.777171, 0.708428, 0.705165, 0.696605,
+		0.695576, 0.695813, 0.683322, 0.671205, 0.674917, 0.678706,
+		0.512585,
+	},
+	{ // KJs
+		0.174686, 0.288385, 0.382317, 0.287275, 0.409903, 0.425868,
+		0.430986, 0.430170, 0.434006, 0.428320, 0.429143, 0.429967,
+		0.430791, 0.298714, 0.142667, 0.291475, 0.500000, 0.699052,
+		0.700894, 0.701698, 0.699142, 0.700323, 0.697590, 0.701770,
+		0.705979, 0.710218, 0.408182, 0.302518, 0.320843, 0.707649,
+		0.615868, 0.627668, 0.636452, 0.644838, 0.643150, 0.640152,
+		0.643563, 0.647034, 0.650564, 0.297458, 0.524921, 0.748481,
+		0.355702, 0.693755, 0.698158, 0.712308, 0.709908, 0.721338,
+		0.718818, 0.723241, 0.727722, 0.732260, 0.436215, 0.739433,
+		0.644295, 0.733219, 0.462293, 0.633009, 0.641884, 0.650270,
+		0.660810, 0.669744, 0.670102, 0.673573, 0.677103, 0.453026,
+		0.741882, 0.656742, 0.738468, 0.661499, 0.473659, 0.627125,
+		0.632740, 0.643280, 0.651725, 0.664240, 0.664657, 0.668187,
+		0.458732, 0.743074, 0.665963, 0.754128, 0.670812, 0.655681,
+		0.485987, 0.622381, 0.632921, 0.638749, 0.651264, 0.663838,
+		0.664315, 0.457845, 0.740213, 0.674846, 0.751427, 0.679695,
+		0.661605, 0.651040, 0.487525, 0.621007, 0.626835, 0.639350,
+		0.651924, 0.664558, 0.462040, 0.741551, 0.673134, 0.763807,
+		0.690796, 0.672706, 0.662141, 0.649587, 0.495029, 0.619118,
+		0.631634, 0.644208, 0.656842, 0.455966, 0.738500, 0.669921,
+		0.760976, 0.700145, 0.681534, 0.668184, 0.655630, 0.647641,
+		0.498034, 0.617077, 0.629654, 0.642287, 0.456890, 0.743041,
+		0.673574, 0.765767, 0.700537, 0.694716, 0.681366, 0.668812,
+		0.660823, 0.645439, 0.503323, 0.633148, 0.645785, 0.457833,
+		0.747628, 0.677304, 0.770633, 0.704267, 0.695186, 0.694626,
+		0.682072, 0.674083, 0.658699, 0.662411, 0.509239, 0.649351,
+		0.458801, 0.752267, 0.681117, 0.775581, 0.708080, 0.698999,
+		0.695180, 0.695416, 0.687427, 0.672043, 0.675755, 0.679544,
+		0.515796,
+	},
+	{ // KTs
+		0.178730, 0.291056, 0.385367, 0.382224, 0.288726, 0.426230,
+		0.431348, 0.430532, 0.433984, 0.432131, 0.432134, 0.432958,
+		0.433781, 0.301663, 0.146652, 0.294227, 0.300948, 0.500000,
+		0.691889, 0.693272, 0.690676, 0.688914, 0.689848, 0.690664,
+		0.694833, 0.699031, 0.411400, 0.305541, 0.324463, 0.587887,
+		0.706198, 0.630811, 0.639595, 0.647981, 0.643187, 0.644021,
+		0.644380, 0.647851, 0.651381, 0.407941, 0.312425, 0.615704,
+		0.322831, 0.692436, 0.619511, 0.628295, 0.636681, 0.645171,
+		0.643298, 0.643657, 0.647127, 0.650657, 0.299039, 0.524921,
+		0.746891, 0.731891, 0.358570, 0.686260, 0.700410, 0.698010,
+		0.709440, 0.720691, 0.721789, 0.726270, 0.730809, 0.453302,
+		0.732270, 0.659969, 0.647965, 0.725515, 0.472891, 0.628583,
+		0.634197, 0.644435, 0.654791, 0.667081, 0.667498, 0.671028,
+		0.459008, 0.734089, 0.669190, 0.657186, 0.741174, 0.657069,
+		0.485220, 0.621221, 0.631459, 0.641815, 0.653616, 0.666190,
+		0.666667, 0.458120, 0.731187, 0.678072, 0.666069, 0.738474,
+		0.662992, 0.649642, 0.486757, 0.619545, 0.629901, 0.641702,
+		0.654277, 0.666910, 0.461898, 0.729312, 0.673049, 0.675056,
+		0.750845, 0.673777, 0.660427, 0.647873, 0.493838, 0.622184,
+		0.631369, 0.643944, 0.656577, 0.460014, 0.730385, 0.674026,
+		0.673151, 0.763031, 0.684684, 0.671334, 0.658780, 0.650785,
+		0.502209, 0.620947, 0.633523, 0.646157, 0.460044, 0.731240,
+		0.674412, 0.673537, 0.764177, 0.697632, 0.683761, 0.671207,
+		0.660427, 0.649544, 0.506534, 0.633965, 0.646601, 0.460987,
+		0.735786, 0.678142, 0.677267, 0.769043, 0.698101, 0.697021,
+		0.684467, 0.673687, 0.662804, 0.663249, 0.512450, 0.650168,
+		0.461955, 0.740384, 0.681956, 0.681081, 0.773991, 0.701915,
+		0.697574, 0.697811, 0.687031, 0.676148, 0.676593, 0.680382,
+		0.519007,
+	},
+	{ // K9s
+		0.172730, 0.272744, 0.373487, 0.370344, 0.367201, 0.288956,
+		0.414840, 0.414024, 0.417476, 0.415239, 0.419075, 0.419079,
+		0.419902, 0.282464, 0.128967, 0.293189, 0.299106, 0.308111,
+		0.500000, 0.673349, 0.670713, 0.668623, 0.666614, 0.671097,
+		0.671901, 0.676059, 0.399279, 0.305096, 0.318286, 0.571922,
+		0.571560, 0.705969, 0.627977, 0.636363, 0.631081, 0.629298,
+		0.633489, 0.633906, 0.637436, 0.395820, 0.311107, 0.599297,
+		0.316654, 0.562066, 0.692264, 0.616736, 0.625122, 0.633612,
+		0.628634, 0.632824, 0.633242, 0.636772, 0.392362, 0.320490,
+		0.598792, 0.588645, 0.315676, 0.678559, 0.605495, 0.613881,
+		0.622371, 0.630677, 0.632160, 0.632578, 0.636108, 0.299887,
+		0.524921, 0.747144, 0.732204, 0.717264, 0.346286, 0.687036,
+		0.684636, 0.696066, 0.707317, 0.722187, 0.723343, 0.727882,
+		0.442013, 0.712826, 0.657317, 0.645373, 0.633428, 0.726726,
+		0.469662, 0.617364, 0.627603, 0.637656, 0.651368, 0.663717,
+		0.664193, 0.441126, 0.709884, 0.666200, 0.654256, 0.642311,
+		0.724025, 0.645594, 0.471200, 0.615688, 0.625742, 0.639454,
+		0.651803, 0.664436, 0.444903, 0.707650, 0.660655, 0.663243,
+		0.651299, 0.736396, 0.656378, 0.643824, 0.478280, 0.615408,
+		0.629121, 0.640981, 0.653615, 0.442601, 0.705510, 0.658841,
+		0.658026, 0.660092, 0.748575, 0.666969, 0.654415, 0.643635,
+		0.486227, 0.618210, 0.627455, 0.640089, 0.446823, 0.710488,
+		0.663418, 0.662602, 0.661786, 0.764737, 0.681475, 0.668921,
+		0.658141, 0.646731, 0.495918, 0.632031, 0.644668, 0.446872,
+		0.711348, 0.663881, 0.663065, 0.662249, 0.765958, 0.694500,
+		0.681946, 0.670645, 0.656450, 0.661397, 0.500871, 0.645181,
+		0.447839, 0.715906, 0.667695, 0.666879, 0.666063, 0.770905,
+		0.695054, 0.695290, 0.683989, 0.669794, 0.674741, 0.675263,
+		0.507428,
+	},
+	{ // K8s
+		0.160827, 0.275161, 0.365161, 0.362017, 0.358874, 0.370492,
+		0.276576, 0.402638, 0.406091, 0.403853, 0.407306, 0.411142,
+		0.411145, 0.285093, 0.115790, 0.289501, 0.298302, 0.306728,
+		0.326651, 0.500000, 0.643776, 0.642203, 0.639866, 0.641406,
+		0.645877, 0.646671, 0.390824, 0.301625, 0.314220, 0.559414,
+		0.559052, 0.575560, 0.705593, 0.627900, 0.622618, 0.620346,
+		0.621920, 0.626170, 0.626647, 0.387366, 0.310781, 0.586434,
+		0.312588, 0.549559, 0.566067, 0.691888, 0.616659, 0.625149,
+		0.619682, 0.621256, 0.625506, 0.625982, 0.383908, 0.319535,
+		0.585929, 0.575782, 0.311610, 0.556632, 0.678241, 0.605477,
+		0.613967, 0.622273, 0.620651, 0.624901, 0.625377, 0.396139,
+		0.340766, 0.603281, 0.593133, 0.583045, 0.317308, 0.668833,
+		0.595465, 0.603955, 0.612261, 0.623923, 0.625465, 0.625942,
+		0.286416, 0.524923, 0.747115, 0.732175, 0.717294, 0.707165,
+		0.338716, 0.671590, 0.683020, 0.694271, 0.709141, 0.724069,
+		0.725283, 0.429111, 0.681042, 0.657556, 0.645612, 0.633727,
+		0.623209, 0.709913, 0.459362, 0.613125, 0.623178, 0.636588,
+		0.650359, 0.662767, 0.432888, 0.679367, 0.652011, 0.654599,
+		0.642714, 0.632197, 0.722285, 0.641123, 0.466442, 0.612356,
+		0.625767, 0.639538, 0.651946, 0.430586, 0.676869, 0.649677,
+		0.648861, 0.651508, 0.640990, 0.734463, 0.651714, 0.640412,
+		0.474390, 0.612239, 0.626012, 0.637931, 0.434390, 0.678634,
+		0.651462, 0.650646, 0.649889, 0.653383, 0.750616, 0.665903,
+		0.654602, 0.640407, 0.483657, 0.630588, 0.639894, 0.438630,
+		0.683618, 0.656116, 0.655300, 0.654543, 0.655154, 0.766854,
+		0.680487, 0.669186, 0.654991, 0.659931, 0.493976, 0.644541,
+		0.438704, 0.684489, 0.656663, 0.655847, 0.655090, 0.655701,
+		0.768157, 0.693596, 0.682295, 0.667579, 0.669734, 0.674758,
+		0.499569,
+	},
+	{ // K7s
+		0.164870, 0.277520, 0.366908, 0.366871, 0.364216, 0.375834,
+		0.373514, 0.278027, 0.406275, 0.404038, 0.407490, 0.410943,
+		0.414779, 0.287707, 0.119776, 0.292016, 0.300858, 0.309324,
+		0.329287, 0.356224, 0.500000, 0.615313, 0.613728, 0.614940,
+		0.616469, 0.620929, 0.392686, 0.304389, 0.313998, 0.553913,
+		0.553934, 0.570442, 0.583552, 0.717973, 0.624938, 0.622667,
+		0.623752, 0.625384, 0.629694, 0.392515, 0.313587, 0.580869,
+		0.315782, 0.546910, 0.563418, 0.576528, 0.704268, 0.627470,
+		0.622386, 0.623471, 0.625104, 0.629413, 0.389578, 0.322382,
+		0.580757, 0.573135, 0.315325, 0.554367, 0.567477, 0.690622,
+		0.616671, 0.624977, 0.622866, 0.624499, 0.628808, 0.401809,
+		0.343655, 0.598109, 0.590487, 0.580792, 0.321024, 0.562423,
+		0.677838, 0.606764, 0.615069, 0.626731, 0.625168, 0.629477,
+		0.399349, 0.372448, 0.611844, 0.604222, 0.594527, 0.589222,
+		0.326723, 0.668015, 0.599533, 0.607839, 0.619501, 0.631222,
+		0.632824, 0.288002, 0.524927, 0.760527, 0.745587, 0.730706,
+		0.716894, 0.706297, 0.343799, 0.671125, 0.682377, 0.697247,
+		0.712175, 0.727160, 0.432975, 0.650426, 0.654382, 0.656970,
+		0.645478, 0.635070, 0.627495, 0.709335, 0.465675, 0.614302,
+		0.627713, 0.641182, 0.655012, 0.430673, 0.648746, 0.652047,
+		0.651625, 0.654272, 0.643864, 0.636288, 0.721513, 0.642321,
+		0.473623, 0.613696, 0.627167, 0.640998, 0.434476, 0.650153,
+		0.653311, 0.652889, 0.652132, 0.656256, 0.648681, 0.737667,
+		0.656511, 0.641795, 0.482889, 0.629126, 0.642960, 0.438298,
+		0.651924, 0.655174, 0.654751, 0.653995, 0.654715, 0.661151,
+		0.753897, 0.670777, 0.656061, 0.658217, 0.492784, 0.647607,
+		0.442563, 0.656919, 0.659911, 0.659489, 0.658732, 0.659453,
+		0.663006, 0.770216, 0.685445, 0.670729, 0.672884, 0.677902,
+		0.503744,
+	},
+	{ // K6s
+		0.164991, 0.276918, 0.370541, 0.366671, 0.366634, 0.378740,
+		0.376420, 0.373277, 0.278449, 0.403755, 0.407207, 0.410660,
+		0.414496, 0.287110, 0.119769, 0.294502, 0.299677, 0.311086,
+		0.331377, 0.357797, 0.384687, 0.500000, 0.581131, 0.583332,
+		0.584533, 0.586050, 0.396532, 0.307131, 0.317618, 0.554729,
+		0.554751, 0.571259, 0.584369, 0.595813, 0.716521, 0.625809,
+		0.626895, 0.628039, 0.629731, 0.392427, 0.312438, 0.581701,
+		0.314210, 0.539429, 0.556320, 0.569430, 0.580875, 0.714715,
+		0.621999, 0.623084, 0.624228, 0.625920, 0.392256, 0.324438,
+		0.581589, 0.565549, 0.316647, 0.549355, 0.562465, 0.573909,
+		0.701068, 0.624590, 0.622863, 0.624007, 0.625699, 0.405009,
+		0.346070, 0.598941, 0.583294, 0.575731, 0.322867, 0.557794,
+		0.566133, 0.688284, 0.615066, 0.626728, 0.624676, 0.626368,
+		0.402549, 0.374304, 0.612676, 0.597029, 0.589466, 0.584554,
+		0.328567, 0.560650, 0.678462, 0.607835, 0.619497, 0.631218,
+		0.629714, 0.399094, 0.403013, 0.624603, 0.608956, 0.601393,
+		0.593175, 0.587396, 0.329558, 0.664991, 0.596833, 0.608496,
+		0.620217, 0.631997, 0.288485, 0.524936, 0.758947, 0.756960,
+		0.742079, 0.728267, 0.717670, 0.702971, 0.348002, 0.671939,
+		0.686810, 0.701738, 0.716723, 0.430287, 0.613555, 0.655274,
+		0.651228, 0.653875, 0.643860, 0.636285, 0.624580, 0.710160,
+		0.469641, 0.614822, 0.628294, 0.641822, 0.434090, 0.616041,
+		0.656537, 0.652492, 0.652128, 0.656253, 0.648677, 0.636972,
+		0.726313, 0.642862, 0.478907, 0.629764, 0.643295, 0.437912,
+		0.617453, 0.657879, 0.653833, 0.653470, 0.654190, 0.661147,
+		0.649442, 0.742543, 0.657129, 0.658763, 0.488802, 0.645326,
+		0.442171, 0.619235, 0.659826, 0.655780, 0.655416, 0.656137,
+		0.659690, 0.661996, 0.758854, 0.671479, 0.673113, 0.675346,
+		0.499338,
+	},
+	{ // K5s
+		0.162418, 0.272659, 0.367642, 0.366825, 0.362955, 0.377679,
+		0.375847, 0.372705, 0.372787, 0.275664, 0.395194, 0.398646,
+		0.402098, 0.282382, 0.120020, 0.293871, 0.302410, 0.310152,
+		0.333386, 0.360134, 0.386272, 0.418869, 0.500000, 0.551960,
+		0.554387, 0.555576, 0.393317, 0.306487, 0.317871, 0.555301,
+		0.551490, 0.568382, 0.581492, 0.592936, 0.592811, 0.716285,
+		0.629514, 0.630658, 0.631862, 0.392426, 0.315448, 0.582278,
+		0.318089, 0.540000, 0.556892, 0.570002, 0.581447, 0.592874,
+		0.713027, 0.626523, 0.627668, 0.628871, 0.388321, 0.323558,
+		0.578232, 0.566119, 0.315336, 0.541629, 0.555123, 0.566567,
+		0.577994, 0.711278, 0.622772, 0.623916, 0.625120, 0.403841,
+		0.348395, 0.595977, 0.583865, 0.567883, 0.324450, 0.552538,
+		0.560877, 0.572304, 0.698495, 0.626637, 0.624969, 0.626173,
+		0.401901, 0.376989, 0.609712, 0.597600, 0.582012, 0.579232,
+		0.330671, 0.555777, 0.567204, 0.688672, 0.619790, 0.631511,
+		0.629519, 0.398446, 0.404879, 0.621639, 0.609526, 0.593938,
+		0.587853, 0.582467, 0.331662, 0.557950, 0.675203, 0.608789,
+		0.620510, 0.632290, 0.398461, 0.439873, 0.621384, 0.621441,
+		0.605852, 0.599767, 0.594381, 0.584473, 0.333869, 0.665119,
+		0.600904, 0.612625, 0.624405, 0.285381, 0.524956, 0.758707,
+		0.755130, 0.753202, 0.739390, 0.728793, 0.714094, 0.703103,
+		0.353155, 0.672961, 0.687892, 0.702877, 0.421250, 0.582093,
+		0.659229, 0.656024, 0.652038, 0.656162, 0.648980, 0.637275,
+		0.628901, 0.711220, 0.474646, 0.631226, 0.644757, 0.425071,
+		0.584844, 0.660570, 0.657366, 0.653379, 0.654493, 0.661450,
+		0.649745, 0.641370, 0.727450, 0.660179, 0.484541, 0.646299,
+		0.428918, 0.586267, 0.661995, 0.658791, 0.654804, 0.655918,
+		0.659471, 0.662299, 0.653924, 0.743761, 0.674530, 0.676241,
+		0.495077,
+	},
+	{ // K4s
+		0.158360, 0.268196, 0.364230, 0.363413, 0.362597, 0.373488,
+		0.374274, 0.371619, 0.371702, 0.359965, 0.274212, 0.395175,
+		0.398627, 0.277564, 0.116020, 0.289651, 0.298230, 0.309336,
+		0.328903, 0.358594, 0.385060, 0.416668, 0.448040, 0.500000,
+		0.528734, 0.531386, 0.389714, 0.301940, 0.314237, 0.554477,
+		0.551486, 0.564546, 0.578040, 0.589484, 0.589359, 0.592538,
+		0.717736, 0.629835, 0.631038, 0.388823, 0.310943, 0.581433,
+		0.314456, 0.539997, 0.553056, 0.566550, 0.577994, 0.589421,
+		0.589547, 0.714478, 0.626844, 0.628047, 0.387933, 0.322707,
+		0.578228, 0.566116, 0.315329, 0.541625, 0.555119, 0.566564,
+		0.577991, 0.589669, 0.711278, 0.623912, 0.625116, 0.399518,
+		0.343653, 0.592039, 0.579927, 0.567873, 0.319252, 0.544236,
+		0.552959, 0.564386, 0.576064, 0.710393, 0.621435, 0.622639,
+		0.400345, 0.375452, 0.606168, 0.594055, 0.582002, 0.570804,
+		0.328366, 0.549945, 0.561372, 0.573050, 0.700570, 0.627978,
+		0.626369, 0.397411, 0.403701, 0.618094, 0.605982, 0.593928,
+		0.579818, 0.576564, 0.329879, 0.552501, 0.564180, 0.687101,
+		0.617360, 0.629140, 0.397426, 0.437617, 0.617840, 0.617896,
+		0.605842, 0.591732, 0.588478, 0.578964, 0.332086, 0.558041,
+		0.677019, 0.609475, 0.621255, 0.384911, 0.471349, 0.621100,
+		0.617896, 0.618011, 0.603900, 0.600647, 0.591132, 0.584554,
+		0.334913, 0.665457, 0.598653, 0.610433, 0.283836, 0.524992,
+		0.760333, 0.756756, 0.753239, 0.752380, 0.741783, 0.727084,
+		0.716093, 0.703466, 0.357191, 0.689375, 0.704364, 0.421405,
+		0.557113, 0.659725, 0.656520, 0.653375, 0.650866, 0.657823,
+		0.646511, 0.638137, 0.626613, 0.729076, 0.481323, 0.645476,
+		0.425252, 0.560134, 0.661150, 0.657946, 0.654801, 0.652291,
+		0.656238, 0.659065, 0.650691, 0.639167, 0.745387, 0.675396,
+		0.491858,
+	},
+	{ // K3s
+		0.154303, 0.263675, 0.360759, 0.359943, 0.359126, 0.373071,
+		0.370024, 0.369987, 0.370557, 0.358821, 0.359644, 0.272761,
+		0.395156, 0.272684, 0.112020, 0.285401, 0.294021, 0.305167,
+		0.328099, 0.354123, 0.383531, 0.415467, 0.445613, 0.471266,
+		0.500000, 0.528298, 0.386048, 0.297361, 0.310604, 0.553654,
+		0.550663, 0.564542, 0.574204, 0.586032, 0.585906, 0.589086,
+		0.592556, 0.719187, 0.630215, 0.385157, 0.306405, 0.580587,
+		0.310822, 0.539173, 0.553052, 0.562714, 0.574542, 0.585969,
+		0.586095, 0.589566, 0.715930, 0.627224, 0.384267, 0.318211,
+		0.577383, 0.565271, 0.311695, 0.541622, 0.551283, 0.563111,
+		0.574538, 0.586217, 0.586634, 0.712729, 0.624292, 0.399067,
+		0.342811, 0.592035, 0.579923, 0.567870, 0.319244, 0.544233,
+		0.552955, 0.564382, 0.576060, 0.588030, 0.710392, 0.622635,
+		0.395960, 0.370720, 0.602230, 0.590117, 0.578064, 0.570794,
+		0.323168, 0.541644, 0.553454, 0.565133, 0.577102, 0.712468,
+		0.622835, 0.395792, 0.402174, 0.614550, 0.602437, 0.590384,
+		0.579808, 0.568136, 0.327575, 0.546669, 0.558348, 0.570317,
+		0.698999, 0.625606, 0.396328, 0.436449, 0.614295, 0.614351,
+		0.602298, 0.591722, 0.580443, 0.573061, 0.330303, 0.552593,
+		0.564564, 0.688917, 0.618105, 0.383813, 0.468843, 0.617555,
+		0.614351, 0.614466, 0.603890, 0.592612, 0.585229, 0.579045,
+		0.333130, 0.555790, 0.677358, 0.607283, 0.384738, 0.496617,
+		0.621267, 0.618062, 0.614917, 0.616510, 0.605232, 0.597849,
+		0.591665, 0.582248, 0.339088, 0.681185, 0.610840, 0.282315,
+		0.525053, 0.761984, 0.758407, 0.754889, 0.752440, 0.754797,
+		0.740098, 0.729107, 0.716480, 0.720635, 0.361946, 0.705864,
+		0.421586, 0.556849, 0.660305, 0.657101, 0.653955, 0.652288,
+		0.652611, 0.655438, 0.647457, 0.635933, 0.639704, 0.747038,
+		0.488640,
+	},
+	{ // K2s
+		0.150245, 0.259096, 0.357229, 0.356413, 0.355596, 0.369541,
+		0.369547, 0.365678, 0.368970, 0.357676, 0.358500, 0.359323,
+		0.271310, 0.267740, 0.108021, 0.281123, 0.289782, 0.300969,
+		0.323941, 0.353329, 0.379071, 0.413950, 0.444424, 0.468614,
+		0.471702, 0.500000, 0.382319, 0.292751, 0.306970, 0.552830,
+		0.549839, 0.563719, 0.574200, 0.582196, 0.582454, 0.585633,
+		0.589104, 0.592634, 0.720639, 0.381428, 0.301836, 0.579742,
+		0.307189, 0.538350, 0.552229, 0.562710, 0.570706, 0.582517,
+		0.582642, 0.586113, 0.589643, 0.717381, 0.380538, 0.313684,
+		0.576538, 0.564425, 0.308062, 0.540798, 0.551279, 0.559275,
+		0.571086, 0.582764, 0.583182, 0.586712, 0.714181, 0.395338,
+		0.338325, 0.591190, 0.579078, 0.567024, 0.315611, 0.544229,
+		0.549119, 0.560930, 0.572608, 0.584578, 0.585054, 0.711844,
+		0.395445, 0.369888, 0.602226, 0.590113, 0.578060, 0.570790,
+		0.323161, 0.541640, 0.553451, 0.565129, 0.577099, 0.589128,
+		0.712468, 0.391343, 0.397452, 0.610611, 0.598499, 0.586446,
+		0.575870, 0.568126, 0.322376, 0.538368, 0.550430, 0.562400,
+		0.574429, 0.710897, 0.394773, 0.434932, 0.610750, 0.610806,
+		0.598753, 0.588177, 0.580433, 0.564632, 0.327999, 0.546761,
+		0.558732, 0.570761, 0.700815, 0.382715, 0.467685, 0.614011,
+		0.610806, 0.610922, 0.600346, 0.592602, 0.577194, 0.573142,
+		0.331347, 0.550341, 0.562372, 0.689256, 0.383640, 0.493860,
+		0.617722, 0.614518, 0.611373, 0.612965, 0.605222, 0.589814,
+		0.585762, 0.576739, 0.337305, 0.565926, 0.693088, 0.384583,
+		0.497203, 0.621511, 0.618307, 0.615162, 0.613494, 0.617919,
+		0.602511, 0.598459, 0.589436, 0.593207, 0.343955, 0.696990,
+		0.280826, 0.525147, 0.763668, 0.760091, 0.756573, 0.754124,
+		0.754891, 0.753145, 0.742154, 0.729527, 0.733682, 0.737913,
+		0.367437,
+	},
+	{ // AQo
+		0.072638, 0.245537, 0.475079, 0.696616, 0.693676, 0.694236,
+		0.691440, 0.692599, 0.691432, 0.679400, 0.683947, 0.688526,
+		0.693136, 0.256061, 0.282108, 0.704124, 0.591818, 0.588600,
+		0.600721, 0.609176, 0.607314, 0.603468, 0.606683, 0.610286,
+		0.613952, 0.617681, 0.500000, 0.745178, 0.303014, 0.688453,
+		0.685505, 0.704704, 0.702075, 0.714332, 0.711308, 0.716036,
+		0.720854, 0.725734, 0.730678, 0.739052, 0.628816, 0.730599,
+		0.430770, 0.597250, 0.609458, 0.617906, 0.628568, 0.637213,
+		0.637201, 0.640810, 0.644483, 0.648218, 0.735779, 0.625295,
+		0.727326, 0.633839, 0.430511, 0.596841, 0.605289, 0.615951,
+		0.624596, 0.637205, 0.637587, 0.641259, 0.644994, 0.736818,
+		0.638200, 0.748544, 0.646837, 0.633238, 0.446138, 0.592919,
+		0.603581, 0.608938, 0.621547, 0.634550, 0.634995, 0.638730,
+		0.733682, 0.647189, 0.745545, 0.655826, 0.642227, 0.629409,
+		0.447223, 0.591159, 0.596511, 0.609120, 0.622123, 0.635189,
+		0.635697, 0.734972, 0.645276, 0.758708, 0.667121, 0.653522,
+		0.640704, 0.627295, 0.454680, 0.588399, 0.601008, 0.614011,
+		0.627077, 0.640206, 0.733630, 0.641091, 0.755345, 0.676445,
+		0.662845, 0.646445, 0.633036, 0.624414, 0.453622, 0.585560,
+		0.598563, 0.611629, 0.624758, 0.720527, 0.644453, 0.760270,
+		0.676295, 0.676286, 0.659886, 0.646477, 0.637854, 0.621269,
+		0.457337, 0.588465, 0.601532, 0.614661, 0.725429, 0.648378,
+		0.765434, 0.680220, 0.676699, 0.673889, 0.660480, 0.651857,
+		0.635272, 0.624408, 0.463197, 0.605220, 0.618352, 0.730373,
+		0.652378, 0.770673, 0.684220, 0.680699, 0.674377, 0.674558,
+		0.665935, 0.649350, 0.638487, 0.642474, 0.469754, 0.622111,
+		0.735363, 0.656457, 0.775989, 0.688299, 0.684778, 0.678456,
+		0.675125, 0.680093, 0.663508, 0.652644, 0.656632, 0.660695,
+		0.477017,
+	},
+	{ // KQo
+		0.128802, 0.244087, 0.244188, 0.381891, 0.381053, 0.397460,
+		0.403025, 0.402193, 0.402197, 0.397229, 0.398074, 0.398920,
+		0.399765, 0.252261, 0.086990, 0.475079, 0.697482, 0.694459,
+		0.694904, 0.698375, 0.695611, 0.692869, 0.693513, 0.698060,
+		0.702639, 0.707249, 0.254822, 0.500000, 0.314642, 0.698652,
+		0.695620, 0.699730, 0.714524, 0.711916, 0.709324, 0.710157,
+		0.714933, 0.719772, 0.724674, 0.402382, 0.739597, 0.741367,
+		0.435356, 0.609211, 0.619231, 0.628580, 0.637091, 0.646624,
+		0.643455, 0.647064, 0.650736, 0.654472, 0.401445, 0.736241,
+		0.738011, 0.646227, 0.435096, 0.606613, 0.615963, 0.624474,
+		0.634007, 0.643459, 0.643841, 0.647513, 0.651248, 0.418918,
+		0.737157, 0.742915, 0.656951, 0.643351, 0.447535, 0.603569,
+		0.609238, 0.615483, 0.624935, 0.637937, 0.638382, 0.642118,
+		0.425474, 0.741224, 0.759207, 0.666836, 0.653237, 0.640392,
+		0.461298, 0.607430, 0.604517, 0.613969, 0.626972, 0.640038,
+		0.640546, 0.424546, 0.738127, 0.756239, 0.675896, 0.662297,
+		0.646382, 0.644875, 0.462945, 0.592160, 0.601613, 0.614615,
+		0.627681, 0.640810, 0.424548, 0.735066, 0.753333, 0.686148,
+		0.672548, 0.653051, 0.641617, 0.628279, 0.465230, 0.589321,
+		0.602325, 0.615390, 0.628519, 0.419187, 0.735697, 0.754153,
+		0.682680, 0.682671, 0.663173, 0.651740, 0.638401, 0.625128,
+		0.469453, 0.590394, 0.603461, 0.616590, 0.420149, 0.740599,
+		0.759275, 0.686604, 0.683083, 0.677176, 0.665743, 0.652404,
+		0.639131, 0.626260, 0.475060, 0.607149, 0.620281, 0.421123,
+		0.745543, 0.764472, 0.690604, 0.687083, 0.677664, 0.679821,
+		0.666482, 0.653209, 0.640338, 0.644325, 0.481323, 0.624040,
+		0.422113, 0.750534, 0.769746, 0.694684, 0.691163, 0.681744,
+		0.680388, 0.680640, 0.667367, 0.654495, 0.658483, 0.662546,
+		0.488250,
+	},
+	{ // QQ
+		0.184529, 0.539515, 0.657251, 0.680251, 0.676632, 0.682808,
+		0.679614, 0.681186, 0.681193, 0.667093, 0.670727, 0.674360,
+		0.677994, 0.567577, 0.180652, 0.646614, 0.679157, 0.675537,
+		0.681714, 0.685780, 0.686002, 0.682382, 0.682129, 0.685763,
+		0.689396, 0.693030, 0.696986, 0.685358, 0.500000, 0.841728,
+		0.837742, 0.855389, 0.868565, 0.881742, 0.877756, 0.877505,
+		0.881504, 0.885504, 0.889504, 0.716497, 0.715406, 0.891795,
+		0.819665, 0.816699, 0.819336, 0.820686, 0.824576, 0.836479,
+		0.832175, 0.836233, 0.840290, 0.844348, 0.712551, 0.711460,
+		0.887439, 0.857699, 0.815675, 0.802898, 0.804249, 0.808138,
+		0.820042, 0.832182, 0.832189, 0.836247, 0.840304, 0.718971,
+		0.717880, 0.906549, 0.860444, 0.842911, 0.811903, 0.789950,
+		0.793839, 0.805222, 0.817362, 0.833813, 0.833820, 0.837878,
+		0.715488, 0.722034, 0.920788, 0.861781, 0.844248, 0.829051,
+		0.808701, 0.782594, 0.790658, 0.802798, 0.819250, 0.835701,
+		0.835708, 0.717186, 0.722288, 0.935045, 0.865768, 0.848235,
+		0.833038, 0.821136, 0.809250, 0.779414, 0.791554, 0.808006,
+		0.824457, 0.840908, 0.717186, 0.718346, 0.930691, 0.878514,
+		0.860981, 0.845214, 0.829730, 0.817842, 0.805270, 0.775121,
+		0.791573, 0.808025, 0.824476, 0.702028, 0.718079, 0.930421,
+		0.873877, 0.873872, 0.858105, 0.842621, 0.830732, 0.813204,
+		0.805192, 0.774884, 0.791337, 0.807788, 0.706006, 0.722057,
+		0.934804, 0.878284, 0.873909, 0.875670, 0.860186, 0.848297,
+		0.830769, 0.812974, 0.809592, 0.795410, 0.811864, 0.709997,
+		0.726047, 0.939195, 0.882703, 0.878328, 0.875720, 0.877763,
+		0.865875, 0.848347, 0.830551, 0.834958, 0.814016, 0.815945,
+		0.714004, 0.730055, 0.943597, 0.887139, 0.882764, 0.880156,
+		0.877830, 0.883469, 0.865941, 0.848145, 0.852552, 0.856972,
+		0.818474,
+	},
+	{ // QJs
+		0.191124, 0.364719, 0.300283, 0.301038, 0.419456, 0.435421,
+		0.438070, 0.445551, 0.445555, 0.439869, 0.440692, 0.441516,
+		0.442339, 0.389298, 0.177036, 0.291596, 0.292351, 0.412113,
+		0.428078, 0.440586, 0.446087, 0.445271, 0.444699, 0.445523,
+		0.446346, 0.447170, 0.311547, 0.301348, 0.158272, 0.500000,
+		0.697977, 0.699738, 0.700432, 0.704109, 0.701582, 0.698849,
+		0.703029, 0.707239, 0.711477, 0.312320, 0.302122, 0.524921,
+		0.369893, 0.703004, 0.704006, 0.707623, 0.721789, 0.719408,
+		0.716847, 0.721230, 0.725671, 0.730169, 0.446003, 0.438164,
+		0.737873, 0.743069, 0.469933, 0.644729, 0.651776, 0.661013,
+		0.669458, 0.678391, 0.678749, 0.682220, 0.685750, 0.462815,
+		0.454976, 0.740241, 0.744610, 0.673524, 0.480887, 0.634301,
+		0.643537, 0.651598, 0.660043, 0.672558, 0.672975, 0.676505,
+		0.465872, 0.468109, 0.741321, 0.748870, 0.680959, 0.662926,
+		0.490528, 0.633156, 0.638830, 0.644658, 0.657173, 0.669747,
+		0.670224, 0.474002, 0.474235, 0.745655, 0.764558, 0.690650,
+		0.672617, 0.662033, 0.503758, 0.637358, 0.634360, 0.646875,
+		0.659449, 0.672083, 0.474006, 0.473352, 0.742828, 0.761880,
+		0.699597, 0.681152, 0.668020, 0.666778, 0.505738, 0.622508,
+		0.635025, 0.647599, 0.660233, 0.467932, 0.472738, 0.739778,
+		0.759009, 0.708945, 0.689980, 0.674063, 0.663565, 0.651075,
+		0.508547, 0.620468, 0.633044, 0.645678, 0.468856, 0.473663,
+		0.744319, 0.763758, 0.709337, 0.703162, 0.687245, 0.676748,
+		0.664257, 0.648873, 0.513598, 0.636539, 0.649176, 0.469799,
+		0.474605, 0.748906, 0.768584, 0.713067, 0.703632, 0.700505,
+		0.690008, 0.677517, 0.662133, 0.665845, 0.519239, 0.652742,
+		0.470767, 0.475573, 0.753545, 0.773490, 0.716881, 0.707445,
+		0.701059, 0.703352, 0.690861, 0.675477, 0.679189, 0.682978,
+		0.525480,
+	},
+	{ // QTs
+		0.195167, 0.367769, 0.302954, 0.393525, 0.302489, 0.435783,
+		0.438432, 0.445529, 0.445533, 0.443679, 0.443683, 0.444507,
+		0.445330, 0.392522, 0.181079, 0.294347, 0.384132, 0.293802,
+		0.428440, 0.440948, 0.446066, 0.445249, 0.448510, 0.448514,
+		0.449337, 0.450161, 0.314495, 0.304380, 0.162258, 0.302023,
+		0.500000, 0.690774, 0.692087, 0.693065, 0.690498, 0.691432,
+		0.692248, 0.696417, 0.700614, 0.419526, 0.409580, 0.313133,
+		0.327520, 0.701685, 0.629574, 0.638278, 0.647121, 0.655566,
+		0.653693, 0.654052, 0.657522, 0.661052, 0.313902, 0.303704,
+		0.524921, 0.741741, 0.372761, 0.692108, 0.695725, 0.709891,
+		0.707510, 0.718721, 0.719779, 0.724219, 0.728718, 0.463090,
+		0.455251, 0.730670, 0.658286, 0.731657, 0.480120, 0.635758,
+		0.644692, 0.652754, 0.663109, 0.675399, 0.675816, 0.679346,
+		0.466148, 0.468385, 0.732418, 0.667425, 0.735916, 0.664314,
+		0.489761, 0.631694, 0.637368, 0.647724, 0.659525, 0.672099,
+		0.672576, 0.473859, 0.474093, 0.733802, 0.676707, 0.751597,
+		0.673688, 0.660318, 0.502567, 0.627070, 0.637426, 0.646610,
+		0.659185, 0.671818, 0.473864, 0.473210, 0.730934, 0.685654,
+		0.748919, 0.682223, 0.666306, 0.655802, 0.504547, 0.625575,
+		0.634760, 0.647334, 0.659968, 0.471980, 0.476786, 0.732008,
+		0.683749, 0.761064, 0.693130, 0.677213, 0.666709, 0.654219,
+		0.512722, 0.624337, 0.636914, 0.649547, 0.472011, 0.476817,
+		0.732862, 0.684134, 0.762168, 0.706078, 0.689640, 0.676351,
+		0.663861, 0.652978, 0.516810, 0.637355, 0.649992, 0.472954,
+		0.477760, 0.737409, 0.687864, 0.766993, 0.706547, 0.702900,
+		0.689611, 0.677121, 0.666238, 0.666683, 0.522450, 0.653558,
+		0.473921, 0.478727, 0.742007, 0.691678, 0.771900, 0.710361,
+		0.703453, 0.702955, 0.690464, 0.679582, 0.680027, 0.683816,
+		0.528692,
+	},
+	{ // Q9s
+		0.189168, 0.355496, 0.284642, 0.381645, 0.378502, 0.302718,
+		0.421924, 0.429021, 0.429025, 0.426788, 0.430624, 0.430627,
+		0.431451, 0.380003, 0.178031, 0.289944, 0.372332, 0.369189,
+		0.294031, 0.424440, 0.429558, 0.428741, 0.431618, 0.435454,
+		0.435458, 0.436281, 0.295296, 0.300270, 0.144611, 0.300262,
+		0.309226, 0.500000, 0.672273, 0.673964, 0.671357, 0.669348,
+		0.673831, 0.674635, 0.678793, 0.407405, 0.397540, 0.311898,
+		0.321343, 0.570109, 0.701484, 0.626719, 0.635562, 0.644007,
+		0.639029, 0.643219, 0.643637, 0.647167, 0.403947, 0.394081,
+		0.321240, 0.596889, 0.320326, 0.687780, 0.615478, 0.624321,
+		0.632766, 0.641072, 0.642555, 0.642973, 0.646503, 0.314749,
+		0.304551, 0.524921, 0.742023, 0.727082, 0.360320, 0.682226,
+		0.696393, 0.694011, 0.705222, 0.720052, 0.721168, 0.725666,
+		0.449153, 0.451390, 0.711269, 0.655612, 0.643667, 0.721339,
+		0.474164, 0.627783, 0.633457, 0.643510, 0.657222, 0.669571,
+		0.670048, 0.456865, 0.457098, 0.713419, 0.664894, 0.652949,
+		0.737019, 0.656213, 0.486969, 0.620542, 0.630595, 0.644307,
+		0.656168, 0.668801, 0.456869, 0.456215, 0.710511, 0.673840,
+		0.661896, 0.734341, 0.662200, 0.648911, 0.488950, 0.618744,
+		0.632457, 0.644318, 0.656951, 0.454567, 0.459374, 0.708371,
+		0.668623, 0.670690, 0.746478, 0.672790, 0.659502, 0.647011,
+		0.496700, 0.621546, 0.630791, 0.643425, 0.458789, 0.463595,
+		0.713349, 0.673199, 0.672383, 0.762599, 0.687297, 0.674008,
+		0.661518, 0.650108, 0.506155, 0.635367, 0.648004, 0.458838,
+		0.463644, 0.714210, 0.673662, 0.672847, 0.763779, 0.700322,
+		0.686512, 0.674022, 0.659827, 0.664773, 0.510831, 0.648517,
+		0.459806, 0.464612, 0.718767, 0.677476, 0.676660, 0.768686,
+		0.700875, 0.699856, 0.687366, 0.673171, 0.678117, 0.678639,
+		0.517073,
+	},
+	{ // Q8s
+		0.177264, 0.347169, 0.287059, 0.373318, 0.370175, 0.381793,
+		0.290338, 0.417636, 0.417640, 0.415402, 0.418855, 0.422691,
+		0.422694, 0.371555, 0.174142, 0.275794, 0.363548, 0.360405,
+		0.372023, 0.294407, 0.416448, 0.415631, 0.418508, 0.421960,
+		0.425796, 0.425800, 0.297925, 0.285476, 0.131435, 0.299568,
+		0.307913, 0.327727, 0.500000, 0.649808, 0.647160, 0.644824,
+		0.646364, 0.650835, 0.651628, 0.398951, 0.388588, 0.311686,
+		0.317277, 0.557602, 0.574080, 0.701108, 0.627099, 0.635544,
+		0.630077, 0.631651, 0.635901, 0.636377, 0.395493, 0.385130,
+		0.320358, 0.584026, 0.316260, 0.564646, 0.687461, 0.615917,
+		0.624362, 0.632668, 0.631046, 0.635296, 0.635772, 0.407724,
+		0.397361, 0.341476, 0.601346, 0.591258, 0.321879, 0.674620,
+		0.605846, 0.613907, 0.622213, 0.633875, 0.635417, 0.635894,
+		0.301278, 0.305426, 0.524922, 0.741993, 0.727112, 0.713240,
+		0.352434, 0.683205, 0.680824, 0.692035, 0.706864, 0.721751,
+		0.722925, 0.444850, 0.443581, 0.687578, 0.656250, 0.644365,
+		0.633788, 0.722760, 0.475093, 0.617452, 0.627506, 0.640916,
+		0.654687, 0.667095, 0.444855, 0.442698, 0.684629, 0.665197,
+		0.653312, 0.642323, 0.720082, 0.645650, 0.477073, 0.615654,
+		0.629066, 0.642837, 0.655245, 0.442553, 0.445856, 0.682130,
+		0.659458, 0.662105, 0.651117, 0.732219, 0.656240, 0.643750,
+		0.484823, 0.615537, 0.629311, 0.641230, 0.446356, 0.449659,
+		0.683896, 0.661243, 0.660487, 0.663509, 0.748332, 0.670429,
+		0.657939, 0.643744, 0.493853, 0.633887, 0.643192, 0.450596,
+		0.453899, 0.688880, 0.665897, 0.665140, 0.665281, 0.764529,
+		0.685013, 0.672523, 0.658328, 0.663268, 0.503897, 0.647840,
+		0.450670, 0.453973, 0.689751, 0.666444, 0.665688, 0.665828,
+		0.765791, 0.698122, 0.685632, 0.670916, 0.673071, 0.678095,
+		0.509174,
+	},
+	{ // Q7s
+		0.164863, 0.338110, 0.275646, 0.364887, 0.361743, 0.373361,
+		0.371041, 0.279891, 0.406272, 0.404034, 0.407487, 0.410939,
+		0.414775, 0.362085, 0.162238, 0.278194, 0.355162, 0.352019,
+		0.363637, 0.372100, 0.282027, 0.404187, 0.407064, 0.410516,
+		0.413968, 0.417804, 0.285668, 0.288084, 0.118258, 0.295891,
+		0.306935, 0.326036, 0.350192, 0.500000, 0.613440, 0.611855,
+		0.613067, 0.614596, 0.619056, 0.390391, 0.380071, 0.308225,
+		0.313210, 0.545094, 0.561573, 0.574682, 0.700732, 0.627081,
+		0.621614, 0.622699, 0.624332, 0.628641, 0.386933, 0.376613,
+		0.319839, 0.571163, 0.312193, 0.552138, 0.565248, 0.687085,
+		0.615899, 0.624205, 0.622094, 0.623727, 0.628036, 0.399164,
+		0.388844, 0.340191, 0.588483, 0.578395, 0.317813, 0.556983,
+		0.674301, 0.605503, 0.613809, 0.625471, 0.623908, 0.628217,
+		0.396697, 0.397704, 0.365975, 0.602212, 0.592124, 0.583403,
+		0.323381, 0.664478, 0.595551, 0.603856, 0.615518, 0.627240,
+		0.628841, 0.290032, 0.291958, 0.524926, 0.741967, 0.727086,
+		0.713273, 0.702668, 0.345381, 0.667780, 0.678992, 0.693821,
+		0.708708, 0.723653, 0.432971, 0.430620, 0.648387, 0.656553,
+		0.644668, 0.633739, 0.623263, 0.705973, 0.465235, 0.613091,
+		0.626502, 0.639971, 0.653801, 0.430669, 0.433778, 0.646707,
+		0.650815, 0.653462, 0.642532, 0.632056, 0.718110, 0.641049,
+		0.472986, 0.612485, 0.625956, 0.639787, 0.434473, 0.437582,
+		0.648114, 0.652079, 0.651322, 0.654925, 0.644449, 0.734223,
+		0.655238, 0.640522, 0.482016, 0.627915, 0.641749, 0.438294,
+		0.441404, 0.649885, 0.653941, 0.653185, 0.653384, 0.656919,
+		0.750412, 0.669505, 0.654789, 0.656944, 0.491635, 0.646396,
+		0.442559, 0.445668, 0.654880, 0.658679, 0.657922, 0.658122,
+		0.658774, 0.766690, 0.684172, 0.669456, 0.671612, 0.676629,
+		0.502279,
+	},
+	{ // Q6s
+		0.169035, 0.341744, 0.278369, 0.367395, 0.367357, 0.379464,
+		0.376760, 0.377147, 0.281765, 0.406805, 0.410257, 0.413710,
+		0.417546, 0.365944, 0.166282, 0.280535, 0.356850, 0.356813,
+		0.368919, 0.377382, 0.375062, 0.283479, 0.407189, 0.410641,
+		0.414094, 0.417546, 0.288692, 0.290676, 0.122244, 0.298418,
+		0.309502, 0.328643, 0.352840, 0.386560, 0.500000, 0.581131,
+		0.583332, 0.584533, 0.586050, 0.393076, 0.381869, 0.311000,
+		0.312989, 0.539592, 0.556454, 0.569564, 0.582734, 0.713113,
+		0.623934, 0.625019, 0.626164, 0.627856, 0.392906, 0.381698,
+		0.322655, 0.565598, 0.315387, 0.549489, 0.562599, 0.575768,
+		0.699466, 0.626525, 0.624798, 0.625942, 0.627634, 0.405658,
+		0.394451, 0.343048, 0.583312, 0.575749, 0.321528, 0.554823,
+		0.567992, 0.686209, 0.616513, 0.628175, 0.626123, 0.627815,
+		0.402780, 0.403312, 0.368874, 0.597040, 0.589477, 0.581259,
+		0.327097, 0.562509, 0.673484, 0.606665, 0.618327, 0.630048,
+		0.628544, 0.403205, 0.400853, 0.405069, 0.610837, 0.603274,
+		0.595056, 0.589271, 0.333297, 0.663721, 0.599496, 0.611158,
+		0.622879, 0.634659, 0.292097, 0.293550, 0.524936, 0.755385,
+		0.740504, 0.726172, 0.712403, 0.701868, 0.350949, 0.667102,
+		0.681933, 0.696820, 0.711765, 0.433504, 0.433801, 0.613547,
+		0.653185, 0.655832, 0.645296, 0.634930, 0.627416, 0.705167,
+		0.472219, 0.614431, 0.627903, 0.641431, 0.437308, 0.437605,
+		0.616033, 0.654449, 0.654086, 0.657689, 0.647322, 0.639808,
+		0.721279, 0.642431, 0.481249, 0.629373, 0.642904, 0.441130,
+		0.441427, 0.617445, 0.655790, 0.655427, 0.655626, 0.659792,
+		0.652278, 0.737468, 0.656697, 0.658331, 0.490867, 0.644935,
+		0.445388, 0.445273, 0.619227, 0.657737, 0.657374, 0.657573,
+		0.658335, 0.664832, 0.753739, 0.671048, 0.672682, 0.674915,
+		0.501088,
+	},
+	{ // Q5s
+		0.166462, 0.338844, 0.274110, 0.367549, 0.363679, 0.378402,
+		0.376187, 0.376574, 0.375837, 0.278979, 0.398243, 0.401696,
+		0.405148, 0.362729, 0.166535, 0.279731, 0.359848, 0.355979,
+		0.370702, 0.379654, 0.377333, 0.374191, 0.283715, 0.407462,
+		0.410914, 0.414367, 0.283964, 0.289843, 0.122495, 0.301151,
+		0.308568, 0.330652, 0.355176, 0.388145, 0.418869, 0.500000,
+		0.551960, 0.554387, 0.555576, 0.393076, 0.385025, 0.314010,
+		0.316869, 0.540164, 0.557026, 0.570136, 0.583305, 0.594809,
+		0.711424, 0.628459, 0.629603, 0.630806, 0.388971, 0.380920,
+		0.321775, 0.566169, 0.314076, 0.541763, 0.555257, 0.568426,
+		0.579930, 0.709676, 0.624707, 0.625852, 0.627055, 0.404490,
+		0.396439, 0.345374, 0.583882, 0.567901, 0.323111, 0.549566,
+		0.562735, 0.573750, 0.696419, 0.628084, 0.626416, 0.627620,
+		0.402133, 0.405821, 0.371559, 0.597611, 0.582023, 0.575937,
+		0.329201, 0.557636, 0.566034, 0.683694, 0.618620, 0.630341,
+		0.628349, 0.402557, 0.403363, 0.406935, 0.611408, 0.595820,
+		0.589734, 0.584342, 0.335401, 0.560612, 0.673932, 0.611451,
+		0.623172, 0.634952, 0.401678, 0.399912, 0.439865, 0.623398,
+		0.607810, 0.601203, 0.593026, 0.587309, 0.336858, 0.660524,
+		0.600513, 0.612234, 0.624014, 0.288994, 0.293830, 0.524956,
+		0.753555, 0.751627, 0.737295, 0.723527, 0.712992, 0.698356,
+		0.355866, 0.668084, 0.682974, 0.697919, 0.424467, 0.434129,
+		0.582085, 0.657982, 0.653995, 0.657598, 0.647625, 0.640111,
+		0.628469, 0.706186, 0.476988, 0.630835, 0.644366, 0.428289,
+		0.437951, 0.584836, 0.659323, 0.655336, 0.655929, 0.660095,
+		0.652581, 0.640939, 0.722375, 0.659748, 0.486607, 0.645908,
+		0.432135, 0.441797, 0.586259, 0.660748, 0.656762, 0.657354,
+		0.658116, 0.665134, 0.653493, 0.738645, 0.674099, 0.675810,
+		0.496827,
+	},
+	{ // Q4s
+		0.162404, 0.335433, 0.269647, 0.364137, 0.363320, 0.374212,
+		0.374613, 0.375489, 0.374751, 0.363015, 0.277528, 0.398225,
+		0.401677, 0.359119, 0.162478, 0.275308, 0.356437, 0.355620,
+		0.366511, 0.378080, 0.376248, 0.373105, 0.370486, 0.282264,
+		0.407444, 0.410896, 0.279146, 0.285067, 0.118496, 0.296971,
+		0.307752, 0.326169, 0.353636, 0.386933, 0.416668, 0.448040,
+		0.500000, 0.528734, 0.531386, 0.389473, 0.381422, 0.309505,
+		0.313235, 0.540160, 0.553190, 0.566684, 0.579853, 0.591357,
+		0.591483, 0.712876, 0.628779, 0.629983, 0.388583, 0.380532,
+		0.320924, 0.566165, 0.314068, 0.541760, 0.555253, 0.568422,
+		0.579926, 0.591604, 0.709676, 0.625848, 0.627051, 0.400167,
+		0.392117, 0.340631, 0.579944, 0.567891, 0.317912, 0.541265,
+		0.554818, 0.565833, 0.577511, 0.708317, 0.622882, 0.624086,
+		0.400577, 0.404265, 0.370022, 0.594067, 0.582013, 0.567509,
+		0.326896, 0.551804, 0.560202, 0.571880, 0.695592, 0.626808,
+		0.625199, 0.401522, 0.402328, 0.405757, 0.607863, 0.595810,
+		0.581699, 0.578439, 0.333618, 0.555163, 0.566842, 0.685830,
+		0.620022, 0.631802, 0.400643, 0.398877, 0.437609, 0.619853,
+		0.607800, 0.593168, 0.587123, 0.581799, 0.335075, 0.557650,
+		0.672424, 0.609084, 0.620864, 0.388129, 0.395994, 0.471341,
+		0.619853, 0.619968, 0.605336, 0.599292, 0.593968, 0.584123,
+		0.337666, 0.660862, 0.598262, 0.610042, 0.287448, 0.292284,
+		0.524992, 0.755181, 0.751664, 0.750285, 0.736516, 0.725981,
+		0.711345, 0.698718, 0.359625, 0.684457, 0.699407, 0.424623,
+		0.434285, 0.557104, 0.658478, 0.655333, 0.652302, 0.656468,
+		0.649347, 0.637706, 0.626182, 0.724001, 0.483388, 0.645085,
+		0.428469, 0.438131, 0.560126, 0.659903, 0.656758, 0.653727,
+		0.654883, 0.661901, 0.650259, 0.638736, 0.740271, 0.674965,
+		0.493608,
+	},
+	{ // Q3s
+		0.158346, 0.331962, 0.265126, 0.360666, 0.359850, 0.373794,
+		0.370363, 0.373856, 0.373607, 0.361871, 0.362694, 0.276077,
+		0.398206, 0.355447, 0.158420, 0.270827, 0.352966, 0.352149,
+		0.366094, 0.373830, 0.374616, 0.371961, 0.369342, 0.370165,
+		0.280813, 0.407366, 0.274266, 0.280228, 0.114496, 0.292761,
+		0.303583, 0.325365, 0.349165, 0.385404, 0.415467, 0.445613,
+		0.471266, 0.500000, 0.528298, 0.385807, 0.377756, 0.304967,
+		0.309601, 0.539337, 0.553187, 0.562848, 0.576401, 0.587905,
+		0.588030, 0.591501, 0.714327, 0.629159, 0.384917, 0.376866,
+		0.316428, 0.565320, 0.310435, 0.541756, 0.551417, 0.564970,
+		0.576474, 0.588152, 0.588569, 0.711127, 0.626228, 0.399716,
+		0.391665, 0.339790, 0.579941, 0.567887, 0.317905, 0.541261,
+		0.554814, 0.565829, 0.577507, 0.589477, 0.708317, 0.624082,
+		0.396191, 0.399879, 0.365289, 0.590129, 0.578075, 0.567499,
+		0.321698, 0.543502, 0.552284, 0.563962, 0.575932, 0.707490,
+		0.621665, 0.399903, 0.400709, 0.404230, 0.604318, 0.592265,
+		0.581689, 0.570011, 0.331314, 0.549331, 0.561010, 0.572980,
+		0.697728, 0.628268, 0.399545, 0.397779, 0.436441, 0.616308,
+		0.604255, 0.593158, 0.579088, 0.575896, 0.333292, 0.552202,
+		0.564173, 0.684322, 0.617714, 0.387031, 0.394896, 0.468835,
+		0.616308, 0.616424, 0.605326, 0.591257, 0.588065, 0.578614,
+		0.335883, 0.555399, 0.672763, 0.606892, 0.387955, 0.395821,
+		0.496608, 0.620020, 0.616875, 0.617946, 0.603877, 0.600685,
+		0.591233, 0.581817, 0.341565, 0.676590, 0.610449, 0.285927,
+		0.290763, 0.525053, 0.756832, 0.753314, 0.750346, 0.749530,
+		0.738995, 0.724359, 0.711732, 0.715887, 0.364065, 0.700906,
+		0.424803, 0.434402, 0.556841, 0.659058, 0.655913, 0.653724,
+		0.651256, 0.658274, 0.647026, 0.635502, 0.639273, 0.741922,
+		0.490390,
+	},
+	{ // Q2s
+		0.154289, 0.328432, 0.260547, 0.357136, 0.356320, 0.370264,
+		0.369887, 0.369547, 0.372020, 0.360726, 0.361550, 0.362373,
+		0.274626, 0.351712, 0.154362, 0.266289, 0.349436, 0.348619,
+		0.362564, 0.373353, 0.370306, 0.370269, 0.368138, 0.368962,
+		0.369785, 0.279361, 0.269322, 0.275326, 0.110496, 0.288523,
+		0.299386, 0.321207, 0.348372, 0.380944, 0.413950, 0.444424,
+		0.468614, 0.471702, 0.500000, 0.382078, 0.374027, 0.300399,
+		0.305968, 0.538513, 0.552363, 0.562844, 0.572565, 0.584452,
+		0.584578, 0.588049, 0.591579, 0.715778, 0.381187, 0.373137,
+		0.311901, 0.564475, 0.306801, 0.540932, 0.551414, 0.561134,
+		0.573022, 0.584700, 0.585117, 0.588647, 0.712578, 0.395987,
+		0.387936, 0.335304, 0.579095, 0.567042, 0.314271, 0.541257,
+		0.550978, 0.562377, 0.574055, 0.586025, 0.586501, 0.709768,
+		0.395677, 0.399365, 0.364457, 0.590125, 0.578071, 0.567495,
+		0.321691, 0.543499, 0.552281, 0.563959, 0.575929, 0.587958,
+		0.707489, 0.395454, 0.396260, 0.399507, 0.600380, 0.588327,
+		0.577751, 0.570001, 0.326115, 0.541030, 0.553092, 0.565062,
+		0.577091, 0.709626, 0.397991, 0.396097, 0.434924, 0.612764,
+		0.600710, 0.589613, 0.579078, 0.567468, 0.330988, 0.546369,
+		0.558341, 0.570370, 0.696220, 0.385933, 0.393735, 0.467677,
+		0.612764, 0.612879, 0.601782, 0.591247, 0.580030, 0.572711,
+		0.334100, 0.549950, 0.561981, 0.684661, 0.386857, 0.394659,
+		0.493852, 0.616475, 0.613330, 0.614401, 0.603867, 0.592650,
+		0.585330, 0.576308, 0.339782, 0.565535, 0.688493, 0.387800,
+		0.395602, 0.497195, 0.620264, 0.617119, 0.614930, 0.616564,
+		0.605347, 0.598028, 0.589005, 0.592776, 0.346116, 0.692395,
+		0.284439, 0.289275, 0.525147, 0.758516, 0.754998, 0.752029,
+		0.749624, 0.752042, 0.737406, 0.724779, 0.728934, 0.733165,
+		0.369201,
+	},
+	{ // AJo
+		0.076995, 0.248520, 0.250421, 0.475079, 0.686751, 0.688142,
+		0.685305, 0.683259, 0.685982, 0.670296, 0.674802, 0.679339,
+		0.683908, 0.259334, 0.286054, 0.563233, 0.702542, 0.592059,
+		0.604180, 0.612634, 0.607485, 0.607573, 0.607574, 0.611177,
+		0.614843, 0.618572, 0.260948, 0.597618, 0.283503, 0.687680,
+		0.580474, 0.592595, 0.601049, 0.609609, 0.606924, 0.606924,
+		0.610527, 0.614193, 0.617922, 0.500000, 0.743460, 0.727370,
+		0.305507, 0.672673, 0.691872, 0.689242, 0.701500, 0.713348,
+		0.714454, 0.719272, 0.724153, 0.729096, 0.728460, 0.628939,
+		0.616503, 0.713458, 0.429790, 0.599230, 0.607678, 0.618029,
+		0.627866, 0.640359, 0.640740, 0.644413, 0.648148, 0.730398,
+		0.641844, 0.629408, 0.734676, 0.635860, 0.445417, 0.592021,
+		0.602371, 0.612209, 0.624180, 0.637182, 0.637627, 0.641363,
+		0.727221, 0.650833, 0.638397, 0.731676, 0.644849, 0.628449,
+		0.446502, 0.589944, 0.599781, 0.611752, 0.624755, 0.637821,
+		0.638329, 0.725028, 0.645332, 0.647500, 0.744831, 0.655815,
+		0.639415, 0.626006, 0.453518, 0.591669, 0.600868, 0.613871,
+		0.626937, 0.640066, 0.728028, 0.645540, 0.644726, 0.757562,
+		0.666248, 0.649848, 0.636439, 0.627810, 0.458141, 0.589665,
+		0.602668, 0.615734, 0.628863, 0.710944, 0.645384, 0.644570,
+		0.758544, 0.679601, 0.662632, 0.649223, 0.637581, 0.625712,
+		0.460846, 0.589349, 0.602417, 0.615545, 0.715804, 0.649308,
+		0.648495, 0.763708, 0.680014, 0.676635, 0.663225, 0.651584,
+		0.639715, 0.625333, 0.466706, 0.606105, 0.619236, 0.720707,
+		0.653308, 0.652495, 0.768946, 0.684014, 0.677123, 0.677304,
+		0.665662, 0.653793, 0.639411, 0.643399, 0.473263, 0.622995,
+		0.725656, 0.657388, 0.656574, 0.774262, 0.688093, 0.681202,
+		0.677871, 0.679819, 0.667951, 0.653569, 0.657556, 0.661619,
+		0.480526,
+	},
+	{ // KJo
+		0.133177, 0.247069, 0.353301, 0.245778, 0.381617, 0.398024,
+		0.403195, 0.402364, 0.406302, 0.400493, 0.401338, 0.402183,
+		0.403028, 0.255533, 0.091347, 0.250391, 0.475079, 0.687575,
+		0.688893, 0.689219, 0.686413, 0.687562, 0.684552, 0.689057,
+		0.693595, 0.698164, 0.371184, 0.260403, 0.284594, 0.697878,
+		0.590420, 0.602460, 0.611412, 0.619929, 0.618131, 0.614975,
+		0.618578, 0.622244, 0.625973, 0.256540, 0.500000, 0.738139,
+		0.317134, 0.682788, 0.686898, 0.701692, 0.699084, 0.711363,
+		0.708575, 0.713351, 0.718190, 0.723092, 0.401936, 0.728964,
+		0.626800, 0.724143, 0.434375, 0.609003, 0.618041, 0.626552,
+		0.637277, 0.646612, 0.646994, 0.650666, 0.654402, 0.419409,
+		0.730820, 0.639615, 0.729046, 0.645973, 0.446814, 0.602360,
+		0.608028, 0.618753, 0.627567, 0.640570, 0.641015, 0.644750,
+		0.425536, 0.731474, 0.649085, 0.745339, 0.655536, 0.639109,
+		0.460148, 0.597063, 0.607788, 0.613835, 0.626838, 0.639904,
+		0.640412, 0.424601, 0.728329, 0.658138, 0.742362, 0.664590,
+		0.645093, 0.633659, 0.461782, 0.595430, 0.601472, 0.614475,
+		0.627541, 0.640670, 0.428997, 0.729612, 0.656292, 0.755549,
+		0.675951, 0.656454, 0.645020, 0.631675, 0.469750, 0.593426,
+		0.606429, 0.619495, 0.632624, 0.422702, 0.726262, 0.652818,
+		0.752427, 0.685986, 0.665919, 0.651473, 0.638128, 0.629571,
+		0.472961, 0.591278, 0.604346, 0.617475, 0.423663, 0.731122,
+		0.656742, 0.757549, 0.686398, 0.679922, 0.665475, 0.652131,
+		0.643574, 0.627184, 0.478569, 0.608034, 0.621165, 0.424638,
+		0.736024, 0.660742, 0.762746, 0.690398, 0.680410, 0.679553,
+		0.666209, 0.657652, 0.641262, 0.645250, 0.484832, 0.624924,
+		0.425628, 0.740973, 0.664821, 0.768020, 0.694478, 0.684489,
+		0.680121, 0.680366, 0.671810, 0.655420, 0.659408, 0.663471,
+		0.491758,
+	},
+	{ // QJo
+		0.150710, 0.335552, 0.260023, 0.260778, 0.391824, 0.408230,
+		0.410877, 0.418463, 0.418473, 0.412664, 0.413510, 0.414355,
+		0.415200, 0.351663, 0.135622, 0.250763, 0.251519, 0.384296,
+		0.400703, 0.413566, 0.419131, 0.418299, 0.417722, 0.418567,
+		0.419413, 0.420258, 0.269401, 0.258633, 0.108205, 0.475079,
+		0.686867, 0.688102, 0.688314, 0.691775, 0.689000, 0.685990,
+		0.690495, 0.695033, 0.699601, 0.272630, 0.261861, 0.500000,
+		0.332652, 0.693601, 0.694003, 0.697391, 0.712207, 0.709620,
+		0.706790, 0.711525, 0.716323, 0.721184, 0.412509, 0.404398,
+		0.727791, 0.735555, 0.442441, 0.621386, 0.628547, 0.637960,
+		0.646534, 0.655869, 0.656251, 0.659923, 0.663658, 0.429982,
+		0.421871, 0.729564, 0.736431, 0.658804, 0.454452, 0.610080,
+		0.619493, 0.627673, 0.636487, 0.649490, 0.649935, 0.653670,
+		0.433356, 0.435522, 0.730095, 0.740413, 0.666416, 0.646979,
+		0.464984, 0.608504, 0.614235, 0.620283, 0.633285, 0.646351,
+		0.646859, 0.441942, 0.442080, 0.734154, 0.756728, 0.676366,
+		0.656929, 0.645469, 0.479254, 0.612492, 0.609382, 0.622385,
+		0.635450, 0.648579, 0.441944, 0.441155, 0.731049, 0.753783,
+		0.685492, 0.665627, 0.651524, 0.650082, 0.481369, 0.597090,
+		0.610094, 0.623160, 0.636289, 0.435649, 0.440519, 0.727698,
+		0.750619, 0.695527, 0.675092, 0.657977, 0.646608, 0.633335,
+		0.484370, 0.594943, 0.608010, 0.621139, 0.436611, 0.441481,
+		0.732558, 0.755700, 0.695939, 0.689095, 0.671979, 0.660610,
+		0.647338, 0.630948, 0.489725, 0.611698, 0.624829, 0.437585,
+		0.442455, 0.737461, 0.760855, 0.699939, 0.689583, 0.686058,
+		0.674689, 0.661416, 0.645026, 0.649014, 0.495694, 0.628589,
+		0.438575, 0.443445, 0.742410, 0.766088, 0.704019, 0.693662,
+		0.686625, 0.688846, 0.675574, 0.659184, 0.663172, 0.667234,
+		0.502284,
+	},
+	{ // JJ
+		0.188519, 0.540388, 0.541155, 0.654936, 0.678264, 0.684440,
+		0.680725, 0.679402, 0.684601, 0.666875, 0.670508, 0.674142,
+		0.677776, 0.568509, 0.184642, 0.536698, 0.644298, 0.677169,
+		0.683346, 0.687412, 0.684218, 0.685790, 0.681911, 0.685544,
+		0.689178, 0.692811, 0.569230, 0.564644, 0.180335, 0.630107,
+		0.672480, 0.678657, 0.682723, 0.686790, 0.687011, 0.683131,
+		0.686765, 0.690399, 0.694032, 0.694493, 0.682866, 0.667348,
+		0.500000, 0.821830, 0.839397, 0.852534, 0.865710, 0.878887,
+		0.874643, 0.878643, 0.882643, 0.886643, 0.714366, 0.713276,
+		0.708275, 0.870255, 0.819856, 0.816996, 0.817935, 0.819285,
+		0.823175, 0.834818, 0.834825, 0.838882, 0.842940, 0.720786,
+		0.719695, 0.714695, 0.889281, 0.858014, 0.816083, 0.803115,
+		0.804465, 0.808354, 0.819997, 0.836449, 0.836456, 0.840513,
+		0.716733, 0.723849, 0.718848, 0.903478, 0.858922, 0.843155,
+		0.812312, 0.789901, 0.793791, 0.804912, 0.821363, 0.837815,
+		0.837822, 0.715266, 0.720367, 0.723004, 0.917718, 0.860260,
+		0.844493, 0.829008, 0.809113, 0.782546, 0.790350, 0.806801,
+		0.823253, 0.839704, 0.720908, 0.722069, 0.723261, 0.931976,
+		0.864250, 0.848484, 0.832999, 0.821098, 0.809668, 0.779108,
+		0.795560, 0.812012, 0.828463, 0.701797, 0.717848, 0.719040,
+		0.927343, 0.876716, 0.860950, 0.844896, 0.829413, 0.817527,
+		0.805413, 0.774820, 0.791273, 0.807725, 0.705776, 0.721826,
+		0.723018, 0.931726, 0.876754, 0.878515, 0.862461, 0.846978,
+		0.835093, 0.812916, 0.809813, 0.795347, 0.811800, 0.709766,
+		0.725817, 0.727009, 0.936117, 0.881173, 0.878565, 0.880038,
+		0.864555, 0.852670, 0.830493, 0.834900, 0.814238, 0.815882,
+		0.713773, 0.729824, 0.731016, 0.940519, 0.885609, 0.883001,
+		0.880105, 0.882149, 0.870264, 0.848087, 0.852494, 0.856913,
+		0.818695,
+	},
+	{ // JTs
+		0.211605, 0.379922, 0.377976, 0.314852, 0.316251, 0.445277,
+		0.447542, 0.452554, 0.460855, 0.455169, 0.455173, 0.455996,
+		0.456820, 0.405139, 0.197517, 0.366624, 0.306245, 0.307564,
+		0.437934, 0.450441, 0.453090, 0.460571, 0.460000, 0.460003,
+		0.460827, 0.461650, 0.402750, 0.390789, 0.183301, 0.296996,
+		0.298315, 0.429891, 0.442398, 0.454906, 0.460408, 0.459836,
+		0.459840, 0.460663, 0.461487, 0.327327, 0.317212, 0.306399,
+		0.178170, 0.500000, 0.689698, 0.690931, 0.691800, 0.695465,
+		0.692691, 0.693507, 0.697676, 0.701874, 0.328764, 0.318566,
+		0.307753, 0.524921, 0.386921, 0.701062, 0.701751, 0.705384,
+		0.719569, 0.716968, 0.717986, 0.722386, 0.726844, 0.472815,
+		0.464976, 0.456375, 0.729110, 0.741168, 0.487729, 0.647017,
+		0.654123, 0.663418, 0.671679, 0.683968, 0.684386, 0.687916,
+		0.475461, 0.478110, 0.469508, 0.730776, 0.742239, 0.675846,
+		0.496919, 0.638792, 0.648088, 0.655964, 0.667765, 0.680340,
+		0.680816, 0.480936, 0.481170, 0.482644, 0.732049, 0.746520,
+		0.683342, 0.667482, 0.506998, 0.637768, 0.643257, 0.652441,
+		0.665016, 0.677649, 0.489958, 0.489304, 0.488774, 0.736376,
+		0.762230, 0.693097, 0.677237, 0.666714, 0.520631, 0.641848,
+		0.642207, 0.654782, 0.667415, 0.483883, 0.488689, 0.488160,
+		0.733285, 0.759318, 0.701850, 0.685578, 0.672507, 0.671329,
+		0.523242, 0.627650, 0.640227, 0.652860, 0.483914, 0.488720,
+		0.488190, 0.734140, 0.760381, 0.714798, 0.698005, 0.682149,
+		0.671715, 0.656331, 0.527093, 0.640668, 0.653305, 0.484857,
+		0.489663, 0.489133, 0.738686, 0.765166, 0.715267, 0.711265,
+		0.695409, 0.684975, 0.669591, 0.670036, 0.532458, 0.656871,
+		0.485824, 0.490631, 0.490101, 0.743284, 0.770032, 0.719081,
+		0.711818, 0.708753, 0.698319, 0.682935, 0.683379, 0.687169,
+		0.538384,
+	},
+	{ // J9s
+		0.205605, 0.367650, 0.366006, 0.296540, 0.389802, 0.316423,
+		0.431034, 0.436046, 0.443964, 0.438278, 0.442114, 0.442117,
+		0.442941, 0.392620, 0.194469, 0.356784, 0.301842, 0.380489,
+		0.307736, 0.433933, 0.436582, 0.443680, 0.443108, 0.446944,
+		0.446948, 0.447771, 0.390542, 0.380769, 0.180664, 0.295994,
+		0.370426, 0.298516, 0.425920, 0.438427, 0.443546, 0.442974,
+		0.446810, 0.446813, 0.447637, 0.308128, 0.313102, 0.305997,
+		0.160603, 0.310302, 0.500000, 0.671158, 0.672779, 0.673932,
+		0.671117, 0.675600, 0.676405, 0.680562, 0.415532, 0.405666,
+		0.394986, 0.321947, 0.324945, 0.695590, 0.624820, 0.633583,
+		0.642485, 0.650746, 0.652229, 0.652647, 0.656176, 0.329549,
+		0.319351, 0.308567, 0.524921, 0.735404, 0.374401, 0.686822,
+		0.690456, 0.704640, 0.702040, 0.716829, 0.717905, 0.722363,
+		0.458467, 0.461115, 0.452543, 0.709669, 0.653225, 0.726151,
+		0.481282, 0.634333, 0.643326, 0.651202, 0.664914, 0.677263,
+		0.677740, 0.463942, 0.464175, 0.465678, 0.711749, 0.662425,
+		0.730431, 0.662795, 0.491361, 0.630389, 0.635878, 0.649590,
+		0.661451, 0.674084, 0.472545, 0.471891, 0.471391, 0.713328,
+		0.671771, 0.746134, 0.672233, 0.658925, 0.504570, 0.625643,
+		0.639356, 0.648600, 0.661233, 0.466470, 0.471277, 0.470777,
+		0.710196, 0.680524, 0.743222, 0.680574, 0.664719, 0.654278,
+		0.507181, 0.624311, 0.633556, 0.646190, 0.470692, 0.475498,
+		0.474998, 0.715174, 0.682217, 0.759302, 0.695081, 0.679225,
+		0.668785, 0.652879, 0.516399, 0.638132, 0.650769, 0.470741,
+		0.475547, 0.475047, 0.716034, 0.682681, 0.760441, 0.708106,
+		0.691729, 0.678504, 0.662599, 0.667545, 0.520799, 0.651282,
+		0.471709, 0.476515, 0.476015, 0.720591, 0.686494, 0.765307,
+		0.708659, 0.705073, 0.691848, 0.675943, 0.680889, 0.681411,
+		0.526726,
+	},
+	{ // J8s
+		0.193701, 0.359323, 0.357679, 0.298957, 0.381475, 0.393034,
+		0.304043, 0.424661, 0.432578, 0.426892, 0.430344, 0.434180,
+		0.434184, 0.384172, 0.190579, 0.347607, 0.287692, 0.371705,
+		0.383264, 0.308112, 0.423472, 0.430570, 0.429998, 0.433450,
+		0.437286, 0.437290, 0.382094, 0.371420, 0.179314, 0.292377,
+		0.361722, 0.373281, 0.298892, 0.425318, 0.430436, 0.429864,
+		0.433316, 0.437152, 0.437156, 0.310758, 0.298308, 0.302609,
+		0.147466, 0.309069, 0.328842, 0.500000, 0.648733, 0.650968,
+		0.648113, 0.649653, 0.654125, 0.654918, 0.407078, 0.396715,
+		0.386116, 0.321148, 0.320878, 0.571967, 0.695243, 0.625179,
+		0.634081, 0.642342, 0.640719, 0.644969, 0.645446, 0.419246,
+		0.408883, 0.398284, 0.342226, 0.598739, 0.326419, 0.682155,
+		0.614819, 0.623721, 0.631982, 0.643644, 0.645186, 0.645663,
+		0.316078, 0.320226, 0.309442, 0.524922, 0.735403, 0.721250,
+		0.366318, 0.677143, 0.691328, 0.688728, 0.703517, 0.718364,
+		0.719497, 0.451927, 0.450657, 0.452161, 0.686022, 0.653841,
+		0.642953, 0.716043, 0.479445, 0.627245, 0.632734, 0.646144,
+		0.659915, 0.672323, 0.460530, 0.458373, 0.457873, 0.688765,
+		0.663186, 0.652299, 0.731746, 0.655606, 0.492653, 0.619882,
+		0.633293, 0.647064, 0.658984, 0.454456, 0.457759, 0.457259,
+		0.685592, 0.671939, 0.661052, 0.728833, 0.661400, 0.648174,
+		0.495264, 0.618247, 0.632021, 0.643940, 0.458259, 0.461562,
+		0.461062, 0.687357, 0.670321, 0.673445, 0.744905, 0.675589,
+		0.662364, 0.646458, 0.504058, 0.636597, 0.645902, 0.462499,
+		0.465802, 0.465302, 0.692341, 0.674974, 0.675216, 0.761062,
+		0.690173, 0.676948, 0.661042, 0.665983, 0.513825, 0.650550,
+		0.462573, 0.465876, 0.465376, 0.693212, 0.675522, 0.675763,
+		0.762282, 0.703282, 0.689535, 0.673630, 0.675786, 0.680809,
+		0.518788,
+	},
+	{ // J7s
+		0.181301, 0.350264, 0.347198, 0.287544, 0.373044, 0.384603,
+		0.381899, 0.293596, 0.421210, 0.415524, 0.418977, 0.422429,
+		0.426265, 0.374702, 0.178675, 0.339221, 0.290092, 0.363319,
+		0.374878, 0.383341, 0.295732, 0.419125, 0.418553, 0.422006,
+		0.425458, 0.429294, 0.371432, 0.362909, 0.175424, 0.278211,
+		0.352879, 0.364438, 0.372901, 0.299268, 0.417266, 0.416695,
+		0.420147, 0.423599, 0.427435, 0.298500, 0.300916, 0.287793,
+		0.134290, 0.308200, 0.327221, 0.351267, 0.500000, 0.620417,
+		0.617522, 0.618734, 0.620263, 0.624723, 0.398518, 0.388198,
+		0.377101, 0.320745, 0.316811, 0.559460, 0.572540, 0.694867,
+		0.625618, 0.633879, 0.631768, 0.633401, 0.637710, 0.410686,
+		0.400366, 0.389270, 0.341014, 0.585876, 0.322352, 0.563987,
+		0.681837, 0.615318, 0.623578, 0.635240, 0.633677, 0.637986,
+		0.407808, 0.409226, 0.398130, 0.366686, 0.599573, 0.590542,
+		0.327802, 0.669053, 0.605306, 0.613182, 0.624845, 0.636566,
+		0.638167, 0.304831, 0.306757, 0.310320, 0.524926, 0.735376,
+		0.721283, 0.707455, 0.358911, 0.678142, 0.675543, 0.690332,
+		0.705179, 0.720084, 0.448647, 0.446296, 0.444293, 0.655963,
+		0.654543, 0.643715, 0.633180, 0.717490, 0.480776, 0.616792,
+		0.630204, 0.643672, 0.657503, 0.442572, 0.445681, 0.443678,
+		0.652749, 0.663296, 0.652468, 0.641521, 0.714578, 0.644913,
+		0.483388, 0.615158, 0.628629, 0.642459, 0.446376, 0.449485,
+		0.447482, 0.654156, 0.661156, 0.664860, 0.653913, 0.730649,
+		0.659102, 0.643197, 0.492181, 0.630588, 0.644421, 0.450198,
+		0.453307, 0.451304, 0.655926, 0.663019, 0.663319, 0.666383,
+		0.746797, 0.673369, 0.657464, 0.659619, 0.501524, 0.649069,
+		0.454462, 0.457571, 0.455568, 0.660921, 0.667756, 0.668057,
+		0.668238, 0.763035, 0.688037, 0.672131, 0.674287, 0.679304,
+		0.511853,
+	},
+	{ // J6s
+		0.169028, 0.341740, 0.338899, 0.276496, 0.365373, 0.376932,
+		0.374228, 0.374615, 0.283571, 0.406742, 0.410195, 0.413647,
+		0.417483, 0.365940, 0.166275, 0.330103, 0.278662, 0.354829,
+		0.366388, 0.374851, 0.372530, 0.285285, 0.407126, 0.410579,
+		0.414031, 0.417483, 0.362787, 0.353376, 0.163521, 0.280592,
+		0.344434, 0.355993, 0.364456, 0.372919, 0.286887, 0.405191,
+		0.408643, 0.412095, 0.415548, 0.286652, 0.288637, 0.290380,
+		0.121113, 0.304535, 0.326068, 0.349032, 0.379583, 0.500000,
+		0.579258, 0.581459, 0.582660, 0.584177, 0.390782, 0.379574,
+		0.368521, 0.317294, 0.312745, 0.546952, 0.560032, 0.573202,
+		0.694491, 0.625416, 0.623305, 0.624449, 0.626141, 0.402950,
+		0.391743, 0.380689, 0.340303, 0.573013, 0.318286, 0.551479,
+		0.564648, 0.681460, 0.615115, 0.626777, 0.624725, 0.626417,
+		0.400072, 0.400603, 0.389550, 0.364810, 0.586710, 0.577679,
+		0.323736, 0.556443, 0.668735, 0.604778, 0.616441, 0.628162,
+		0.626658, 0.400490, 0.398139, 0.398412, 0.397556, 0.600500,
+		0.591469, 0.582790, 0.329766, 0.658972, 0.594887, 0.606549,
+		0.618271, 0.630051, 0.294064, 0.295517, 0.296858, 0.524936,
+		0.735356, 0.721263, 0.707494, 0.696951, 0.352303, 0.662504,
+		0.677295, 0.692142, 0.707046, 0.433438, 0.433735, 0.431537,
+		0.611508, 0.654652, 0.643824, 0.632936, 0.622521, 0.700474,
+		0.471550, 0.612594, 0.626065, 0.639593, 0.437241, 0.437538,
+		0.435341, 0.613994, 0.652512, 0.656217, 0.645329, 0.634914,
+		0.716546, 0.640496, 0.480344, 0.627536, 0.641067, 0.441063,
+		0.441360, 0.439163, 0.615406, 0.653854, 0.654154, 0.657799,
+		0.647384, 0.732694, 0.654763, 0.656397, 0.489687, 0.643098,
+		0.445321, 0.445207, 0.443009, 0.617188, 0.655800, 0.656101,
+		0.656342, 0.659938, 0.748924, 0.669113, 0.670747, 0.672980,
+		0.499592,
+	},
+	{ // J5s
+		0.170505, 0.341894, 0.339053, 0.275562, 0.364402, 0.379067,
+		0.376851, 0.376855, 0.379647, 0.282237, 0.401234, 0.404687,
+		0.408139, 0.365952, 0.170579, 0.333101, 0.281182, 0.356702,
+		0.371366, 0.380318, 0.377614, 0.378001, 0.286973, 0.410453,
+		0.413905, 0.417358, 0.362799, 0.356545, 0.167825, 0.283153,
+		0.346307, 0.360971, 0.369923, 0.378386, 0.376066, 0.288576,
+		0.408517, 0.411970, 0.415422, 0.285546, 0.291425, 0.293210,
+		0.125357, 0.307309, 0.328883, 0.351887, 0.382478, 0.420742,
+		0.500000, 0.551960, 0.554387, 0.555576, 0.389620, 0.381570,
+		0.370516, 0.320338, 0.312784, 0.541206, 0.554670, 0.567839,
+		0.581067, 0.706635, 0.625921, 0.627066, 0.628269, 0.405076,
+		0.397026, 0.385972, 0.343389, 0.567187, 0.321740, 0.548586,
+		0.561755, 0.574983, 0.693604, 0.629394, 0.627726, 0.628929,
+		0.402719, 0.406407, 0.395354, 0.367937, 0.581278, 0.574772,
+		0.327711, 0.554038, 0.567267, 0.680406, 0.619441, 0.631162,
+		0.629170, 0.402726, 0.403531, 0.404217, 0.400725, 0.595068,
+		0.588562, 0.580385, 0.333742, 0.561844, 0.667742, 0.609655,
+		0.621376, 0.633156, 0.405726, 0.403960, 0.401763, 0.441920,
+		0.608928, 0.602422, 0.594245, 0.588522, 0.340369, 0.658041,
+		0.602549, 0.614270, 0.626050, 0.292543, 0.297379, 0.298720,
+		0.524956, 0.748524, 0.734431, 0.720143, 0.706436, 0.695964,
+		0.358545, 0.661995, 0.676845, 0.691750, 0.427621, 0.437283,
+		0.435086, 0.582077, 0.655189, 0.658893, 0.648399, 0.638094,
+		0.630643, 0.699863, 0.479298, 0.629818, 0.643349, 0.431443,
+		0.441105, 0.438908, 0.584828, 0.656530, 0.657224, 0.660869,
+		0.650564, 0.643113, 0.716011, 0.658655, 0.488641, 0.644891,
+		0.435290, 0.444952, 0.442754, 0.586250, 0.657956, 0.658650,
+		0.658890, 0.663117, 0.655667, 0.732241, 0.673005, 0.674717,
+		0.498545,
+	},
+	{ // J4s
+		0.166448, 0.338483, 0.335642, 0.271098, 0.364044, 0.374876,
+		0.375278, 0.375770, 0.378562, 0.366006, 0.280786, 0.401216,
+		0.404668, 0.362343, 0.166521, 0.329689, 0.276759, 0.356343,
+		0.367176, 0.378744, 0.376529, 0.376916, 0.373477, 0.285522,
+		0.410434, 0.413887, 0.359190, 0.352936, 0.163767, 0.278770,
+		0.345948, 0.356781, 0.368349, 0.377301, 0.374981, 0.371541,
+		0.287124, 0.408499, 0.411951, 0.280728, 0.286649, 0.288475,
+		0.121357, 0.306493, 0.324400, 0.350347, 0.381266, 0.418541,
+		0.448040, 0.500000, 0.528734, 0.531386, 0.389232, 0.381181,
+		0.370128, 0.319486, 0.312777, 0.541202, 0.554666, 0.567835,
+		0.581064, 0.592819, 0.706634, 0.627062, 0.628265, 0.400754,
+		0.392703, 0.381650, 0.338646, 0.567177, 0.316541, 0.540285,
+		0.553837, 0.567066, 0.578821, 0.705502, 0.624192, 0.625395,
+		0.401163, 0.404851, 0.393798, 0.366400, 0.581268, 0.566343,
+		0.325407, 0.548206, 0.561435, 0.572701, 0.692304, 0.627628,
+		0.626020, 0.401691, 0.402496, 0.403182, 0.399547, 0.595058,
+		0.580527, 0.574482, 0.331959, 0.556396, 0.565046, 0.679640,
+		0.618226, 0.630006, 0.404691, 0.402925, 0.400728, 0.439664,
+		0.608918, 0.594387, 0.588342, 0.583012, 0.338586, 0.559686,
+		0.669941, 0.611120, 0.622900, 0.391283, 0.399148, 0.396951,
+		0.471333, 0.621162, 0.606631, 0.600066, 0.591951, 0.586297,
+		0.340387, 0.655055, 0.597245, 0.609025, 0.290997, 0.295833,
+		0.297174, 0.524992, 0.748561, 0.747421, 0.733132, 0.719425,
+		0.708954, 0.692681, 0.362029, 0.678328, 0.693237, 0.427777,
+		0.437439, 0.435242, 0.557096, 0.656527, 0.653597, 0.657242,
+		0.647330, 0.639879, 0.625089, 0.717637, 0.485422, 0.644068,
+		0.431624, 0.441286, 0.439088, 0.560118, 0.657952, 0.655022,
+		0.655657, 0.659884, 0.652433, 0.637642, 0.733867, 0.673872,
+		0.495327,
+	},
+	{ // J3s
+		0.162390, 0.335012, 0.332171, 0.266577, 0.360573, 0.374458,
+		0.371028, 0.374137, 0.377418, 0.364861, 0.365685, 0.279335,
+		0.401197, 0.358671, 0.162464, 0.326218, 0.272278, 0.352873,
+		0.366758, 0.374494, 0.374896, 0.375772, 0.372332, 0.373156,
+		0.284070, 0.410357, 0.355517, 0.349264, 0.159710, 0.274329,
+		0.342478, 0.356363, 0.364099, 0.375668, 0.373836, 0.370397,
+		0.371221, 0.285673, 0.408421, 0.275847, 0.281810, 0.283677,
+		0.117357, 0.302324, 0.323595, 0.345875, 0.379737, 0.417340,
+		0.445613, 0.471266, 0.500000, 0.528298, 0.385566, 0.377515,
+		0.366462, 0.314990, 0.309143, 0.541198, 0.550830, 0.564383,
+		0.577611, 0.589366, 0.589784, 0.708086, 0.627442, 0.400302,
+		0.392252, 0.381198, 0.337805, 0.567173, 0.316534, 0.540281,
+		0.553834, 0.567062, 0.578817, 0.590787, 0.705502, 0.625392,
+		0.396777, 0.400466, 0.389412, 0.361668, 0.577330, 0.566333,
+		0.320209, 0.539905, 0.553517, 0.564783, 0.576753, 0.704202,
+		0.622486, 0.400072, 0.400877, 0.401563, 0.398020, 0.591513,
+		0.580517, 0.566054, 0.329655, 0.550564, 0.559214, 0.571183,
+		0.691538, 0.626472, 0.403593, 0.401827, 0.399630, 0.438496,
+		0.605373, 0.594377, 0.580307, 0.577109, 0.336803, 0.554238,
+		0.566209, 0.681839, 0.619750, 0.390185, 0.398050, 0.395853,
+		0.468827, 0.617618, 0.606622, 0.592031, 0.586048, 0.580787,
+		0.338604, 0.554381, 0.666956, 0.605875, 0.391110, 0.398975,
+		0.396778, 0.496600, 0.618069, 0.619241, 0.604650, 0.598667,
+		0.593407, 0.580724, 0.344011, 0.670783, 0.609432, 0.289476,
+		0.294312, 0.295653, 0.525053, 0.750211, 0.747482, 0.746147,
+		0.732440, 0.721968, 0.705695, 0.709850, 0.366153, 0.694737,
+		0.427958, 0.437557, 0.435359, 0.556833, 0.657107, 0.655019,
+		0.652029, 0.656257, 0.649199, 0.634409, 0.638179, 0.735518,
+		0.492109,
+	},
+	{ // J2s
+		0.158332, 0.331482, 0.328641, 0.261998, 0.357043, 0.370928,
+		0.370551, 0.369828, 0.375831, 0.363717, 0.364541, 0.365364,
+		0.277883, 0.354936, 0.158406, 0.322688, 0.267740, 0.349343,
+		0.363228, 0.374018, 0.370587, 0.374080, 0.371129, 0.371953,
+		0.372776, 0.282619, 0.351782, 0.345528, 0.155652, 0.269831,
+		0.338948, 0.352833, 0.363623, 0.371359, 0.372144, 0.369194,
+		0.370017, 0.370841, 0.284222, 0.270904, 0.276908, 0.278816,
+		0.113357, 0.298126, 0.319438, 0.345082, 0.375277, 0.415823,
+		0.444424, 0.468614, 0.471702, 0.500000, 0.381837, 0.373786,
+		0.362733, 0.310463, 0.305509, 0.540375, 0.550827, 0.560547,
+		0.574159, 0.585914, 0.586331, 0.589861, 0.709537, 0.396573,
+		0.388523, 0.377469, 0.333319, 0.566328, 0.312901, 0.540277,
+		0.549998, 0.563610, 0.575365, 0.587334, 0.587811, 0.706953,
+		0.396263, 0.399951, 0.388898, 0.360836, 0.577326, 0.566330,
+		0.320201, 0.539901, 0.553513, 0.564780, 0.576750, 0.588778,
+		0.704202, 0.395623, 0.396428, 0.397114, 0.393297, 0.587575,
+		0.576579, 0.566044, 0.324456, 0.542262, 0.551296, 0.563266,
+		0.575295, 0.703436, 0.402039, 0.400145, 0.397948, 0.436979,
+		0.601828, 0.590832, 0.580297, 0.568681, 0.334498, 0.548406,
+		0.560377, 0.572406, 0.693737, 0.389087, 0.396889, 0.394692,
+		0.467669, 0.614073, 0.603077, 0.592021, 0.578013, 0.574884,
+		0.336821, 0.548933, 0.560964, 0.678854, 0.390012, 0.397814,
+		0.395617, 0.493844, 0.614524, 0.615697, 0.604641, 0.590632,
+		0.587504, 0.575214, 0.342228, 0.564518, 0.682686, 0.390955,
+		0.398757, 0.396560, 0.497187, 0.618313, 0.616225, 0.617338,
+		0.603330, 0.600201, 0.587912, 0.591682, 0.348247, 0.686588,
+		0.287988, 0.292824, 0.294165, 0.525147, 0.751895, 0.749165,
+		0.746240, 0.745487, 0.735015, 0.718743, 0.722898, 0.727128,
+		0.370934,
+	},
+	{ // ATo
+		0.081352, 0.251503, 0.253404, 0.260557, 0.475079, 0.678647,
+		0.675768, 0.673363, 0.672881, 0.661086, 0.661937, 0.666433,
+		0.670960, 0.262606, 0.290000, 0.564124, 0.563785, 0.700961,
+		0.607638, 0.616092, 0.610422, 0.607744, 0.611679, 0.612067,
+		0.615733, 0.619462, 0.264221, 0.598555, 0.287449, 0.553997,
+		0.686098, 0.596053, 0.604507, 0.613067, 0.607094, 0.611029,
+		0.611417, 0.615083, 0.618813, 0.271540, 0.598064, 0.587491,
+		0.285634, 0.671236, 0.584468, 0.592922, 0.601482, 0.609218,
+		0.610380, 0.610768, 0.614434, 0.618163, 0.500000, 0.741742,
+		0.725652, 0.709563, 0.308672, 0.679040, 0.676410, 0.688668,
+		0.700515, 0.716493, 0.717690, 0.722571, 0.727514, 0.720287,
+		0.645488, 0.633052, 0.620617, 0.720808, 0.444696, 0.593889,
+		0.604240, 0.613766, 0.627450, 0.640336, 0.640781, 0.644516,
+		0.717068, 0.654477, 0.642041, 0.629606, 0.717808, 0.630502,
+		0.445781, 0.591812, 0.601338, 0.615022, 0.627908, 0.640974,
+		0.641482, 0.714483, 0.648406, 0.651145, 0.638709, 0.730963,
+		0.641468, 0.628058, 0.452796, 0.590454, 0.604138, 0.616503,
+		0.629569, 0.642698, 0.714001, 0.645595, 0.644782, 0.646950,
+		0.743685, 0.651572, 0.638162, 0.626521, 0.456979, 0.592935,
+		0.602528, 0.615594, 0.628723, 0.701260, 0.649833, 0.649019,
+		0.648206, 0.760760, 0.666035, 0.652625, 0.640984, 0.629109,
+		0.465365, 0.593454, 0.606521, 0.619650, 0.702139, 0.650239,
+		0.649425, 0.648612, 0.761982, 0.679950, 0.666541, 0.654329,
+		0.639441, 0.629776, 0.470214, 0.606989, 0.620120, 0.707000,
+		0.654239, 0.653425, 0.652612, 0.767220, 0.680438, 0.680619,
+		0.668407, 0.653519, 0.643854, 0.644323, 0.476772, 0.623879,
+		0.711907, 0.658318, 0.657505, 0.656691, 0.772536, 0.684517,
+		0.681186, 0.682565, 0.667677, 0.658011, 0.658481, 0.662544,
+		0.484035,
+	},
+	{ // KTo
+		0.137552, 0.250052, 0.356564, 0.353337, 0.247368, 0.398528,
+		0.403700, 0.402869, 0.406413, 0.404538, 0.404542, 0.405387,
+		0.406233, 0.258806, 0.095704, 0.253455, 0.260567, 0.475079,
+		0.679510, 0.680465, 0.677618, 0.675562, 0.676442, 0.677293,
+		0.681789, 0.686316, 0.374705, 0.263759, 0.288540, 0.561836,
+		0.696296, 0.605919, 0.614870, 0.623387, 0.618302, 0.619080,
+		0.619468, 0.623134, 0.626863, 0.371061, 0.271036, 0.595602,
+		0.286724, 0.681434, 0.594334, 0.603285, 0.611802, 0.620426,
+		0.618430, 0.618819, 0.622485, 0.626214, 0.258258, 0.500000,
+		0.736421, 0.720331, 0.320216, 0.674066, 0.688860, 0.686252,
+		0.698531, 0.710614, 0.711769, 0.716608, 0.721510, 0.419836,
+		0.720824, 0.643260, 0.630824, 0.715178, 0.446093, 0.604228,
+		0.609896, 0.620310, 0.630837, 0.643723, 0.644168, 0.647904,
+		0.425964, 0.722158, 0.652729, 0.640293, 0.731471, 0.641162,
+		0.459427, 0.596164, 0.606578, 0.617105, 0.629470, 0.642536,
+		0.643044, 0.425029, 0.718971, 0.661782, 0.649347, 0.728494,
+		0.647145, 0.632699, 0.461061, 0.594215, 0.604743, 0.617107,
+		0.630173, 0.643302, 0.428989, 0.716771, 0.656347, 0.658516,
+		0.741672, 0.658177, 0.643731, 0.630386, 0.468587, 0.596696,
+		0.606289, 0.619355, 0.632484, 0.427087, 0.717764, 0.657266,
+		0.656453, 0.754643, 0.669322, 0.654875, 0.641531, 0.632968,
+		0.477480, 0.595383, 0.608451, 0.621580, 0.427115, 0.718643,
+		0.657673, 0.656859, 0.755823, 0.683237, 0.668221, 0.654876,
+		0.643300, 0.631627, 0.482078, 0.608918, 0.622049, 0.428089,
+		0.723504, 0.661673, 0.660859, 0.761020, 0.683725, 0.682299,
+		0.668954, 0.657379, 0.645705, 0.646174, 0.488341, 0.625808,
+		0.429080, 0.728411, 0.665752, 0.664938, 0.766294, 0.687804,
+		0.682867, 0.683112, 0.671536, 0.659863, 0.660332, 0.664395,
+		0.495267,
+	},
+	{ // QTo
+		0.155085, 0.338815, 0.263005, 0.365341, 0.262368, 0.408735,
+		0.411382, 0.418575, 0.418585, 0.416710, 0.416714, 0.417559,
+		0.418404, 0.355184, 0.139997, 0.253827, 0.355705, 0.253109,
+		0.401208, 0.414071, 0.419243, 0.418411, 0.421768, 0.421772,
+		0.422617, 0.423462, 0.272674, 0.261989, 0.112561, 0.262127,
+		0.475079, 0.678760, 0.679642, 0.680161, 0.677345, 0.678225,
+		0.679076, 0.683572, 0.688099, 0.383497, 0.373200, 0.272209,
+		0.291725, 0.692247, 0.605014, 0.613884, 0.622899, 0.631479,
+		0.629484, 0.629872, 0.633538, 0.637267, 0.274348, 0.263579,
+		0.500000, 0.731743, 0.335733, 0.681171, 0.684559, 0.699374,
+		0.696788, 0.708829, 0.709943, 0.714741, 0.719602, 0.430409,
+		0.422299, 0.719609, 0.641886, 0.722563, 0.453730, 0.611948,
+		0.621050, 0.629231, 0.639758, 0.652644, 0.653089, 0.656824,
+		0.433783, 0.435950, 0.720862, 0.651266, 0.726545, 0.649032,
+		0.464262, 0.607294, 0.613026, 0.623553, 0.635918, 0.648984,
+		0.649492, 0.441940, 0.442079, 0.721726, 0.660800, 0.742860,
+		0.658659, 0.644185, 0.478103, 0.602124, 0.612652, 0.622250,
+		0.635316, 0.648445, 0.441936, 0.441147, 0.718574, 0.669920,
+		0.739907, 0.667350, 0.650235, 0.638866, 0.480206, 0.600360,
+		0.609953, 0.623019, 0.636148, 0.440034, 0.444904, 0.719567,
+		0.667856, 0.752836, 0.678495, 0.661380, 0.650010, 0.636732,
+		0.488889, 0.599048, 0.612115, 0.625244, 0.440062, 0.444932,
+		0.720445, 0.668263, 0.753974, 0.692410, 0.674725, 0.660343,
+		0.647064, 0.635391, 0.493234, 0.612582, 0.625713, 0.441036,
+		0.445907, 0.725306, 0.672263, 0.759129, 0.692898, 0.688803,
+		0.674421, 0.661143, 0.649469, 0.649938, 0.499203, 0.629473,
+		0.442027, 0.446897, 0.730214, 0.676342, 0.764362, 0.696977,
+		0.689371, 0.688579, 0.675300, 0.663627, 0.664096, 0.668159,
+		0.505793,
+	},
+	{ // JTo
+		0.172618, 0.351609, 0.349699, 0.275959, 0.277368, 0.418883,
+		0.421136, 0.426197, 0.434625, 0.428823, 0.428826, 0.429672,
+		0.430517, 0.368784, 0.157530, 0.338048, 0.266781, 0.268109,
+		0.411355, 0.424218, 0.426865, 0.434451, 0.433881, 0.433884,
+		0.434729, 0.435575, 0.366161, 0.353773, 0.142301, 0.256931,
+		0.258259, 0.403111, 0.415974, 0.428837, 0.434402, 0.433831,
+		0.433835, 0.434680, 0.435525, 0.286542, 0.275857, 0.264445,
+		0.129745, 0.475079, 0.678053, 0.678852, 0.679255, 0.682706,
+		0.679662, 0.680514, 0.685010, 0.689537, 0.290437, 0.279669,
+		0.268257, 0.500000, 0.351219, 0.691646, 0.691846, 0.695256,
+		0.710093, 0.707268, 0.708341, 0.713097, 0.717916, 0.440919,
+		0.432809, 0.423901, 0.718436, 0.733593, 0.461765, 0.623859,
+		0.631083, 0.640559, 0.648935, 0.661821, 0.662266, 0.666001,
+		0.443864, 0.446460, 0.437552, 0.719606, 0.734116, 0.661351,
+		0.471826, 0.614935, 0.624411, 0.632394, 0.644759, 0.657825,
+		0.658333, 0.449697, 0.449835, 0.451205, 0.720346, 0.738121,
+		0.669027, 0.651972, 0.482824, 0.613486, 0.619020, 0.628619,
+		0.641684, 0.654813, 0.459214, 0.458424, 0.457765, 0.724398,
+		0.754460, 0.679043, 0.661989, 0.650592, 0.497520, 0.617342,
+		0.617784, 0.630850, 0.643979, 0.452918, 0.457789, 0.457130,
+		0.721003, 0.751257, 0.687953, 0.670469, 0.656432, 0.655055,
+		0.500308, 0.602633, 0.615700, 0.628829, 0.452946, 0.457816,
+		0.457158, 0.721882, 0.752354, 0.701868, 0.683815, 0.666764,
+		0.655461, 0.639072, 0.504401, 0.616167, 0.629298, 0.453921,
+		0.458791, 0.458132, 0.726743, 0.757467, 0.702356, 0.697893,
+		0.680843, 0.669539, 0.653150, 0.653619, 0.510075, 0.633058,
+		0.454911, 0.459781, 0.459122, 0.731650, 0.762658, 0.706435,
+		0.698461, 0.695000, 0.683697, 0.667308, 0.667777, 0.671840,
+		0.516329,
+	},
+	{ // TT
+		0.192509, 0.540630, 0.541397, 0.542164, 0.651989, 0.685378,
+		0.681663, 0.679820, 0.682124, 0.669589, 0.669596, 0.673230,
+		0.676863, 0.568768, 0.188632, 0.536940, 0.537707, 0.641430,
+		0.684324, 0.688390, 0.684675, 0.683353, 0.684664, 0.684671,
+		0.688305, 0.691938, 0.569489, 0.564904, 0.184325, 0.530067,
+		0.627239, 0.679674, 0.683740, 0.687807, 0.684613, 0.685924,
+		0.685932, 0.689565, 0.693199, 0.570210, 0.565625, 0.557559,
+		0.180144, 0.613079, 0.675055, 0.679122, 0.683189, 0.687255,
+		0.687216, 0.687223, 0.690857, 0.694491, 0.691328, 0.679784,
+		0.664267, 0.648781, 0.500000, 0.823098, 0.836156, 0.849293,
+		0.862470, 0.875388, 0.875395, 0.879395, 0.883395, 0.721865,
+		0.720817, 0.715858, 0.710932, 0.871687, 0.820012, 0.816976,
+		0.817914, 0.819264, 0.822893, 0.838848, 0.838855, 0.842912,
+		0.717813, 0.724970, 0.720012, 0.715085, 0.885800, 0.858006,
+		0.816240, 0.802829, 0.804179, 0.807808, 0.823762, 0.840214,
+		0.840221, 0.715776, 0.720919, 0.724167, 0.719240, 0.899998,
+		0.858915, 0.842861, 0.812471, 0.789617, 0.793246, 0.808679,
+		0.825130, 0.841581, 0.718252, 0.719455, 0.720688, 0.723398,
+		0.914240, 0.860256, 0.844202, 0.828718, 0.809279, 0.782004,
+		0.794119, 0.810571, 0.827022, 0.704784, 0.720877, 0.722110,
+		0.723376, 0.928218, 0.863967, 0.847913, 0.832429, 0.820532,
+		0.809559, 0.778571, 0.795024, 0.811475, 0.704809, 0.720902,
+		0.722136, 0.723401, 0.928238, 0.881108, 0.865053, 0.849001,
+		0.833521, 0.816986, 0.809782, 0.795047, 0.811500, 0.708800,
+		0.724892, 0.726126, 0.727392, 0.932629, 0.881157, 0.882631,
+		0.866578, 0.851098, 0.834564, 0.834589, 0.814207, 0.815582,
+		0.712807, 0.728899, 0.730133, 0.731399, 0.937031, 0.885593,
+		0.882697, 0.884172, 0.868692, 0.852158, 0.852183, 0.856602,
+		0.818664,
+	},
+	{ // T9s
+		0.222042, 0.379803, 0.378159, 0.376213, 0.308438, 0.330070,
+		0.440468, 0.445097, 0.450929, 0.453540, 0.453544, 0.453548,
+		0.454371, 0.405237, 0.210906, 0.368937, 0.366991, 0.313740,
+		0.321441, 0.443368, 0.445633, 0.450645, 0.458371, 0.458375,
+		0.458378, 0.459202, 0.403159, 0.393387, 0.197102, 0.355271,
+		0.307892, 0.312220, 0.435354, 0.447862, 0.450511, 0.458237,
+		0.458240, 0.458244, 0.459068, 0.400770, 0.390997, 0.378614,
+		0.183004, 0.298938, 0.304410, 0.428033, 0.440540, 0.453048,
+		0.458794, 0.458798, 0.458802, 0.459625, 0.320960, 0.325934,
+		0.318829, 0.308354, 0.176902, 0.500000, 0.670083, 0.671624,
+		0.672666, 0.676084, 0.676859, 0.677664, 0.681821, 0.344285,
+		0.334150, 0.323366, 0.314079, 0.524921, 0.388451, 0.693201,
+		0.694133, 0.697784, 0.711750, 0.712727, 0.713763, 0.718181,
+		0.468129, 0.470777, 0.462205, 0.454365, 0.708110, 0.732933,
+		0.488781, 0.644489, 0.651654, 0.660765, 0.672382, 0.684730,
+		0.685207, 0.473192, 0.473425, 0.475341, 0.467500, 0.710107,
+		0.734264, 0.673160, 0.498369, 0.636480, 0.645591, 0.656824,
+		0.668684, 0.681318, 0.479559, 0.478905, 0.478405, 0.480640,
+		0.711575, 0.738567, 0.680719, 0.665022, 0.508812, 0.635334,
+		0.644180, 0.653424, 0.666057, 0.482501, 0.487307, 0.486807,
+		0.487039, 0.715638, 0.754044, 0.690281, 0.674583, 0.664123,
+		0.523036, 0.639577, 0.639996, 0.652630, 0.482532, 0.487338,
+		0.486838, 0.487070, 0.716452, 0.755066, 0.702632, 0.686523,
+		0.673516, 0.668923, 0.526651, 0.640438, 0.653075, 0.482581,
+		0.487387, 0.486887, 0.487119, 0.717312, 0.756165, 0.715658,
+		0.699027, 0.683235, 0.669386, 0.669831, 0.530776, 0.653588,
+		0.483549, 0.488355, 0.487855, 0.488087, 0.721869, 0.760990,
+		0.716211, 0.712371, 0.696579, 0.682730, 0.683175, 0.683697,
+		0.536387,
+	},
+	{ // T8s
+		0.210139, 0.371476, 0.369832, 0.367886, 0.310855, 0.404216,
+		0.317689, 0.433711, 0.439543, 0.441771, 0.441775, 0.445611,
+		0.445615, 0.396789, 0.207016, 0.359760, 0.358116, 0.299590,
+		0.394505, 0.321759, 0.432523, 0.437535, 0.444877, 0.444881,
+		0.448717, 0.448721, 0.394711, 0.384037, 0.195751, 0.348224,
+		0.304275, 0.384522, 0.312539, 0.434752, 0.437401, 0.444743,
+		0.444747, 0.448583, 0.448586, 0.392322, 0.381959, 0.371453,
+		0.182065, 0.298249, 0.375180, 0.304757, 0.427460, 0.439968,
+		0.445330, 0.445334, 0.449170, 0.449173, 0.323590, 0.311140,
+		0.315441, 0.308154, 0.163844, 0.329917, 0.500000, 0.647617,
+		0.649783, 0.650874, 0.651609, 0.656080, 0.656873, 0.430705,
+		0.420405, 0.409806, 0.399887, 0.342934, 0.330927, 0.687657,
+		0.623154, 0.631976, 0.640694, 0.652310, 0.653853, 0.654330,
+		0.330814, 0.334962, 0.324178, 0.314921, 0.524922, 0.727124,
+		0.380249, 0.679617, 0.683268, 0.697234, 0.698212, 0.713018,
+		0.714112, 0.461177, 0.459908, 0.461823, 0.454012, 0.684421,
+		0.651444, 0.718605, 0.486413, 0.632883, 0.641692, 0.652925,
+		0.666696, 0.679104, 0.467544, 0.465387, 0.464887, 0.467152,
+		0.687094, 0.660708, 0.722908, 0.661223, 0.496856, 0.628817,
+		0.637664, 0.651435, 0.663355, 0.470068, 0.473372, 0.472872,
+		0.473133, 0.688611, 0.669860, 0.738376, 0.670467, 0.657223,
+		0.510656, 0.624235, 0.638008, 0.647310, 0.470099, 0.473403,
+		0.472903, 0.473164, 0.689384, 0.682212, 0.739399, 0.682407,
+		0.666615, 0.652760, 0.514271, 0.638450, 0.647755, 0.474339,
+		0.477642, 0.477142, 0.477404, 0.694368, 0.683983, 0.755514,
+		0.696991, 0.681199, 0.667344, 0.667789, 0.523762, 0.652403,
+		0.474413, 0.477716, 0.477216, 0.477478, 0.695239, 0.684530,
+		0.756694, 0.710100, 0.693787, 0.677147, 0.677592, 0.682615,
+		0.528409,
+	},
+	{ // T7s
+		0.197738, 0.362417, 0.359351, 0.357707, 0.299442, 0.395785,
+		0.393081, 0.307243, 0.428176, 0.430403, 0.430407, 0.433860,
+		0.437695, 0.387320, 0.195113, 0.351374, 0.349730, 0.301990,
+		0.386119, 0.394523, 0.309378, 0.426091, 0.433433, 0.433436,
+		0.436889, 0.440725, 0.384049, 0.375526, 0.191862, 0.338987,
+		0.290109, 0.375679, 0.384083, 0.312915, 0.424232, 0.431574,
+		0.431578, 0.435030, 0.438866, 0.381971, 0.373448, 0.362040,
+		0.180715, 0.294616, 0.366417, 0.374821, 0.305133, 0.426798,
+		0.432161, 0.432165, 0.435617, 0.439453, 0.311332, 0.313748,
+		0.300626, 0.304744, 0.150707, 0.328376, 0.352383, 0.500000,
+		0.619342, 0.622122, 0.622817, 0.624345, 0.628805, 0.422145,
+		0.411888, 0.400792, 0.390954, 0.341804, 0.326860, 0.570302,
+		0.687309, 0.623572, 0.632290, 0.643907, 0.642344, 0.646653,
+		0.419266, 0.420685, 0.409589, 0.399751, 0.367436, 0.596956,
+		0.332192, 0.674280, 0.613272, 0.621990, 0.633606, 0.645328,
+		0.646929, 0.319567, 0.321494, 0.325056, 0.315799, 0.524926,
+		0.727125, 0.713016, 0.372605, 0.669732, 0.683698, 0.684676,
+		0.699482, 0.714347, 0.455661, 0.453310, 0.451307, 0.453571,
+		0.654406, 0.652124, 0.641278, 0.708283, 0.484939, 0.625578,
+		0.634425, 0.647894, 0.661724, 0.458185, 0.461294, 0.459291,
+		0.459552, 0.657745, 0.661276, 0.650430, 0.723752, 0.653802,
+		0.498740, 0.618378, 0.631849, 0.645680, 0.458216, 0.461325,
+		0.459322, 0.459583, 0.658477, 0.673627, 0.662781, 0.724774,
+		0.663195, 0.646555, 0.502354, 0.632291, 0.646124, 0.462038,
+		0.465147, 0.463144, 0.463405, 0.660248, 0.672086, 0.675252,
+		0.740881, 0.677462, 0.660822, 0.661266, 0.511422, 0.650772,
+		0.466302, 0.469411, 0.467408, 0.467670, 0.665242, 0.676824,
+		0.677107, 0.757078, 0.692129, 0.675489, 0.675934, 0.680952,
+		0.521435,
+	},
+	{ // T6s
+		0.185465, 0.353893, 0.351053, 0.347986, 0.288394, 0.388114,
+		0.385410, 0.385414, 0.297218, 0.421622, 0.421625, 0.425078,
+		0.428914, 0.378557, 0.182712, 0.342256, 0.339190, 0.290560,
+		0.377629, 0.386033, 0.383329, 0.298932, 0.422006, 0.422009,
+		0.425462, 0.428914, 0.375404, 0.365993, 0.179958, 0.330542,
+		0.292490, 0.367234, 0.375638, 0.384101, 0.300534, 0.420070,
+		0.420074, 0.423526, 0.426978, 0.372134, 0.362723, 0.353466,
+		0.176825, 0.280431, 0.357515, 0.365919, 0.374382, 0.305509,
+		0.418933, 0.418936, 0.422389, 0.425841, 0.299485, 0.301469,
+		0.303212, 0.289907, 0.137530, 0.327334, 0.350217, 0.380658,
+		0.500000, 0.586945, 0.587599, 0.588800, 0.590317, 0.414409,
+		0.403265, 0.392211, 0.381876, 0.341208, 0.322794, 0.557794,
+		0.570933, 0.686933, 0.623827, 0.635444, 0.633392, 0.635084,
+		0.411531, 0.412062, 0.401008, 0.390674, 0.365633, 0.584093,
+		0.328125, 0.562440, 0.673962, 0.613586, 0.625203, 0.636924,
+		0.635420, 0.411537, 0.409186, 0.409871, 0.399536, 0.398267,
+		0.597852, 0.588862, 0.333998, 0.661238, 0.603635, 0.614868,
+		0.626590, 0.638370, 0.308800, 0.310253, 0.311594, 0.316683,
+		0.524936, 0.727104, 0.713055, 0.699289, 0.365604, 0.670517,
+		0.671497, 0.686303, 0.701168, 0.449050, 0.449347, 0.447150,
+		0.445908, 0.619864, 0.652632, 0.641846, 0.631372, 0.709502,
+		0.486863, 0.615289, 0.628760, 0.642288, 0.449081, 0.449378,
+		0.447181, 0.445939, 0.620555, 0.664984, 0.654197, 0.643311,
+		0.710524, 0.643293, 0.490478, 0.629201, 0.642733, 0.452903,
+		0.453200, 0.451003, 0.449761, 0.621967, 0.662921, 0.666667,
+		0.655781, 0.726631, 0.657560, 0.658005, 0.499544, 0.644763,
+		0.457161, 0.457047, 0.454850, 0.453608, 0.623749, 0.664868,
+		0.665210, 0.668335, 0.742820, 0.671911, 0.672355, 0.674588,
+		0.509134,
+	},
+	{ // T5s
+		0.170498, 0.341891, 0.339050, 0.336209, 0.273689, 0.376964,
+		0.374260, 0.374264, 0.377056, 0.283986, 0.401112, 0.404565,
+		0.408017, 0.365949, 0.170572, 0.333097, 0.330256, 0.279309,
+		0.369323, 0.377727, 0.375023, 0.375410, 0.288722, 0.410331,
+		0.413783, 0.417236, 0.362795, 0.356541, 0.167818, 0.321609,
+		0.281279, 0.358928, 0.367332, 0.375795, 0.373475, 0.290324,
+		0.408396, 0.411848, 0.415300, 0.359641, 0.353388, 0.344131,
+		0.165182, 0.283032, 0.349254, 0.357658, 0.366121, 0.374584,
+		0.293365, 0.407181, 0.410634, 0.414086, 0.283507, 0.289386,
+		0.291171, 0.292732, 0.124612, 0.323916, 0.349126, 0.377878,
+		0.413055, 0.500000, 0.550087, 0.552513, 0.553703, 0.402826,
+		0.394839, 0.383785, 0.373493, 0.338027, 0.318987, 0.545042,
+		0.558181, 0.571410, 0.686321, 0.627277, 0.625225, 0.626429,
+		0.399948, 0.403636, 0.392583, 0.382291, 0.364990, 0.570969,
+		0.324319, 0.549688, 0.562916, 0.673349, 0.617036, 0.628757,
+		0.626765, 0.399954, 0.400760, 0.401445, 0.391153, 0.395801,
+		0.584728, 0.575738, 0.330192, 0.554772, 0.660684, 0.606761,
+		0.618482, 0.630263, 0.402948, 0.401182, 0.398985, 0.400020,
+		0.433628, 0.598582, 0.589592, 0.580973, 0.336609, 0.650984,
+		0.596933, 0.608655, 0.620435, 0.294447, 0.299283, 0.300624,
+		0.303491, 0.524956, 0.726834, 0.712785, 0.699078, 0.688598,
+		0.359631, 0.655048, 0.669857, 0.684722, 0.427492, 0.437154,
+		0.434956, 0.433520, 0.580037, 0.656646, 0.645860, 0.635033,
+		0.624682, 0.692682, 0.478361, 0.626974, 0.640505, 0.431314,
+		0.440975, 0.438778, 0.437342, 0.582788, 0.654584, 0.658330,
+		0.647503, 0.637152, 0.708789, 0.655653, 0.487428, 0.642047,
+		0.435160, 0.444822, 0.442625, 0.441189, 0.584211, 0.656009,
+		0.656351, 0.660057, 0.649706, 0.724977, 0.670004, 0.671715,
+		0.497018,
+	},
+	{ // T4s
+		0.170491, 0.341533, 0.338692, 0.335851, 0.272550, 0.375481,
+		0.375883, 0.376375, 0.378783, 0.369757, 0.283986, 0.404147,
+		0.407600, 0.365567, 0.170565, 0.332739, 0.329898, 0.278211,
+		0.367840, 0.379349, 0.377134, 0.377137, 0.377228, 0.288722,
+		0.413366, 0.416818, 0.362413, 0.356159, 0.167811, 0.321251,
+		0.280221, 0.357445, 0.368954, 0.377906, 0.375202, 0.375293,
+		0.290324, 0.411431, 0.414883, 0.359260, 0.353006, 0.343749,
+		0.165175, 0.282014, 0.347771, 0.359281, 0.368232, 0.376695,
+		0.374079, 0.293366, 0.410216, 0.413669, 0.282310, 0.288231,
+		0.290057, 0.291659, 0.124605, 0.323141, 0.348391, 0.377183,
+		0.412401, 0.449913, 0.500000, 0.528734, 0.531386, 0.401277,
+		0.393290, 0.382236, 0.371944, 0.337209, 0.315139, 0.538720,
+		0.552243, 0.565472, 0.578951, 0.700152, 0.624399, 0.625602,
+		0.401686, 0.405375, 0.394321, 0.384029, 0.364213, 0.564562,
+		0.323887, 0.546219, 0.559447, 0.572927, 0.687181, 0.627931,
+		0.626322, 0.402214, 0.403020, 0.403705, 0.393413, 0.395065,
+		0.578714, 0.572250, 0.330280, 0.551791, 0.565271, 0.674043,
+		0.618040, 0.629820, 0.404796, 0.403031, 0.400833, 0.402280,
+		0.432934, 0.592568, 0.586104, 0.577988, 0.336698, 0.559912,
+		0.661442, 0.608317, 0.620097, 0.395268, 0.403133, 0.400936,
+		0.399500, 0.473388, 0.606683, 0.600218, 0.592103, 0.586443,
+		0.343630, 0.650263, 0.598274, 0.610054, 0.294483, 0.299319,
+		0.300660, 0.303527, 0.524992, 0.741869, 0.727820, 0.713593,
+		0.699949, 0.687841, 0.364400, 0.669890, 0.684758, 0.430869,
+		0.440530, 0.438333, 0.436897, 0.557088, 0.653724, 0.657470,
+		0.647037, 0.636795, 0.626195, 0.708825, 0.487425, 0.642044,
+		0.434715, 0.444377, 0.442180, 0.440744, 0.560110, 0.655150,
+		0.655885, 0.659591, 0.649349, 0.638749, 0.725014, 0.671712,
+		0.497014,
+	},
+	{ // T3s
+		0.166434, 0.338062, 0.335221, 0.332380, 0.268029, 0.375063,
+		0.371633, 0.374742, 0.377639, 0.368613, 0.368617, 0.282535,
+		0.404129, 0.361895, 0.166507, 0.329268, 0.326427, 0.273730,
+		0.367422, 0.375099, 0.375501, 0.375993, 0.376084, 0.376088,
+		0.287271, 0.413288, 0.358741, 0.352487, 0.163753, 0.317780,
+		0.275781, 0.357027, 0.364704, 0.376273, 0.374058, 0.374148,
+		0.374152, 0.288873, 0.411353, 0.355587, 0.349334, 0.340077,
+		0.161118, 0.277614, 0.347353, 0.355031, 0.366599, 0.375551,
+		0.372934, 0.372938, 0.291914, 0.410139, 0.277429, 0.283392,
+		0.285259, 0.286903, 0.120605, 0.322336, 0.343920, 0.375655,
+		0.411200, 0.447487, 0.471266, 0.500000, 0.528298, 0.400826,
+		0.392838, 0.381785, 0.371493, 0.336367, 0.315132, 0.538716,
+		0.552240, 0.565468, 0.578947, 0.590994, 0.700152, 0.625599,
+		0.397301, 0.400989, 0.389936, 0.379643, 0.359481, 0.564553,
+		0.318688, 0.537917, 0.551530, 0.565009, 0.577056, 0.699079,
+		0.622788, 0.400595, 0.401400, 0.402086, 0.391794, 0.393538,
+		0.578704, 0.563822, 0.327976, 0.545959, 0.559439, 0.570997,
+		0.685941, 0.626286, 0.403698, 0.401932, 0.399735, 0.401182,
+		0.431766, 0.592558, 0.578069, 0.572086, 0.334915, 0.554463,
+		0.563406, 0.673340, 0.616947, 0.394170, 0.402035, 0.399838,
+		0.398402, 0.470882, 0.606673, 0.592183, 0.586200, 0.580933,
+		0.341847, 0.555411, 0.662164, 0.606904, 0.394201, 0.402066,
+		0.399869, 0.398433, 0.496592, 0.619368, 0.604879, 0.598375,
+		0.590323, 0.581831, 0.346425, 0.662667, 0.607408, 0.292962,
+		0.297798, 0.299139, 0.302006, 0.525053, 0.741930, 0.740834,
+		0.726607, 0.712963, 0.700855, 0.701365, 0.368209, 0.686258,
+		0.431049, 0.440648, 0.438451, 0.437015, 0.556824, 0.655146,
+		0.652258, 0.655964, 0.646115, 0.635515, 0.636019, 0.726664,
+		0.493796,
+	},
+	{ // T2s
+		0.162376, 0.334532, 0.331691, 0.328850, 0.263450, 0.371533,
+		0.371156, 0.370433, 0.376052, 0.367469, 0.367472, 0.368296,
+		0.281084, 0.358159, 0.162450, 0.325738, 0.322897, 0.269191,
+		0.363892, 0.374623, 0.371192, 0.374301, 0.374880, 0.374884,
+		0.375708, 0.285819, 0.355006, 0.348752, 0.159696, 0.314250,
+		0.271282, 0.353497, 0.364228, 0.371964, 0.372366, 0.372945,
+		0.372949, 0.373772, 0.287422, 0.351852, 0.345598, 0.336342,
+		0.157060, 0.273156, 0.343824, 0.354554, 0.362290, 0.373859,
+		0.371731, 0.371735, 0.372558, 0.290463, 0.272486, 0.278490,
+		0.280398, 0.282084, 0.116605, 0.318179, 0.343127, 0.371195,
+		0.409683, 0.446297, 0.468614, 0.471702, 0.500000, 0.397097,
+		0.389109, 0.378056, 0.367764, 0.331881, 0.311499, 0.538713,
+		0.548404, 0.562015, 0.575495, 0.587542, 0.588018, 0.701603,
+		0.396786, 0.400474, 0.389421, 0.379129, 0.358649, 0.564549,
+		0.318681, 0.537914, 0.551526, 0.565005, 0.577052, 0.589081,
+		0.699078, 0.396146, 0.396952, 0.397637, 0.387345, 0.388816,
+		0.574766, 0.563812, 0.322778, 0.537658, 0.551522, 0.563080,
+		0.575109, 0.697839, 0.402144, 0.400250, 0.398053, 0.399500,
+		0.430249, 0.589014, 0.578059, 0.563657, 0.332611, 0.548631,
+		0.557574, 0.569603, 0.685238, 0.393072, 0.400874, 0.398677,
+		0.397241, 0.469724, 0.603128, 0.592173, 0.578165, 0.575030,
+		0.340064, 0.549962, 0.561993, 0.674062, 0.393103, 0.400905,
+		0.398708, 0.397272, 0.493836, 0.615824, 0.604869, 0.590339,
+		0.584420, 0.576321, 0.344642, 0.562494, 0.674570, 0.394046,
+		0.401848, 0.399651, 0.398215, 0.497179, 0.616352, 0.617566,
+		0.603037, 0.597117, 0.589018, 0.589522, 0.350345, 0.678472,
+		0.291474, 0.296310, 0.297651, 0.300518, 0.525147, 0.743614,
+		0.740928, 0.739654, 0.726011, 0.713903, 0.714412, 0.718643,
+		0.372636,
+	},
+	{ // A9o
+		0.062182, 0.246140, 0.251713, 0.258037, 0.267763, 0.475078,
+		0.652487, 0.650381, 0.649540, 0.634539, 0.639281, 0.640123,
+		0.644609, 0.257585, 0.283580, 0.547312, 0.546974, 0.546698,
+		0.700113, 0.603861, 0.598191, 0.594991, 0.596159, 0.600482,
+		0.600933, 0.604662, 0.263182, 0.581082, 0.281029, 0.537185,
+		0.536910, 0.685251, 0.592276, 0.600836, 0.594342, 0.595510,
+		0.599833, 0.600284, 0.604013, 0.269602, 0.580591, 0.570018,
+		0.279214, 0.527185, 0.670451, 0.580754, 0.589314, 0.597050,
+		0.594924, 0.599246, 0.599698, 0.603427, 0.279713, 0.580164,
+		0.569591, 0.559081, 0.278135, 0.655715, 0.569295, 0.577855,
+		0.585591, 0.597174, 0.598723, 0.599174, 0.602903, 0.500000,
+		0.741366, 0.725277, 0.709250, 0.693287, 0.295489, 0.662046,
+		0.674303, 0.686151, 0.702129, 0.718196, 0.719456, 0.724400,
+		0.692306, 0.641873, 0.629437, 0.617064, 0.604755, 0.702416,
+		0.429048, 0.588539, 0.598066, 0.611439, 0.625517, 0.638466,
+		0.638974, 0.690043, 0.635802, 0.638540, 0.626167, 0.613858,
+		0.715571, 0.624809, 0.436064, 0.586660, 0.600033, 0.614111,
+		0.627060, 0.640189, 0.689169, 0.632421, 0.631608, 0.634409,
+		0.622099, 0.728293, 0.634913, 0.622702, 0.440246, 0.586058,
+		0.600136, 0.612564, 0.625693, 0.672945, 0.633640, 0.632826,
+		0.632076, 0.634370, 0.745360, 0.649047, 0.636836, 0.621948,
+		0.448191, 0.591063, 0.600719, 0.613848, 0.678167, 0.638439,
+		0.637626, 0.636875, 0.636188, 0.762674, 0.664072, 0.651861,
+		0.636973, 0.627301, 0.458722, 0.605432, 0.618563, 0.679047,
+		0.638921, 0.638107, 0.637357, 0.636669, 0.763970, 0.678063,
+		0.665852, 0.650394, 0.637709, 0.642895, 0.464268, 0.619102,
+		0.683912, 0.643000, 0.642187, 0.641436, 0.640749, 0.769286,
+		0.678630, 0.680009, 0.664552, 0.651867, 0.657052, 0.657597,
+		0.471531,
+	},
+	{ // K9o
+		0.131299, 0.229711, 0.344035, 0.340808, 0.337581, 0.247115,
+		0.386342, 0.385510, 0.389055, 0.386787, 0.390725, 0.390729,
+		0.391574, 0.237587, 0.076551, 0.251876, 0.258118, 0.267730,
+		0.475079, 0.659234, 0.656345, 0.653930, 0.651605, 0.656347,
+		0.657189, 0.661675, 0.361800, 0.262843, 0.282120, 0.545024,
+		0.544749, 0.695449, 0.602639, 0.611156, 0.605549, 0.603561,
+		0.607883, 0.608335, 0.612064, 0.358156, 0.269180, 0.578129,
+		0.280305, 0.535024, 0.680649, 0.591117, 0.599634, 0.608257,
+		0.602974, 0.607297, 0.607748, 0.611477, 0.354512, 0.279176,
+		0.577701, 0.567191, 0.279183, 0.665850, 0.579595, 0.588112,
+		0.596735, 0.605161, 0.606710, 0.607162, 0.610891, 0.258634,
+		0.500000, 0.736037, 0.720011, 0.703984, 0.306721, 0.674348,
+		0.671732, 0.684011, 0.696094, 0.712120, 0.713338, 0.718241,
+		0.407887, 0.699579, 0.640125, 0.627752, 0.615379, 0.715918,
+		0.442652, 0.592331, 0.602745, 0.612961, 0.627039, 0.639988,
+		0.640496, 0.406953, 0.696351, 0.649178, 0.636805, 0.624433,
+		0.712941, 0.628839, 0.444286, 0.590381, 0.600598, 0.614676,
+		0.627625, 0.640754, 0.410913, 0.693759, 0.643173, 0.645975,
+		0.633602, 0.726120, 0.639870, 0.626526, 0.451812, 0.589779,
+		0.603858, 0.616286, 0.629415, 0.408576, 0.691269, 0.641073,
+		0.640323, 0.642555, 0.739082, 0.650686, 0.637341, 0.625765,
+		0.460264, 0.592431, 0.602088, 0.615217, 0.412997, 0.696491,
+		0.645873, 0.645122, 0.644372, 0.756355, 0.665711, 0.652366,
+		0.640790, 0.628541, 0.470543, 0.606800, 0.619931, 0.413037,
+		0.697371, 0.646355, 0.645604, 0.644854, 0.757609, 0.679701,
+		0.666356, 0.654211, 0.638949, 0.644135, 0.475795, 0.620470,
+		0.414028, 0.702236, 0.650434, 0.649683, 0.648933, 0.762883,
+		0.680269, 0.680514, 0.668369, 0.653107, 0.658292, 0.658837,
+		0.482722,
+	},
+	{ // Q9o
+		0.148832, 0.325876, 0.242673, 0.352812, 0.349585, 0.262115,
+		0.394023, 0.401217, 0.401227, 0.398959, 0.402897, 0.402900,
+		0.403746, 0.341863, 0.136823, 0.248578, 0.343258, 0.340031,
+		0.252856, 0.396719, 0.401891, 0.401059, 0.404023, 0.407961,
+		0.407965, 0.408810, 0.251456, 0.257085, 0.093451, 0.259759,
+		0.269330, 0.475079, 0.658524, 0.659809, 0.656952, 0.654626,
+		0.659369, 0.660210, 0.664696, 0.370592, 0.360385, 0.270436,
+		0.285305, 0.543625, 0.691433, 0.601716, 0.610730, 0.619311,
+		0.614028, 0.618350, 0.618802, 0.622531, 0.366948, 0.356740,
+		0.280391, 0.576099, 0.284142, 0.676634, 0.590194, 0.599208,
+		0.607789, 0.616215, 0.617764, 0.618215, 0.621944, 0.274723,
+		0.263963, 0.500000, 0.731390, 0.715364, 0.322070, 0.669910,
+		0.684725, 0.682139, 0.694180, 0.710165, 0.711342, 0.716202,
+		0.415707, 0.417880, 0.698398, 0.638725, 0.626352, 0.710858,
+		0.447446, 0.603404, 0.609135, 0.619351, 0.633429, 0.646379,
+		0.646887, 0.423864, 0.424009, 0.700085, 0.648259, 0.635886,
+		0.727173, 0.640264, 0.461287, 0.595467, 0.605684, 0.619762,
+		0.632190, 0.645319, 0.423860, 0.423077, 0.696892, 0.657378,
+		0.645005, 0.724220, 0.646314, 0.631932, 0.463389, 0.593386,
+		0.607465, 0.619893, 0.633022, 0.421523, 0.426400, 0.694401,
+		0.651726, 0.653958, 0.737140, 0.657129, 0.642747, 0.629468,
+		0.471631, 0.596038, 0.605695, 0.618824, 0.425944, 0.430821,
+		0.699623, 0.656526, 0.655775, 0.754371, 0.672154, 0.657772,
+		0.644493, 0.632244, 0.481657, 0.610407, 0.623538, 0.425984,
+		0.430861, 0.700503, 0.657008, 0.656257, 0.755584, 0.686145,
+		0.671193, 0.657914, 0.642652, 0.647838, 0.486615, 0.624077,
+		0.426975, 0.431851, 0.705369, 0.661087, 0.660337, 0.760817,
+		0.686712, 0.685351, 0.672072, 0.656810, 0.661995, 0.662540,
+		0.493206,
+	},
+	{ // J9o
+		0.166365, 0.338670, 0.337078, 0.255626, 0.361588, 0.277055,
+		0.403778, 0.408839, 0.416874, 0.411071, 0.415009, 0.415013,
+		0.415858, 0.355462, 0.154356, 0.327617, 0.261532, 0.352035,
+		0.267796, 0.406867, 0.409513, 0.416706, 0.416135, 0.420073,
+		0.420077, 0.420922, 0.353163, 0.343049, 0.139556, 0.255390,
+		0.341714, 0.257977, 0.398654, 0.411517, 0.416688, 0.416118,
+		0.420056, 0.420059, 0.420905, 0.265324, 0.270954, 0.263569,
+		0.110719, 0.270890, 0.475079, 0.657774, 0.658986, 0.659697,
+		0.656611, 0.661354, 0.662195, 0.666681, 0.379383, 0.369176,
+		0.358114, 0.281564, 0.289068, 0.685921, 0.600113, 0.609046,
+		0.618124, 0.626507, 0.628056, 0.628507, 0.632236, 0.290750,
+		0.279989, 0.268610, 0.500000, 0.725154, 0.337472, 0.675686,
+		0.679096, 0.693933, 0.691108, 0.707052, 0.708187, 0.713007,
+		0.425788, 0.428390, 0.419514, 0.697183, 0.636595, 0.716832,
+		0.454967, 0.610464, 0.619628, 0.627612, 0.641690, 0.654639,
+		0.655147, 0.431621, 0.431765, 0.433167, 0.698789, 0.646039,
+		0.720837, 0.647424, 0.465965, 0.605937, 0.611471, 0.625549,
+		0.637977, 0.651106, 0.440708, 0.439925, 0.439298, 0.699865,
+		0.655639, 0.737176, 0.657118, 0.642708, 0.480232, 0.600636,
+		0.614715, 0.624376, 0.637505, 0.434407, 0.439284, 0.438657,
+		0.696423, 0.664542, 0.733965, 0.665592, 0.648542, 0.637238,
+		0.483008, 0.599042, 0.608699, 0.621828, 0.438828, 0.443705,
+		0.443078, 0.701645, 0.666360, 0.751154, 0.680617, 0.663567,
+		0.652263, 0.635298, 0.492782, 0.613411, 0.626543, 0.438869,
+		0.443745, 0.443118, 0.702525, 0.666841, 0.752325, 0.694608,
+		0.676988, 0.662671, 0.645706, 0.650892, 0.497446, 0.627081,
+		0.439859, 0.444735, 0.444108, 0.707390, 0.670921, 0.757516,
+		0.695175, 0.691145, 0.676829, 0.659864, 0.665049, 0.665594,
+		0.503700,
+	},
+	{ // T9o
+		0.183898, 0.351464, 0.349872, 0.347963, 0.268580, 0.291936,
+		0.413866, 0.418534, 0.424437, 0.427053, 0.427062, 0.427066,
+		0.427911, 0.369062, 0.171889, 0.340410, 0.338501, 0.274485,
+		0.282736, 0.416955, 0.419208, 0.424269, 0.432117, 0.432127,
+		0.432130, 0.432976, 0.366762, 0.356649, 0.157089, 0.326476,
+		0.268343, 0.272918, 0.408742, 0.421605, 0.424251, 0.432099,
+		0.432109, 0.432113, 0.432958, 0.364140, 0.354027, 0.341196,
+		0.141986, 0.258832, 0.264596, 0.401261, 0.414124, 0.426987,
+		0.432813, 0.432823, 0.432827, 0.433672, 0.279192, 0.284822,
+		0.277437, 0.266407, 0.128313, 0.475079, 0.657066, 0.658196,
+		0.658792, 0.661973, 0.662791, 0.663633, 0.668119, 0.306713,
+		0.296016, 0.284636, 0.274846, 0.500000, 0.352842, 0.683434,
+		0.683894, 0.687326, 0.701924, 0.703003, 0.704096, 0.708874,
+		0.436235, 0.438837, 0.429961, 0.421872, 0.696010, 0.724972,
+		0.462886, 0.621208, 0.628496, 0.637774, 0.649701, 0.662650,
+		0.663158, 0.441639, 0.441784, 0.443614, 0.435525, 0.697533,
+		0.725771, 0.658486, 0.473371, 0.612513, 0.621792, 0.633326,
+		0.645754, 0.658882, 0.448402, 0.447619, 0.446992, 0.449181,
+		0.698485, 0.729799, 0.666228, 0.649347, 0.484752, 0.610932,
+		0.620018, 0.629679, 0.642808, 0.451621, 0.456498, 0.455871,
+		0.456031, 0.702247, 0.745880, 0.676028, 0.659147, 0.647817,
+		0.500080, 0.614959, 0.615464, 0.628593, 0.451649, 0.456526,
+		0.455899, 0.456059, 0.703081, 0.746938, 0.688818, 0.671509,
+		0.657537, 0.652474, 0.503917, 0.615932, 0.629063, 0.451690,
+		0.456566, 0.455939, 0.456099, 0.703961, 0.748068, 0.702808,
+		0.684930, 0.667945, 0.652955, 0.653425, 0.508287, 0.629601,
+		0.452680, 0.457557, 0.456929, 0.457090, 0.708827, 0.753217,
+		0.703376, 0.699087, 0.682103, 0.667113, 0.667583, 0.668127,
+		0.514204,
+	},
+	{ // 99
+		0.191394, 0.525780, 0.526971, 0.527739, 0.528506, 0.663997,
+		0.675873, 0.674029, 0.675812, 0.660383, 0.665582, 0.665589,
+		0.669223, 0.552712, 0.191834, 0.525574, 0.526341, 0.527109,
+		0.653714, 0.682692, 0.678976, 0.677133, 0.675550, 0.680748,
+		0.680756, 0.684389, 0.553862, 0.552465, 0.188097, 0.519113,
+		0.519880, 0.639680, 0.678121, 0.682187, 0.678472, 0.676889,
+		0.682088, 0.682095, 0.685729, 0.554583, 0.553186, 0.545548,
+		0.183917, 0.512271, 0.625599, 0.673581, 0.677648, 0.681714,
+		0.678260, 0.683459, 0.683466, 0.687099, 0.555304, 0.553907,
+		0.546270, 0.538235, 0.179988, 0.611549, 0.669073, 0.673140,
+		0.677206, 0.681013, 0.684861, 0.684868, 0.688501, 0.704511,
+		0.693279, 0.677930, 0.662528, 0.647158, 0.500000, 0.821698,
+		0.834756, 0.847893, 0.860811, 0.877980, 0.877987, 0.881987,
+		0.711764, 0.719018, 0.714144, 0.709302, 0.704491, 0.870217,
+		0.819361, 0.816207, 0.817146, 0.818235, 0.826175, 0.842130,
+		0.842137, 0.709727, 0.714967, 0.718299, 0.713457, 0.708646,
+		0.884331, 0.857192, 0.815592, 0.802061, 0.803151, 0.811092,
+		0.827046, 0.843497, 0.711633, 0.712933, 0.714251, 0.717614,
+		0.712803, 0.898531, 0.858104, 0.842051, 0.811829, 0.788591,
+		0.796532, 0.811965, 0.828416, 0.694999, 0.711189, 0.712507,
+		0.713856, 0.716682, 0.912493, 0.859165, 0.843112, 0.827632,
+		0.808362, 0.780984, 0.793100, 0.809551, 0.700667, 0.716857,
+		0.718175, 0.719524, 0.720905, 0.931124, 0.867551, 0.851498,
+		0.836018, 0.819471, 0.813120, 0.798314, 0.814768, 0.700705,
+		0.716894, 0.718212, 0.719562, 0.720943, 0.931152, 0.884704,
+		0.868651, 0.852601, 0.832473, 0.838140, 0.813368, 0.814799,
+		0.704712, 0.720901, 0.722219, 0.723569, 0.724950, 0.935554,
+		0.884770, 0.886245, 0.870195, 0.850066, 0.855734, 0.855772,
+		0.817826,
+	},
+	{ // 98s
+		0.220598, 0.371176, 0.381464, 0.382623, 0.381166, 0.324086,
+		0.327098, 0.438766, 0.444214, 0.444357, 0.452658, 0.452662,
+		0.452665, 0.396714, 0.217475, 0.371413, 0.372875, 0.371417,
+		0.312964, 0.331167, 0.437577, 0.442206, 0.447462, 0.455764,
+		0.455767, 0.455771, 0.407081, 0.396431, 0.210050, 0.365699,
+		0.364242, 0.317774, 0.325380, 0.443017, 0.445177, 0.450434,
+		0.458735, 0.458739, 0.458743, 0.407979, 0.397640, 0.389920,
+		0.196885, 0.352983, 0.313178, 0.317845, 0.436013, 0.448521,
+		0.451414, 0.459715, 0.459719, 0.459723, 0.406111, 0.395772,
+		0.388052, 0.376141, 0.183024, 0.306799, 0.312343, 0.429698,
+		0.442206, 0.454958, 0.461280, 0.461284, 0.461287, 0.337954,
+		0.325652, 0.330090, 0.324314, 0.316566, 0.178302, 0.500000,
+		0.646542, 0.648628, 0.649608, 0.656575, 0.657339, 0.658132,
+		0.341051, 0.345199, 0.338171, 0.329194, 0.322354, 0.524921,
+		0.390047, 0.681745, 0.682921, 0.686354, 0.703898, 0.704893,
+		0.705946, 0.466339, 0.465069, 0.470406, 0.462888, 0.456112,
+		0.682870, 0.720858, 0.490026, 0.640164, 0.647145, 0.659612,
+		0.671288, 0.683696, 0.472294, 0.470137, 0.472931, 0.476028,
+		0.469252, 0.685460, 0.722450, 0.668566, 0.499938, 0.632128,
+		0.644597, 0.655889, 0.667808, 0.472582, 0.475885, 0.478679,
+		0.479360, 0.482660, 0.686866, 0.726519, 0.675932, 0.660399,
+		0.510933, 0.631146, 0.640052, 0.649355, 0.481629, 0.484933,
+		0.487726, 0.488408, 0.489705, 0.694834, 0.745931, 0.689092,
+		0.673559, 0.659685, 0.526122, 0.650936, 0.651416, 0.481679,
+		0.484982, 0.487776, 0.488457, 0.489754, 0.695654, 0.746988,
+		0.701521, 0.685577, 0.669154, 0.680912, 0.529971, 0.651929,
+		0.481753, 0.485056, 0.487850, 0.488531, 0.489828, 0.696524,
+		0.748127, 0.714630, 0.698165, 0.678957, 0.681459, 0.681981,
+		0.534303,
+	},
+	{ // 97s
+		0.208197, 0.374049, 0.370983, 0.372444, 0.370987, 0.312674,
+		0.399883, 0.316651, 0.432846, 0.432989, 0.440906, 0.440910,
+		0.444746, 0.399689, 0.209411, 0.365799, 0.367260, 0.365803,
+		0.315364, 0.404535, 0.322162, 0.433867, 0.439123, 0.447041,
+		0.447045, 0.450881, 0.396419, 0.390762, 0.206161, 0.356463,
+		0.355308, 0.303607, 0.394154, 0.325699, 0.432008, 0.437265,
+		0.445182, 0.445186, 0.449022, 0.397629, 0.391972, 0.380507,
+		0.195535, 0.345877, 0.309544, 0.385181, 0.318163, 0.435352,
+		0.438245, 0.446163, 0.446166, 0.450002, 0.395760, 0.390104,
+		0.378950, 0.368917, 0.182086, 0.305867, 0.376846, 0.312691,
+		0.429067, 0.441819, 0.447757, 0.447760, 0.451596, 0.325697,
+		0.328268, 0.315275, 0.320904, 0.316106, 0.165244, 0.353458,
+		0.500000, 0.618226, 0.620937, 0.625763, 0.626486, 0.630946,
+		0.426224, 0.431065, 0.420032, 0.410486, 0.401633, 0.368151,
+		0.332402, 0.675519, 0.618542, 0.627179, 0.639253, 0.650929,
+		0.652531, 0.329805, 0.335423, 0.338985, 0.330009, 0.323199,
+		0.524925, 0.714337, 0.382245, 0.668129, 0.671562, 0.689106,
+		0.690101, 0.704926, 0.460410, 0.461353, 0.459350, 0.462447,
+		0.455701, 0.652814, 0.646545, 0.706491, 0.487983, 0.628246,
+		0.640412, 0.651704, 0.665534, 0.460698, 0.467101, 0.465098,
+		0.465780, 0.469109, 0.656082, 0.655615, 0.710560, 0.656297,
+		0.498977, 0.624344, 0.633250, 0.647081, 0.469328, 0.475731,
+		0.473728, 0.474409, 0.475736, 0.661706, 0.668366, 0.729963,
+		0.669140, 0.652480, 0.513742, 0.635308, 0.649142, 0.469377,
+		0.475780, 0.473777, 0.474459, 0.475785, 0.662485, 0.680795,
+		0.731021, 0.681157, 0.661950, 0.664445, 0.517591, 0.649655,
+		0.473642, 0.480045, 0.478042, 0.478723, 0.480049, 0.667479,
+		0.682650, 0.747177, 0.695825, 0.676618, 0.679113, 0.679635,
+		0.527289,
+	},
+	{ // 96s
+		0.195924, 0.365525, 0.365790, 0.362724, 0.361568, 0.301626,
+		0.392212, 0.392216, 0.306626, 0.424207, 0.432124, 0.432128,
+		0.435964, 0.390927, 0.197011, 0.359786, 0.356720, 0.355565,
+		0.303934, 0.396045, 0.393236, 0.311716, 0.427696, 0.435614,
+		0.435618, 0.439070, 0.391062, 0.384517, 0.194778, 0.348402,
+		0.347246, 0.305989, 0.386093, 0.394497, 0.313791, 0.426250,
+		0.434167, 0.434171, 0.437623, 0.387791, 0.381247, 0.372327,
+		0.191646, 0.336582, 0.295360, 0.376279, 0.384682, 0.318540,
+		0.425017, 0.432934, 0.432938, 0.436390, 0.386234, 0.379690,
+		0.370769, 0.359441, 0.180736, 0.302216, 0.368024, 0.376428,
+		0.313067, 0.428590, 0.434528, 0.434532, 0.437985, 0.313849,
+		0.315989, 0.317861, 0.306067, 0.312674, 0.152107, 0.351372,
+		0.381774, 0.500000, 0.585870, 0.593228, 0.593911, 0.595429,
+		0.418489, 0.422442, 0.411845, 0.401409, 0.392636, 0.366432,
+		0.328336, 0.565689, 0.675172, 0.618775, 0.630849, 0.642525,
+		0.641021, 0.418495, 0.419438, 0.420644, 0.410208, 0.401435,
+		0.399025, 0.592053, 0.334050, 0.662203, 0.608536, 0.620610,
+		0.632286, 0.644067, 0.319037, 0.324182, 0.326043, 0.330893,
+		0.324083, 0.524935, 0.714345, 0.700297, 0.374968, 0.657804,
+		0.675350, 0.676345, 0.691169, 0.451564, 0.455155, 0.453479,
+		0.452136, 0.455465, 0.618315, 0.647030, 0.636246, 0.695702,
+		0.487061, 0.621009, 0.629916, 0.643444, 0.460193, 0.463784,
+		0.462108, 0.460765, 0.462092, 0.626679, 0.659781, 0.648997,
+		0.715105, 0.648959, 0.501825, 0.629356, 0.642888, 0.460242,
+		0.463833, 0.462158, 0.460815, 0.462141, 0.627416, 0.672210,
+		0.661426, 0.716163, 0.658429, 0.658139, 0.505674, 0.643401,
+		0.464501, 0.467680, 0.466004, 0.464661, 0.465988, 0.629198,
+		0.670753, 0.673980, 0.732311, 0.672780, 0.672490, 0.673012,
+		0.514949,
+	},
+	{ // 95s
+		0.180957, 0.353523, 0.353787, 0.351435, 0.348369, 0.286920,
+		0.381062, 0.381066, 0.383475, 0.293394, 0.411612, 0.411615,
+		0.415068, 0.378318, 0.184870, 0.350628, 0.348275, 0.345209,
+		0.292683, 0.387739, 0.384931, 0.384934, 0.301505, 0.423936,
+		0.423940, 0.427392, 0.378453, 0.375065, 0.182638, 0.339957,
+		0.336891, 0.294778, 0.377787, 0.386191, 0.383487, 0.303581,
+		0.422489, 0.422493, 0.425945, 0.375820, 0.372433, 0.363513,
+		0.180003, 0.328321, 0.297960, 0.368018, 0.376422, 0.384885,
+		0.306396, 0.421179, 0.421183, 0.424635, 0.372550, 0.369163,
+		0.360242, 0.351065, 0.177107, 0.288250, 0.359306, 0.367710,
+		0.376173, 0.313679, 0.421049, 0.421053, 0.424505, 0.297871,
+		0.303906, 0.305820, 0.308892, 0.298076, 0.139189, 0.350392,
+		0.379063, 0.414130, 0.500000, 0.558247, 0.558890, 0.560080,
+		0.406906, 0.414016, 0.403419, 0.393026, 0.383756, 0.365903,
+		0.324530, 0.552937, 0.566136, 0.674560, 0.622683, 0.634358,
+		0.632366, 0.406912, 0.411012, 0.412219, 0.401825, 0.392555,
+		0.396632, 0.578929, 0.330244, 0.557703, 0.661649, 0.612503,
+		0.624179, 0.635959, 0.409495, 0.411023, 0.409347, 0.410692,
+		0.401422, 0.434347, 0.592751, 0.583822, 0.336464, 0.648988,
+		0.602616, 0.613908, 0.625689, 0.304684, 0.313212, 0.315073,
+		0.317701, 0.325237, 0.524956, 0.714074, 0.700087, 0.686383,
+		0.368561, 0.658759, 0.659757, 0.674581, 0.438604, 0.451559,
+		0.449884, 0.448347, 0.448170, 0.588921, 0.651444, 0.640719,
+		0.630308, 0.697116, 0.489670, 0.626603, 0.640134, 0.438653,
+		0.451609, 0.449933, 0.448396, 0.448219, 0.589618, 0.663873,
+		0.653148, 0.642325, 0.698173, 0.655227, 0.493519, 0.640647,
+		0.442500, 0.455455, 0.453780, 0.452242, 0.452066, 0.591041,
+		0.661895, 0.665702, 0.654879, 0.714321, 0.669577, 0.670099,
+		0.502793,
+	},
+	{ // 94s
+		0.164506, 0.341007, 0.341272, 0.338920, 0.336079, 0.272010,
+		0.369400, 0.369404, 0.371812, 0.362786, 0.281496, 0.399645,
+		0.403098, 0.365316, 0.168419, 0.338113, 0.335760, 0.332919,
+		0.277813, 0.376077, 0.373269, 0.373272, 0.373363, 0.289607,
+		0.411970, 0.415422, 0.365450, 0.362063, 0.166187, 0.327442,
+		0.324601, 0.279948, 0.366125, 0.374529, 0.371825, 0.371916,
+		0.291683, 0.410523, 0.413975, 0.362818, 0.359430, 0.350510,
+		0.163551, 0.316032, 0.283171, 0.356356, 0.364760, 0.373223,
+		0.370606, 0.294498, 0.409213, 0.412666, 0.359664, 0.356277,
+		0.347356, 0.338179, 0.161152, 0.287273, 0.347690, 0.356093,
+		0.364556, 0.372723, 0.299848, 0.409006, 0.412458, 0.281804,
+		0.287880, 0.289835, 0.292948, 0.296997, 0.122020, 0.343425,
+		0.374237, 0.406772, 0.441753, 0.500000, 0.526861, 0.529513,
+		0.394936, 0.402046, 0.391449, 0.381056, 0.371828, 0.358860,
+		0.316836, 0.539609, 0.552808, 0.566287, 0.675635, 0.622749,
+		0.620756, 0.394942, 0.399042, 0.400248, 0.389855, 0.380628,
+		0.391924, 0.565224, 0.322551, 0.544375, 0.557855, 0.662724,
+		0.612569, 0.624350, 0.397524, 0.399052, 0.397377, 0.398722,
+		0.389495, 0.426899, 0.579046, 0.570117, 0.328771, 0.549774,
+		0.650123, 0.602358, 0.614138, 0.387990, 0.399149, 0.397473,
+		0.395936, 0.398036, 0.464585, 0.593154, 0.584225, 0.575671,
+		0.335454, 0.638944, 0.589593, 0.601373, 0.291888, 0.300416,
+		0.302277, 0.304905, 0.310219, 0.524992, 0.715670, 0.701683,
+		0.688039, 0.675923, 0.361077, 0.658640, 0.673469, 0.426238,
+		0.439194, 0.437518, 0.435981, 0.435610, 0.555057, 0.651999,
+		0.641274, 0.630511, 0.617010, 0.697050, 0.482445, 0.636134,
+		0.430085, 0.443041, 0.441365, 0.439828, 0.439457, 0.558079,
+		0.650021, 0.653828, 0.643065, 0.629564, 0.713198, 0.665486,
+		0.491719,
+	},
+	{ // 93s
+		0.164499, 0.340590, 0.340855, 0.338503, 0.335662, 0.270814,
+		0.367858, 0.370967, 0.373864, 0.364454, 0.367988, 0.281496,
+		0.402680, 0.364871, 0.168412, 0.337695, 0.335343, 0.332502,
+		0.276657, 0.374535, 0.374832, 0.375324, 0.375031, 0.378565,
+		0.289608, 0.414946, 0.365005, 0.361618, 0.166180, 0.327025,
+		0.324184, 0.278832, 0.364583, 0.376092, 0.373877, 0.373584,
+		0.377118, 0.291683, 0.413499, 0.362373, 0.358985, 0.350065,
+		0.163544, 0.315614, 0.282095, 0.354814, 0.366323, 0.375275,
+		0.372274, 0.375808, 0.294498, 0.412189, 0.359219, 0.355832,
+		0.346911, 0.337734, 0.161145, 0.286237, 0.346147, 0.357656,
+		0.366608, 0.374775, 0.375601, 0.299848, 0.411982, 0.280544,
+		0.286662, 0.288658, 0.291813, 0.295904, 0.122013, 0.342661,
+		0.373514, 0.406089, 0.441110, 0.473139, 0.500000, 0.528298,
+		0.393323, 0.400433, 0.389837, 0.379443, 0.370216, 0.358051,
+		0.312988, 0.533287, 0.546870, 0.560349, 0.574121, 0.689466,
+		0.619930, 0.396618, 0.400717, 0.401924, 0.391531, 0.382303,
+		0.391157, 0.558817, 0.322119, 0.540906, 0.554386, 0.568158,
+		0.676555, 0.623523, 0.399721, 0.401249, 0.399573, 0.400919,
+		0.391692, 0.426173, 0.573032, 0.566629, 0.328860, 0.546793,
+		0.560566, 0.663481, 0.613695, 0.389775, 0.400934, 0.399258,
+		0.397721, 0.400233, 0.463900, 0.587141, 0.580737, 0.572686,
+		0.335543, 0.552571, 0.649403, 0.601035, 0.393685, 0.404845,
+		0.403169, 0.401632, 0.401261, 0.498656, 0.601706, 0.595303,
+		0.587251, 0.578753, 0.345212, 0.653613, 0.605372, 0.291949,
+		0.300477, 0.302338, 0.304966, 0.310280, 0.525053, 0.730730,
+		0.716742, 0.702579, 0.687299, 0.691973, 0.366132, 0.673517,
+		0.429640, 0.442533, 0.440857, 0.439320, 0.438949, 0.556824,
+		0.649161, 0.652968, 0.642598, 0.629207, 0.633902, 0.713259,
+		0.491715,
+	},
+	{ // 92s
+		0.160441, 0.337060, 0.337325, 0.334973, 0.332132, 0.266235,
+		0.367381, 0.366658, 0.372277, 0.363310, 0.366844, 0.366847,
+		0.280045, 0.361135, 0.164354, 0.334165, 0.331813, 0.328972,
+		0.272118, 0.374058, 0.370523, 0.373632, 0.373827, 0.377361,
+		0.377365, 0.288156, 0.361270, 0.357882, 0.162122, 0.323495,
+		0.320654, 0.274334, 0.364106, 0.371783, 0.372185, 0.372380,
+		0.375914, 0.375918, 0.290232, 0.358637, 0.355250, 0.346330,
+		0.159487, 0.312084, 0.277637, 0.354337, 0.362014, 0.373583,
+		0.371071, 0.374605, 0.374608, 0.293047, 0.355484, 0.352096,
+		0.343176, 0.333999, 0.157088, 0.281819, 0.345670, 0.353347,
+		0.364916, 0.373571, 0.374398, 0.374401, 0.298397, 0.275600,
+		0.281759, 0.283798, 0.286993, 0.291126, 0.118013, 0.341868,
+		0.369054, 0.404571, 0.439920, 0.470487, 0.471702, 0.500000,
+		0.392809, 0.399919, 0.389322, 0.378929, 0.369702, 0.357219,
+		0.312981, 0.533284, 0.546866, 0.560346, 0.574117, 0.586223,
+		0.689466, 0.392169, 0.396268, 0.397475, 0.387082, 0.377855,
+		0.386435, 0.558807, 0.316920, 0.532605, 0.546469, 0.560240,
+		0.572346, 0.688453, 0.398167, 0.399567, 0.397891, 0.399236,
+		0.390009, 0.424656, 0.573022, 0.558201, 0.326556, 0.540961,
+		0.554734, 0.566351, 0.675379, 0.388677, 0.399773, 0.398097,
+		0.396560, 0.399072, 0.462742, 0.587131, 0.572702, 0.566783,
+		0.333760, 0.547122, 0.556125, 0.661301, 0.392587, 0.403683,
+		0.402008, 0.400471, 0.400100, 0.495899, 0.601696, 0.587268,
+		0.581348, 0.573243, 0.343429, 0.560457, 0.665516, 0.392637,
+		0.403733, 0.402057, 0.400520, 0.400149, 0.497179, 0.614470,
+		0.600041, 0.593600, 0.582710, 0.587405, 0.348265, 0.666094,
+		0.290461, 0.298989, 0.300850, 0.303478, 0.308792, 0.525147,
+		0.730824, 0.729789, 0.715626, 0.700346, 0.705020, 0.705605,
+		0.370204,
+	},
+	{ // A8o
+		0.066539, 0.248950, 0.254565, 0.260929, 0.270697, 0.294001,
+		0.475077, 0.628034, 0.627751, 0.612391, 0.613928, 0.618661,
+		0.619493, 0.260674, 0.283328, 0.541188, 0.541268, 0.540992,
+		0.557987, 0.713584, 0.600651, 0.597451, 0.598099, 0.599655,
+		0.604040, 0.604555, 0.266318, 0.574526, 0.284512, 0.534128,
+		0.533852, 0.550847, 0.698722, 0.603303, 0.597220, 0.597867,
+		0.599423, 0.603809, 0.604323, 0.272779, 0.574464, 0.566644,
+		0.283267, 0.524539, 0.541533, 0.683922, 0.592192, 0.599928,
+		0.597281, 0.598837, 0.603223, 0.603737, 0.282932, 0.574036,
+		0.566217, 0.556136, 0.282187, 0.531871, 0.669186, 0.580734,
+		0.588469, 0.600052, 0.598314, 0.602699, 0.603214, 0.307694,
+		0.592113, 0.584293, 0.574212, 0.563765, 0.288236, 0.658949,
+		0.573776, 0.581511, 0.593094, 0.605064, 0.606677, 0.607191,
+		0.500000, 0.755872, 0.739791, 0.723764, 0.707801, 0.696854,
+		0.300336, 0.661470, 0.673318, 0.689296, 0.705364, 0.721495,
+		0.722818, 0.666116, 0.638358, 0.641102, 0.629158, 0.616848,
+		0.609565, 0.701702, 0.435341, 0.589048, 0.602422, 0.616189,
+		0.630330, 0.643342, 0.665847, 0.634977, 0.634598, 0.637399,
+		0.625090, 0.617806, 0.714424, 0.625323, 0.439524, 0.587924,
+		0.601692, 0.615833, 0.628845, 0.649232, 0.635626, 0.635247,
+		0.634497, 0.637361, 0.630077, 0.731491, 0.639457, 0.624000,
+		0.447468, 0.589846, 0.603988, 0.616479, 0.650971, 0.637406,
+		0.637027, 0.636277, 0.635590, 0.642910, 0.748797, 0.654153,
+		0.638695, 0.626010, 0.457558, 0.608701, 0.618421, 0.656194,
+		0.642281, 0.641902, 0.641152, 0.640464, 0.644803, 0.766186,
+		0.669253, 0.653796, 0.641111, 0.646290, 0.468787, 0.623205,
+		0.657078, 0.642842, 0.642463, 0.641713, 0.641026, 0.645364,
+		0.767559, 0.683323, 0.667866, 0.654611, 0.656778, 0.662039,
+		0.475039,
+	},
+	{ // K8o
+		0.118558, 0.232432, 0.335213, 0.331986, 0.328760, 0.340632,
+		0.233705, 0.373645, 0.377190, 0.374922, 0.378466, 0.382404,
+		0.382408, 0.240579, 0.062295, 0.247532, 0.256926, 0.265911,
+		0.287174, 0.475077, 0.627552, 0.625696, 0.623011, 0.624548,
+		0.629280, 0.630112, 0.352811, 0.258776, 0.277966, 0.531891,
+		0.531615, 0.548610, 0.694574, 0.602296, 0.596688, 0.594179,
+		0.595735, 0.600121, 0.600635, 0.349167, 0.268526, 0.564478,
+		0.276151, 0.521890, 0.538885, 0.679774, 0.590774, 0.599397,
+		0.593593, 0.595149, 0.599534, 0.600049, 0.345523, 0.277842,
+		0.564050, 0.553540, 0.275030, 0.529223, 0.665038, 0.579315,
+		0.587938, 0.596364, 0.594625, 0.599011, 0.599526, 0.358127,
+		0.300421, 0.582120, 0.571610, 0.561163, 0.280982, 0.654801,
+		0.568935, 0.577558, 0.585984, 0.597954, 0.599567, 0.600081,
+		0.244128, 0.500000, 0.735500, 0.719474, 0.703510, 0.692558,
+		0.298373, 0.657580, 0.669859, 0.681942, 0.697968, 0.714057,
+		0.715339, 0.394067, 0.665610, 0.640072, 0.627699, 0.615389,
+		0.604376, 0.697762, 0.431563, 0.587931, 0.598148, 0.611915,
+		0.626056, 0.639068, 0.398028, 0.663623, 0.634067, 0.636868,
+		0.624559, 0.613546, 0.710941, 0.624133, 0.439089, 0.586808,
+		0.600576, 0.614717, 0.627729, 0.395691, 0.660741, 0.631397,
+		0.630647, 0.633511, 0.622498, 0.723903, 0.634948, 0.622803,
+		0.447541, 0.586376, 0.600519, 0.613009, 0.399677, 0.662481,
+		0.633178, 0.632427, 0.631740, 0.635331, 0.741168, 0.649644,
+		0.637499, 0.622237, 0.457379, 0.605231, 0.614952, 0.404110,
+		0.667704, 0.638053, 0.637302, 0.636615, 0.637224, 0.758515,
+		0.664745, 0.652599, 0.637337, 0.642517, 0.468313, 0.619736,
+		0.404167, 0.668588, 0.638614, 0.637864, 0.637176, 0.637785,
+		0.759847, 0.678815, 0.666669, 0.650838, 0.653004, 0.658265,
+		0.474229,
+	},
+	{ // Q8o
+		0.136091, 0.317055, 0.245394, 0.343990, 0.340763, 0.352636,
+		0.248706, 0.389351, 0.389361, 0.387093, 0.390638, 0.394576,
+		0.394580, 0.332868, 0.132837, 0.232910, 0.334037, 0.330810,
+		0.342683, 0.252885, 0.388156, 0.387324, 0.390288, 0.393832,
+		0.397770, 0.397774, 0.254455, 0.240793, 0.079212, 0.258679,
+		0.267582, 0.288731, 0.475078, 0.634025, 0.631126, 0.628441,
+		0.629978, 0.634711, 0.635543, 0.361603, 0.350915, 0.269905,
+		0.281152, 0.530492, 0.547457, 0.690558, 0.601870, 0.610450,
+		0.604646, 0.606202, 0.610588, 0.611102, 0.357959, 0.347271,
+		0.279138, 0.562448, 0.279988, 0.537795, 0.675822, 0.590411,
+		0.598992, 0.607417, 0.605679, 0.610064, 0.610579, 0.370563,
+		0.359875, 0.301602, 0.580486, 0.570039, 0.285856, 0.661829,
+		0.579968, 0.588155, 0.596581, 0.608551, 0.610163, 0.610678,
+		0.260209, 0.264500, 0.500000, 0.730845, 0.714882, 0.699862,
+		0.313369, 0.670426, 0.667832, 0.679873, 0.695858, 0.711906,
+		0.713146, 0.410979, 0.409691, 0.672586, 0.639152, 0.626843,
+		0.615766, 0.711833, 0.448522, 0.592457, 0.602673, 0.616440,
+		0.630581, 0.643594, 0.410975, 0.408759, 0.669350, 0.648272,
+		0.635962, 0.624457, 0.708881, 0.628928, 0.450624, 0.590375,
+		0.604143, 0.618284, 0.631297, 0.408638, 0.412081, 0.666468,
+		0.642051, 0.644915, 0.633410, 0.721801, 0.639743, 0.626464,
+		0.458866, 0.589944, 0.604086, 0.616577, 0.412624, 0.416068,
+		0.668208, 0.643831, 0.643144, 0.646243, 0.739024, 0.654439,
+		0.641160, 0.625898, 0.468451, 0.608799, 0.618519, 0.417057,
+		0.420501, 0.673431, 0.648706, 0.648018, 0.648136, 0.756329,
+		0.669539, 0.656261, 0.640998, 0.646178, 0.479091, 0.623303,
+		0.417114, 0.420558, 0.674315, 0.649267, 0.648580, 0.648697,
+		0.757620, 0.683609, 0.670331, 0.654499, 0.656665, 0.661926,
+		0.484671,
+	},
+	{ // J8o
+		0.153624, 0.329848, 0.328257, 0.258348, 0.352767, 0.364580,
+		0.263646, 0.396973, 0.405009, 0.399206, 0.402750, 0.406688,
+		0.406692, 0.346467, 0.150370, 0.317987, 0.245872, 0.342814,
+		0.354627, 0.267825, 0.395778, 0.402971, 0.402400, 0.405945,
+		0.409883, 0.409887, 0.344174, 0.333164, 0.138219, 0.251130,
+		0.332575, 0.344388, 0.258007, 0.397788, 0.402960, 0.402389,
+		0.405933, 0.409871, 0.409875, 0.268324, 0.254661, 0.259587,
+		0.096522, 0.269224, 0.290331, 0.475078, 0.633314, 0.635190,
+		0.632063, 0.633600, 0.638332, 0.639164, 0.370394, 0.359707,
+		0.348734, 0.280394, 0.284915, 0.545635, 0.685079, 0.600249,
+		0.609326, 0.617709, 0.615971, 0.620357, 0.620871, 0.382936,
+		0.372248, 0.361275, 0.302817, 0.578128, 0.290698, 0.670806,
+		0.589514, 0.598591, 0.606974, 0.618944, 0.620557, 0.621071,
+		0.276236, 0.280526, 0.269155, 0.500000, 0.724640, 0.709305,
+		0.328560, 0.664659, 0.679497, 0.676672, 0.692616, 0.708622,
+		0.709820, 0.418736, 0.417447, 0.418855, 0.671405, 0.636996,
+		0.625601, 0.705363, 0.453158, 0.602869, 0.608403, 0.622170,
+		0.636312, 0.649324, 0.427823, 0.425607, 0.424986, 0.673731,
+		0.646596, 0.635201, 0.721702, 0.639644, 0.467425, 0.594801,
+		0.608569, 0.622710, 0.635201, 0.421522, 0.424965, 0.424344,
+		0.670248, 0.655499, 0.644104, 0.718491, 0.645477, 0.631161,
+		0.470201, 0.592891, 0.607033, 0.619524, 0.425508, 0.428952,
+		0.428331, 0.671987, 0.653728, 0.656937, 0.735673, 0.660173,
+		0.645857, 0.628891, 0.479534, 0.611746, 0.621466, 0.429941,
+		0.433385, 0.432764, 0.677210, 0.658603, 0.658829, 0.752936,
+		0.675273, 0.660957, 0.643992, 0.649171, 0.489879, 0.626250,
+		0.429998, 0.433442, 0.432821, 0.678094, 0.659164, 0.659391,
+		0.754185, 0.689343, 0.674458, 0.657492, 0.659659, 0.664920,
+		0.495123,
+	},
+	{ // T8o
+		0.171157, 0.342642, 0.341050, 0.339141, 0.271301, 0.376465,
+		0.278527, 0.406669, 0.412572, 0.414794, 0.414804, 0.418742,
+		0.418746, 0.360066, 0.167903, 0.330780, 0.329188, 0.258826,
+		0.366572, 0.282706, 0.405473, 0.410534, 0.417988, 0.417998,
+		0.421936, 0.421940, 0.357773, 0.346763, 0.155752, 0.319041,
+		0.264084, 0.356333, 0.272888, 0.407876, 0.410523, 0.417977,
+		0.417987, 0.421925, 0.421929, 0.355151, 0.344464, 0.333584,
+		0.141078, 0.257761, 0.346775, 0.264597, 0.400427, 0.413290,
+		0.418722, 0.418732, 0.422670, 0.422674, 0.282192, 0.268529,
+		0.273455, 0.265884, 0.114200, 0.291890, 0.475078, 0.632564,
+		0.634367, 0.635010, 0.635787, 0.640519, 0.641351, 0.395245,
+		0.384621, 0.373648, 0.363405, 0.303990, 0.295509, 0.677646,
+		0.598367, 0.607364, 0.616244, 0.628172, 0.629784, 0.630298,
+		0.292199, 0.296490, 0.285118, 0.275360, 0.500000, 0.716499,
+		0.343805, 0.668186, 0.671618, 0.686217, 0.687295, 0.703260,
+		0.704417, 0.428754, 0.427465, 0.429302, 0.421245, 0.670190,
+		0.634695, 0.708953, 0.460522, 0.608966, 0.617933, 0.629467,
+		0.643608, 0.656620, 0.435517, 0.433301, 0.432680, 0.434901,
+		0.672435, 0.644206, 0.712982, 0.645765, 0.471903, 0.604307,
+		0.613393, 0.627534, 0.640025, 0.438307, 0.441751, 0.441130,
+		0.441322, 0.673440, 0.653589, 0.729062, 0.655242, 0.640899,
+		0.486802, 0.599176, 0.613319, 0.623043, 0.438329, 0.441773,
+		0.441152, 0.441344, 0.674227, 0.666373, 0.730112, 0.667597,
+		0.650613, 0.635623, 0.490627, 0.613786, 0.623507, 0.442763,
+		0.446206, 0.445585, 0.445777, 0.679450, 0.668266, 0.747334,
+		0.682698, 0.665713, 0.650723, 0.651187, 0.500678, 0.628291,
+		0.442819, 0.446263, 0.445642, 0.445834, 0.680334, 0.668827,
+		0.748541, 0.696768, 0.679214, 0.661211, 0.661674, 0.666935,
+		0.505585,
+	},
+	{ // 98o
+		0.182190, 0.341879, 0.352855, 0.354252, 0.352864, 0.285603,
+		0.288647, 0.411968, 0.417477, 0.417567, 0.425996, 0.426005,
+		0.426009, 0.359375, 0.178948, 0.342605, 0.344319, 0.342931,
+		0.273274, 0.292835, 0.410778, 0.415446, 0.420768, 0.429196,
+		0.429206, 0.429210, 0.370591, 0.359608, 0.170949, 0.337074,
+		0.335686, 0.278661, 0.286760, 0.416597, 0.418741, 0.424063,
+		0.432491, 0.432501, 0.432505, 0.371551, 0.360891, 0.353021,
+		0.156845, 0.324154, 0.273849, 0.278750, 0.409458, 0.422321,
+		0.425228, 0.433657, 0.433667, 0.433670, 0.369498, 0.358838,
+		0.350968, 0.338649, 0.141994, 0.267067, 0.272876, 0.403044,
+		0.415907, 0.429031, 0.435438, 0.435447, 0.435451, 0.297584,
+		0.284082, 0.289142, 0.283168, 0.275028, 0.129783, 0.475079,
+		0.631849, 0.633568, 0.634097, 0.641140, 0.641949, 0.642781,
+		0.303146, 0.307442, 0.300138, 0.290695, 0.283501, 0.500000,
+		0.354529, 0.671381, 0.672103, 0.675296, 0.693797, 0.694897,
+		0.696013, 0.434175, 0.432893, 0.438452, 0.430714, 0.423774,
+		0.669017, 0.712250, 0.464201, 0.616645, 0.623735, 0.636563,
+		0.648554, 0.661566, 0.440509, 0.438299, 0.441261, 0.444370,
+		0.437430, 0.671179, 0.713326, 0.653547, 0.475028, 0.607919,
+		0.620749, 0.632346, 0.644837, 0.440975, 0.444425, 0.447386,
+		0.448038, 0.451375, 0.672060, 0.717096, 0.661072, 0.644367,
+		0.486999, 0.606509, 0.615658, 0.625382, 0.450517, 0.453967,
+		0.456929, 0.457581, 0.458889, 0.680051, 0.737438, 0.674753,
+		0.658048, 0.643031, 0.503334, 0.626739, 0.627308, 0.450558,
+		0.454007, 0.456969, 0.457621, 0.458930, 0.680887, 0.738528,
+		0.687618, 0.670484, 0.652826, 0.665191, 0.507406, 0.627846,
+		0.450614, 0.454064, 0.457026, 0.457678, 0.458986, 0.681771,
+		0.739694, 0.701688, 0.683985, 0.663314, 0.665753, 0.666298,
+		0.511977,
+	},
+	{ // 88
+		0.195383, 0.524759, 0.525951, 0.526718, 0.527485, 0.543003,
+		0.659471, 0.673578, 0.675361, 0.659411, 0.661715, 0.666914,
+		0.666921, 0.551615, 0.191288, 0.512821, 0.514013, 0.514780,
+		0.530338, 0.661284, 0.673277, 0.671433, 0.669329, 0.671634,
+		0.676832, 0.676839, 0.552777, 0.538702, 0.191299, 0.509472,
+		0.510239, 0.525836, 0.647566, 0.676619, 0.672903, 0.670799,
+		0.673104, 0.678302, 0.678309, 0.553498, 0.539852, 0.535016,
+		0.187688, 0.503081, 0.518718, 0.633682, 0.672198, 0.676264,
+		0.672289, 0.674593, 0.679791, 0.679799, 0.554219, 0.540573,
+		0.535738, 0.528174, 0.183760, 0.511219, 0.619751, 0.667808,
+		0.671875, 0.675681, 0.676113, 0.681312, 0.681319, 0.570952,
+		0.557348, 0.552554, 0.545033, 0.537114, 0.180639, 0.609953,
+		0.667598, 0.671664, 0.675470, 0.683164, 0.687012, 0.687019,
+		0.699664, 0.701627, 0.686631, 0.671440, 0.656195, 0.645471,
+		0.500000, 0.819652, 0.832710, 0.845589, 0.862758, 0.879928,
+		0.879935, 0.709333, 0.709017, 0.712488, 0.707772, 0.703087,
+		0.702944, 0.868054, 0.818206, 0.814965, 0.815643, 0.821044,
+		0.828984, 0.844938, 0.711239, 0.706983, 0.708440, 0.711930,
+		0.707245, 0.707102, 0.882170, 0.855876, 0.814444, 0.800561,
+		0.805963, 0.813903, 0.829857, 0.694035, 0.704670, 0.706126,
+		0.707602, 0.711123, 0.710980, 0.896090, 0.856508, 0.840458,
+		0.810406, 0.787096, 0.795038, 0.810470, 0.696538, 0.707172,
+		0.708628, 0.710104, 0.711611, 0.719105, 0.914705, 0.862244,
+		0.846194, 0.826065, 0.811417, 0.800252, 0.812369, 0.702218,
+		0.712852, 0.714308, 0.715784, 0.717292, 0.723341, 0.933344,
+		0.870642, 0.854592, 0.834463, 0.840119, 0.816200, 0.817591,
+		0.702272, 0.712906, 0.714362, 0.715838, 0.717346, 0.723395,
+		0.933383, 0.887812, 0.871762, 0.851063, 0.853137, 0.858817,
+		0.816481,
+	},
+	{ // 87s
+		0.224634, 0.386024, 0.382958, 0.384420, 0.382962, 0.386293,
+		0.324571, 0.330181, 0.442162, 0.441921, 0.447753, 0.456054,
+		0.456058, 0.412111, 0.220657, 0.367332, 0.377619, 0.378779,
+		0.382636, 0.328410, 0.331985, 0.439350, 0.444223, 0.450055,
+		0.458356, 0.458360, 0.408841, 0.392570, 0.217406, 0.366844,
+		0.368306, 0.372217, 0.316795, 0.335522, 0.437491, 0.442364,
+		0.448196, 0.456498, 0.456501, 0.410056, 0.402937, 0.391496,
+		0.210099, 0.361208, 0.365667, 0.322857, 0.330947, 0.443557,
+		0.445962, 0.451794, 0.460095, 0.460099, 0.408188, 0.403836,
+		0.392706, 0.385065, 0.197171, 0.355511, 0.320383, 0.325720,
+		0.437560, 0.450312, 0.453781, 0.462083, 0.462086, 0.411461,
+		0.407669, 0.396596, 0.389536, 0.378792, 0.183793, 0.318255,
+		0.324481, 0.434311, 0.447063, 0.460391, 0.466713, 0.466716,
+		0.338530, 0.342420, 0.329574, 0.335341, 0.331814, 0.328619,
+		0.180348, 0.500000, 0.617152, 0.619782, 0.624498, 0.631453,
+		0.632206, 0.344414, 0.346100, 0.349663, 0.343922, 0.337392,
+		0.335127, 0.524925, 0.392370, 0.666114, 0.667299, 0.674310,
+		0.691871, 0.692884, 0.469946, 0.466954, 0.464951, 0.470948,
+		0.464495, 0.460987, 0.651262, 0.704291, 0.491927, 0.632792,
+		0.643131, 0.655657, 0.667392, 0.469822, 0.472290, 0.470287,
+		0.473741, 0.477903, 0.474395, 0.654449, 0.705889, 0.660736,
+		0.502352, 0.625015, 0.637544, 0.648895, 0.476215, 0.478684,
+		0.476681, 0.480135, 0.481881, 0.488449, 0.659962, 0.713893,
+		0.671700, 0.652854, 0.514272, 0.639580, 0.648547, 0.485281,
+		0.487750, 0.485747, 0.489201, 0.490947, 0.495512, 0.667935,
+		0.733340, 0.684938, 0.666092, 0.668567, 0.529655, 0.659502,
+		0.485355, 0.487824, 0.485821, 0.489275, 0.491021, 0.495586,
+		0.668765, 0.734438, 0.697451, 0.678193, 0.678121, 0.689955,
+		0.533671,
+	},
+	{ // 86s
+		0.212361, 0.377500, 0.377765, 0.374699, 0.373543, 0.376874,
+		0.313523, 0.403279, 0.320156, 0.433139, 0.438971, 0.447272,
+		0.447276, 0.403349, 0.208256, 0.370145, 0.367079, 0.368541,
+		0.372397, 0.316980, 0.400467, 0.321538, 0.432796, 0.438628,
+		0.446546, 0.446549, 0.403489, 0.395483, 0.209342, 0.361170,
+		0.362632, 0.366543, 0.319176, 0.404449, 0.326516, 0.433966,
+		0.439798, 0.447716, 0.447719, 0.400219, 0.392212, 0.385765,
+		0.206209, 0.351912, 0.356674, 0.308672, 0.394694, 0.331265,
+		0.432733, 0.438565, 0.446483, 0.446487, 0.398662, 0.393422,
+		0.386974, 0.375589, 0.195821, 0.348346, 0.316732, 0.386728,
+		0.326038, 0.437084, 0.440553, 0.448470, 0.448474, 0.401934,
+		0.397255, 0.390865, 0.380372, 0.371504, 0.182854, 0.317079,
+		0.381458, 0.324828, 0.433864, 0.447192, 0.453130, 0.453134,
+		0.326682, 0.330141, 0.332168, 0.320503, 0.328382, 0.327897,
+		0.167290, 0.382848, 0.500000, 0.584754, 0.592044, 0.597043,
+		0.597755, 0.429827, 0.426835, 0.430942, 0.420569, 0.412089,
+		0.406503, 0.399741, 0.334592, 0.659050, 0.610636, 0.622630,
+		0.634763, 0.646498, 0.333647, 0.334860, 0.339892, 0.344742,
+		0.338213, 0.335977, 0.524935, 0.696883, 0.384895, 0.651996,
+		0.659009, 0.676570, 0.677583, 0.460687, 0.460343, 0.461440,
+		0.460097, 0.464259, 0.460781, 0.616723, 0.638145, 0.689372,
+		0.490396, 0.620602, 0.632828, 0.644180, 0.467080, 0.466737,
+		0.467833, 0.466491, 0.468237, 0.474834, 0.625016, 0.650814,
+		0.697376, 0.648187, 0.502315, 0.632247, 0.641214, 0.476140,
+		0.475385, 0.476482, 0.475139, 0.476885, 0.481479, 0.630848,
+		0.663643, 0.716814, 0.661108, 0.660798, 0.517275, 0.643344,
+		0.476214, 0.475459, 0.476556, 0.475213, 0.476959, 0.481553,
+		0.631637, 0.676156, 0.717913, 0.673209, 0.670352, 0.672925,
+		0.521291,
+	},
+	{ // 85s
+		0.197394, 0.365498, 0.365763, 0.363410, 0.360344, 0.363977,
+		0.298817, 0.392129, 0.394538, 0.306924, 0.418458, 0.426375,
+		0.426379, 0.390740, 0.196116, 0.360987, 0.361251, 0.358185,
+		0.362344, 0.305729, 0.392161, 0.392165, 0.311328, 0.426950,
+		0.434867, 0.434871, 0.390880, 0.386031, 0.197202, 0.355342,
+		0.352276, 0.356490, 0.307965, 0.396144, 0.393335, 0.316306,
+		0.428120, 0.436038, 0.436041, 0.388248, 0.386165, 0.379717,
+		0.195088, 0.344036, 0.348798, 0.311272, 0.386818, 0.395222,
+		0.319594, 0.427299, 0.435217, 0.435220, 0.384978, 0.382895,
+		0.376447, 0.367606, 0.192192, 0.339235, 0.302766, 0.378010,
+		0.386414, 0.326651, 0.427073, 0.434991, 0.434995, 0.388561,
+		0.387039, 0.380649, 0.372388, 0.362226, 0.181765, 0.313646,
+		0.372821, 0.381225, 0.325440, 0.433713, 0.439651, 0.439654,
+		0.310704, 0.318058, 0.320127, 0.323328, 0.313783, 0.324704,
+		0.154411, 0.380218, 0.415246, 0.500000, 0.557172, 0.565785,
+		0.566457, 0.418244, 0.418409, 0.422517, 0.412580, 0.403209,
+		0.397703, 0.397430, 0.330786, 0.557783, 0.658467, 0.614523,
+		0.626656, 0.638391, 0.420826, 0.418420, 0.419517, 0.421384,
+		0.412012, 0.406507, 0.435105, 0.583645, 0.336809, 0.645561,
+		0.604347, 0.616480, 0.628215, 0.319293, 0.323889, 0.328922,
+		0.332070, 0.339367, 0.337131, 0.524955, 0.696640, 0.682657,
+		0.378173, 0.641614, 0.659178, 0.660191, 0.445491, 0.454512,
+		0.455609, 0.454593, 0.454315, 0.460913, 0.587372, 0.642536,
+		0.631815, 0.678540, 0.490120, 0.629153, 0.638120, 0.454139,
+		0.463160, 0.464257, 0.463241, 0.462963, 0.467557, 0.597121,
+		0.655365, 0.644644, 0.697978, 0.657525, 0.505079, 0.637144,
+		0.454213, 0.463234, 0.464331, 0.463315, 0.463037, 0.467631,
+		0.597870, 0.667878, 0.657157, 0.699076, 0.667079, 0.666345,
+		0.509096,
+	},
+	{ // 84s
+		0.180942, 0.352983, 0.353247, 0.350895, 0.348054, 0.350265,
+		0.283907, 0.380467, 0.382875, 0.373465, 0.295026, 0.414406,
+		0.414409, 0.377737, 0.179664, 0.348471, 0.348736, 0.346384,
+		0.348632, 0.290859, 0.380499, 0.380503, 0.380210, 0.299430,
+		0.422898, 0.422901, 0.377877, 0.373028, 0.180750, 0.342827,
+		0.340475, 0.342778, 0.293136, 0.384482, 0.381673, 0.381380,
+		0.304408, 0.424068, 0.424071, 0.375245, 0.373162, 0.366715,
+		0.178637, 0.332235, 0.335086, 0.296483, 0.375155, 0.383559,
+		0.380559, 0.307696, 0.423247, 0.423250, 0.372092, 0.370530,
+		0.364082, 0.355241, 0.176238, 0.327618, 0.301788, 0.366394,
+		0.374797, 0.382964, 0.312819, 0.422944, 0.422948, 0.374483,
+		0.372961, 0.366571, 0.358310, 0.350299, 0.173825, 0.296102,
+		0.360747, 0.369151, 0.377317, 0.324365, 0.425879, 0.425883,
+		0.294636, 0.302032, 0.304142, 0.307384, 0.312705, 0.306203,
+		0.137242, 0.375502, 0.407956, 0.442828, 0.500000, 0.535258,
+		0.535889, 0.406274, 0.406439, 0.410546, 0.400610, 0.391281,
+		0.385279, 0.392837, 0.323093, 0.544455, 0.557905, 0.659543,
+		0.615046, 0.626781, 0.408856, 0.406450, 0.407546, 0.409413,
+		0.400085, 0.394083, 0.427730, 0.569940, 0.329116, 0.549536,
+		0.646695, 0.604930, 0.616665, 0.398910, 0.406135, 0.407231,
+		0.406216, 0.408626, 0.402624, 0.465303, 0.584017, 0.575152,
+		0.335562, 0.632556, 0.592106, 0.603457, 0.306497, 0.311093,
+		0.316126, 0.319274, 0.324349, 0.336460, 0.524992, 0.698236,
+		0.684312, 0.668972, 0.370216, 0.657919, 0.658936, 0.441724,
+		0.450745, 0.451842, 0.450826, 0.450354, 0.453445, 0.564201,
+		0.643491, 0.632829, 0.619269, 0.696708, 0.493966, 0.632593,
+		0.441798, 0.450819, 0.451916, 0.450900, 0.450428, 0.453519,
+		0.564908, 0.656004, 0.645342, 0.631371, 0.697806, 0.661692,
+		0.497983,
+	},
+	{ // 83s
+		0.164491, 0.340408, 0.340673, 0.338321, 0.335480, 0.337916,
+		0.268939, 0.368745, 0.371154, 0.361744, 0.365278, 0.283128,
+		0.402439, 0.364671, 0.163213, 0.335897, 0.336162, 0.333810,
+		0.336283, 0.275931, 0.368778, 0.368782, 0.368489, 0.372022,
+		0.287532, 0.410872, 0.364811, 0.359962, 0.164299, 0.330253,
+		0.327901, 0.330429, 0.278249, 0.372760, 0.369952, 0.369659,
+		0.373192, 0.292510, 0.412042, 0.362179, 0.360096, 0.353649,
+		0.162185, 0.319660, 0.322737, 0.281636, 0.363434, 0.371838,
+		0.368838, 0.372372, 0.295798, 0.411222, 0.359026, 0.357464,
+		0.351016, 0.342175, 0.159786, 0.315270, 0.286982, 0.354672,
+		0.363076, 0.371243, 0.372069, 0.300921, 0.410919, 0.361534,
+		0.360012, 0.353621, 0.345361, 0.337350, 0.157870, 0.295107,
+		0.349071, 0.357475, 0.365642, 0.377251, 0.310534, 0.413777,
+		0.278505, 0.285943, 0.288094, 0.291378, 0.296740, 0.305103,
+		0.120072, 0.368547, 0.402957, 0.434215, 0.464742, 0.500000,
+		0.526424, 0.394240, 0.394406, 0.398513, 0.388576, 0.379248,
+		0.373288, 0.385804, 0.315399, 0.531127, 0.544578, 0.558349,
+		0.660618, 0.615172, 0.396823, 0.394416, 0.395513, 0.397380,
+		0.388052, 0.382092, 0.422830, 0.556236, 0.321422, 0.536208,
+		0.549980, 0.647770, 0.605055, 0.386876, 0.394101, 0.395198,
+		0.394182, 0.396593, 0.390633, 0.456485, 0.570312, 0.561447,
+		0.327869, 0.539263, 0.633692, 0.591907, 0.390781, 0.398005,
+		0.399102, 0.398086, 0.397614, 0.402982, 0.489592, 0.584872,
+		0.576006, 0.564613, 0.337249, 0.637902, 0.593521, 0.293726,
+		0.298322, 0.303355, 0.306503, 0.311578, 0.321467, 0.525053,
+		0.699857, 0.685933, 0.670653, 0.675318, 0.362977, 0.657836,
+		0.429383, 0.438342, 0.439438, 0.438423, 0.437950, 0.440847,
+		0.554794, 0.644130, 0.633468, 0.619556, 0.621350, 0.696707,
+		0.486909,
+	},
+	{ // 82s
+		0.164484, 0.339932, 0.340197, 0.337844, 0.335003, 0.337440,
+		0.267685, 0.367144, 0.372763, 0.363796, 0.366946, 0.370480,
+		0.283128, 0.364163, 0.163206, 0.335421, 0.335685, 0.333333,
+		0.335807, 0.274717, 0.367176, 0.370286, 0.370481, 0.373631,
+		0.377165, 0.287532, 0.364303, 0.359454, 0.164292, 0.329776,
+		0.327424, 0.329952, 0.277075, 0.371159, 0.371456, 0.371651,
+		0.374801, 0.378335, 0.292511, 0.361671, 0.359588, 0.353141,
+		0.162178, 0.319184, 0.322260, 0.280503, 0.361833, 0.373342,
+		0.370830, 0.373980, 0.377514, 0.295798, 0.358518, 0.356956,
+		0.350508, 0.341667, 0.159779, 0.314793, 0.285888, 0.353071,
+		0.364580, 0.373235, 0.373678, 0.377212, 0.300922, 0.361026,
+		0.359504, 0.353113, 0.344853, 0.336842, 0.157863, 0.294054,
+		0.347469, 0.358979, 0.367634, 0.379244, 0.380070, 0.310534,
+		0.277182, 0.284661, 0.286854, 0.290180, 0.295583, 0.303987,
+		0.120065, 0.367794, 0.402245, 0.433543, 0.464111, 0.473576,
+		0.500000, 0.392565, 0.392730, 0.396838, 0.386901, 0.377572,
+		0.371613, 0.385005, 0.311551, 0.524805, 0.538640, 0.552411,
+		0.566241, 0.674449, 0.398563, 0.396029, 0.397126, 0.398992,
+		0.389664, 0.383705, 0.422073, 0.549829, 0.320990, 0.532739,
+		0.546511, 0.560342, 0.661602, 0.389073, 0.396235, 0.397332,
+		0.396316, 0.398726, 0.392767, 0.455769, 0.564299, 0.557959,
+		0.327957, 0.536283, 0.550115, 0.647050, 0.392566, 0.399727,
+		0.400824, 0.399809, 0.399336, 0.405116, 0.488918, 0.578858,
+		0.572519, 0.561628, 0.337338, 0.554448, 0.648363, 0.396495,
+		0.403656, 0.404753, 0.403737, 0.403265, 0.406162, 0.499242,
+		0.593501, 0.587162, 0.576272, 0.580960, 0.347225, 0.652648,
+		0.293820, 0.298416, 0.303449, 0.306597, 0.311672, 0.321561,
+		0.525147, 0.714949, 0.701025, 0.685225, 0.686727, 0.691477,
+		0.368256,
+	},
+	{ // A7o
+		0.066530, 0.251729, 0.253220, 0.262798, 0.272924, 0.295928,
+		0.318509, 0.475073, 0.596979, 0.582438, 0.583615, 0.585142,
+		0.589865, 0.263727, 0.287272, 0.542075, 0.542155, 0.541880,
+		0.558874, 0.570889, 0.711998, 0.600906, 0.601554, 0.602589,
+		0.604208, 0.608657, 0.265028, 0.575454, 0.282814, 0.525998,
+		0.526141, 0.543135, 0.555150, 0.709968, 0.596795, 0.597443,
+		0.598478, 0.600097, 0.604546, 0.274972, 0.575399, 0.558058,
+		0.284734, 0.519064, 0.536058, 0.548073, 0.695169, 0.599510,
+		0.597274, 0.598309, 0.599928, 0.604377, 0.285517, 0.574971,
+		0.558060, 0.550303, 0.284224, 0.526808, 0.538823, 0.680433,
+		0.588463, 0.600046, 0.597786, 0.599405, 0.603854, 0.309957,
+		0.593047, 0.576136, 0.568379, 0.558361, 0.290273, 0.533661,
+		0.670195, 0.581505, 0.593088, 0.605058, 0.603382, 0.607831,
+		0.333884, 0.605933, 0.589021, 0.581264, 0.571246, 0.565825,
+		0.290667, 0.655586, 0.570173, 0.581756, 0.593726, 0.605760,
+		0.607435, 0.500000, 0.754162, 0.751941, 0.735923, 0.719959,
+		0.709013, 0.693176, 0.304246, 0.662066, 0.678045, 0.694112,
+		0.710243, 0.726437, 0.632787, 0.638618, 0.634169, 0.636977,
+		0.625096, 0.617812, 0.605629, 0.702281, 0.435287, 0.589422,
+		0.603190, 0.617020, 0.631224, 0.617061, 0.639267, 0.634818,
+		0.634502, 0.637367, 0.630083, 0.617900, 0.719348, 0.625690,
+		0.443231, 0.590822, 0.604654, 0.618858, 0.618408, 0.640478,
+		0.636029, 0.635713, 0.635026, 0.642916, 0.630734, 0.736654,
+		0.640386, 0.627131, 0.453321, 0.606594, 0.620800, 0.620148,
+		0.642333, 0.637885, 0.637569, 0.636882, 0.641220, 0.643642,
+		0.754034, 0.655157, 0.641903, 0.644069, 0.464108, 0.625584,
+		0.625376, 0.647288, 0.642839, 0.642523, 0.641836, 0.646175,
+		0.645614, 0.771501, 0.670337, 0.657082, 0.659249, 0.664503,
+		0.476042,
+	},
+	{ // K7o
+		0.122918, 0.235092, 0.336988, 0.337074, 0.334368, 0.346241,
+		0.343870, 0.235292, 0.377508, 0.375240, 0.378784, 0.382329,
+		0.386267, 0.243508, 0.066651, 0.250352, 0.259787, 0.268813,
+		0.290116, 0.318958, 0.475073, 0.596987, 0.595121, 0.596299,
+		0.597826, 0.602548, 0.354724, 0.261873, 0.277712, 0.525765,
+		0.525907, 0.542902, 0.556419, 0.708042, 0.599147, 0.596637,
+		0.597672, 0.599291, 0.603740, 0.354668, 0.271671, 0.557920,
+		0.279633, 0.518830, 0.535825, 0.549343, 0.693243, 0.601861,
+		0.596469, 0.597504, 0.599123, 0.603572, 0.351594, 0.281029,
+		0.557921, 0.550165, 0.279081, 0.526575, 0.540092, 0.678506,
+		0.590814, 0.599240, 0.596980, 0.598600, 0.603048, 0.364198,
+		0.303649, 0.575991, 0.568235, 0.558216, 0.285033, 0.534931,
+		0.664577, 0.580562, 0.588988, 0.600958, 0.599283, 0.603732,
+		0.361642, 0.334390, 0.590309, 0.582553, 0.572535, 0.567107,
+		0.290983, 0.653900, 0.573165, 0.581591, 0.593561, 0.605594,
+		0.607270, 0.245838, 0.500000, 0.750006, 0.733988, 0.718024,
+		0.703068, 0.691616, 0.303810, 0.657025, 0.669108, 0.685135,
+		0.701224, 0.717377, 0.398257, 0.632787, 0.636620, 0.639428,
+		0.627547, 0.616675, 0.608885, 0.697071, 0.438364, 0.589193,
+		0.602961, 0.616791, 0.630995, 0.395920, 0.630795, 0.633951,
+		0.633635, 0.636500, 0.625627, 0.617838, 0.710033, 0.625421,
+		0.446816, 0.588240, 0.602071, 0.616276, 0.399906, 0.632142,
+		0.635162, 0.634846, 0.634159, 0.638460, 0.630671, 0.727298,
+		0.640117, 0.624285, 0.456654, 0.604011, 0.618218, 0.403904,
+		0.633882, 0.637017, 0.636702, 0.636015, 0.636764, 0.643579,
+		0.744637, 0.654888, 0.639057, 0.641223, 0.467147, 0.623002,
+		0.408354, 0.639110, 0.641972, 0.641656, 0.640969, 0.641719,
+		0.645551, 0.762061, 0.670068, 0.654237, 0.656403, 0.661658,
+		0.478744,
+	},
+	{ // Q7o
+		0.122924, 0.307576, 0.233037, 0.335066, 0.331839, 0.343712,
+		0.341341, 0.237338, 0.377511, 0.375243, 0.378787, 0.382332,
+		0.386270, 0.322683, 0.120094, 0.235611, 0.325154, 0.321928,
+		0.333800, 0.342444, 0.239473, 0.375397, 0.378361, 0.381906,
+		0.385450, 0.389389, 0.241292, 0.243761, 0.064955, 0.254345,
+		0.266198, 0.286581, 0.312422, 0.475074, 0.594931, 0.593065,
+		0.594243, 0.595770, 0.600493, 0.352500, 0.341862, 0.265846,
+		0.276996, 0.517356, 0.534322, 0.547839, 0.689680, 0.601588,
+		0.595783, 0.596818, 0.598437, 0.602886, 0.348855, 0.338218,
+		0.278274, 0.548795, 0.275833, 0.524659, 0.538177, 0.674944,
+		0.590129, 0.598555, 0.596295, 0.597914, 0.602363, 0.361460,
+		0.350822, 0.299915, 0.566833, 0.556386, 0.281701, 0.529594,
+		0.661015, 0.579356, 0.587781, 0.599752, 0.598076, 0.602525,
+		0.358898, 0.359928, 0.327414, 0.581145, 0.570698, 0.561548,
+		0.287512, 0.650337, 0.569058, 0.577483, 0.589454, 0.601487,
+		0.603162, 0.248059, 0.249994, 0.500000, 0.730308, 0.714345,
+		0.699388, 0.687931, 0.305569, 0.653678, 0.665720, 0.681705,
+		0.697753, 0.713864, 0.398260, 0.395809, 0.630563, 0.639163,
+		0.626854, 0.615412, 0.604463, 0.693701, 0.437898, 0.587922,
+		0.601690, 0.615520, 0.629725, 0.395923, 0.399131, 0.628570,
+		0.632942, 0.635806, 0.624365, 0.613415, 0.706621, 0.624069,
+		0.446140, 0.586969, 0.600801, 0.615005, 0.399909, 0.403117,
+		0.629918, 0.634153, 0.633466, 0.637198, 0.626248, 0.723844,
+		0.638765, 0.622933, 0.455725, 0.602741, 0.616947, 0.403907,
+		0.407115, 0.631658, 0.636009, 0.635321, 0.635502, 0.639157,
+		0.741141, 0.653536, 0.637704, 0.639871, 0.465924, 0.621731,
+		0.408357, 0.411565, 0.636885, 0.640963, 0.640276, 0.640456,
+		0.641129, 0.758524, 0.668716, 0.652884, 0.655051, 0.660305,
+		0.477185,
+	},
+	{ // J7o
+		0.140457, 0.320369, 0.317219, 0.245999, 0.343843, 0.355656,
+		0.352892, 0.252279, 0.393158, 0.387355, 0.390900, 0.394445,
+		0.398383, 0.336282, 0.137627, 0.309104, 0.248573, 0.333931,
+		0.345744, 0.354388, 0.254413, 0.391044, 0.390474, 0.394018,
+		0.397563, 0.401501, 0.332879, 0.324104, 0.134232, 0.235442,
+		0.323293, 0.335106, 0.343750, 0.258033, 0.389163, 0.388592,
+		0.392137, 0.395682, 0.399620, 0.255169, 0.257638, 0.243272,
+		0.082282, 0.267951, 0.288251, 0.313978, 0.475074, 0.602444,
+		0.599275, 0.600453, 0.601980, 0.606703, 0.361291, 0.350653,
+		0.339200, 0.279654, 0.280760, 0.532500, 0.545988, 0.684201,
+		0.600464, 0.608847, 0.606587, 0.608206, 0.612655, 0.373833,
+		0.363195, 0.351741, 0.301211, 0.564475, 0.286543, 0.537112,
+		0.669991, 0.589792, 0.598175, 0.610145, 0.608469, 0.612918,
+		0.370842, 0.372301, 0.360848, 0.328595, 0.578755, 0.569286,
+		0.292228, 0.656078, 0.579431, 0.587420, 0.599390, 0.611424,
+		0.613099, 0.264077, 0.266012, 0.269692, 0.500000, 0.724095,
+		0.708823, 0.693867, 0.320365, 0.665196, 0.662364, 0.678308,
+		0.694314, 0.710384, 0.415108, 0.412657, 0.410603, 0.638675,
+		0.637488, 0.626155, 0.615143, 0.706362, 0.454657, 0.591787,
+		0.605555, 0.619385, 0.633590, 0.408807, 0.412015, 0.409961,
+		0.635150, 0.646391, 0.635058, 0.623617, 0.703151, 0.628154,
+		0.457433, 0.589877, 0.603708, 0.617913, 0.412793, 0.416001,
+		0.413947, 0.636498, 0.644050, 0.647891, 0.636450, 0.720332,
+		0.642850, 0.625884, 0.466766, 0.605648, 0.619855, 0.416791,
+		0.420000, 0.417946, 0.638238, 0.645906, 0.646195, 0.649359,
+		0.737588, 0.657621, 0.640656, 0.642822, 0.476670, 0.624639,
+		0.421241, 0.424449, 0.422395, 0.643465, 0.650860, 0.651150,
+		0.651331, 0.754929, 0.672801, 0.655836, 0.658002, 0.663257,
+		0.487595,
+	},
+	{ // T7o
+		0.157990, 0.333163, 0.330012, 0.328421, 0.258952, 0.367541,
+		0.364778, 0.267160, 0.400721, 0.402943, 0.402953, 0.406498,
+		0.410436, 0.349881, 0.155160, 0.321897, 0.320305, 0.261526,
+		0.357689, 0.366273, 0.269294, 0.398607, 0.406062, 0.406072,
+		0.409616, 0.413554, 0.346478, 0.337703, 0.151765, 0.309350,
+		0.248403, 0.347051, 0.355635, 0.272914, 0.396726, 0.404180,
+		0.404190, 0.407735, 0.411673, 0.344185, 0.335410, 0.323634,
+		0.139740, 0.253480, 0.337575, 0.346159, 0.264624, 0.399500,
+		0.404932, 0.404942, 0.408487, 0.412425, 0.269037, 0.271506,
+		0.257140, 0.261879, 0.100002, 0.289893, 0.315579, 0.475074,
+		0.601733, 0.604199, 0.604935, 0.606462, 0.611184, 0.386142,
+		0.375567, 0.364114, 0.353961, 0.302467, 0.291354, 0.543888,
+		0.676801, 0.598565, 0.607445, 0.619372, 0.617697, 0.622145,
+		0.383152, 0.384611, 0.373157, 0.363004, 0.329810, 0.576226,
+		0.296913, 0.662608, 0.587911, 0.596791, 0.608719, 0.620752,
+		0.622428, 0.280041, 0.281976, 0.285655, 0.275905, 0.500000,
+		0.715986, 0.700714, 0.335356, 0.656941, 0.671540, 0.672618,
+		0.688583, 0.704611, 0.422802, 0.420350, 0.418297, 0.420523,
+		0.637494, 0.635161, 0.623829, 0.697254, 0.459094, 0.601135,
+		0.610221, 0.624051, 0.638255, 0.425592, 0.428800, 0.426747,
+		0.426945, 0.640462, 0.644544, 0.633212, 0.713335, 0.637722,
+		0.473992, 0.593238, 0.607069, 0.621273, 0.425614, 0.428822,
+		0.426768, 0.426967, 0.641208, 0.657328, 0.645996, 0.714385,
+		0.647435, 0.629433, 0.477817, 0.607530, 0.621737, 0.429612,
+		0.432821, 0.430767, 0.430965, 0.642948, 0.655632, 0.658904,
+		0.731599, 0.662207, 0.644204, 0.644667, 0.487427, 0.626521,
+		0.434062, 0.437270, 0.435217, 0.435415, 0.648175, 0.660586,
+		0.660877, 0.748898, 0.677387, 0.659384, 0.659847, 0.665102,
+		0.498016,
+	},
+	{ // 97o
+		0.169022, 0.344962, 0.341817, 0.343532, 0.342144, 0.273254,
+		0.371873, 0.277280, 0.405626, 0.405717, 0.413752, 0.413762,
+		0.417700, 0.362698, 0.170357, 0.336681, 0.338395, 0.337008,
+		0.275975, 0.376791, 0.283106, 0.406825, 0.412147, 0.420182,
+		0.420192, 0.424130, 0.359296, 0.353618, 0.166962, 0.327383,
+		0.326312, 0.262981, 0.366212, 0.286727, 0.404944, 0.410266,
+		0.418301, 0.418311, 0.422249, 0.360585, 0.354907, 0.343071,
+		0.155507, 0.316658, 0.269569, 0.357047, 0.278717, 0.408531,
+		0.411438, 0.419473, 0.419483, 0.423421, 0.358532, 0.352855,
+		0.341341, 0.330973, 0.141085, 0.265736, 0.348556, 0.272875,
+		0.402148, 0.415272, 0.421286, 0.421296, 0.425234, 0.284429,
+		0.287059, 0.272827, 0.279163, 0.274229, 0.115669, 0.317130,
+		0.475075, 0.600975, 0.603368, 0.608076, 0.608843, 0.613565,
+		0.390435, 0.395624, 0.384234, 0.374399, 0.365305, 0.330983,
+		0.297056, 0.664873, 0.593497, 0.602297, 0.614721, 0.626712,
+		0.628387, 0.290987, 0.296932, 0.300612, 0.291177, 0.284014,
+		0.500000, 0.703032, 0.345913, 0.656091, 0.659285, 0.677786,
+		0.678885, 0.694872, 0.427794, 0.428931, 0.426878, 0.429993,
+		0.423084, 0.636280, 0.629425, 0.696179, 0.462176, 0.604065,
+		0.616583, 0.628181, 0.642385, 0.428260, 0.435057, 0.433003,
+		0.433661, 0.437030, 0.639166, 0.638719, 0.699949, 0.640454,
+		0.474147, 0.599577, 0.608726, 0.622930, 0.437373, 0.444170,
+		0.442116, 0.442775, 0.444115, 0.644618, 0.651982, 0.720291,
+		0.653811, 0.635781, 0.490053, 0.610649, 0.624856, 0.437408,
+		0.444204, 0.442151, 0.442809, 0.444149, 0.645407, 0.664841,
+		0.721373, 0.666241, 0.645570, 0.648009, 0.494113, 0.625394,
+		0.441857, 0.448654, 0.446600, 0.447259, 0.448599, 0.650634,
+		0.666814, 0.738631, 0.681421, 0.660750, 0.663189, 0.663728,
+		0.504365,
+	},
+	{ // 87o
+		0.186555, 0.357577, 0.354433, 0.356147, 0.354759, 0.358247,
+		0.286206, 0.292041, 0.415596, 0.415293, 0.421196, 0.429624,
+		0.429634, 0.376102, 0.182247, 0.337984, 0.348960, 0.350358,
+		0.354406, 0.290087, 0.293703, 0.412604, 0.417533, 0.423436,
+		0.431864, 0.431874, 0.372705, 0.355125, 0.178864, 0.337967,
+		0.339682, 0.343787, 0.277240, 0.297332, 0.410729, 0.415658,
+		0.421561, 0.429989, 0.429999, 0.373994, 0.366341, 0.354531,
+		0.170992, 0.332518, 0.337205, 0.283957, 0.292545, 0.417210,
+		0.419615, 0.425518, 0.433946, 0.433956, 0.371942, 0.367301,
+		0.355815, 0.348028, 0.157139, 0.326840, 0.281395, 0.286984,
+		0.411138, 0.424262, 0.427750, 0.436178, 0.436188, 0.375191,
+		0.371161, 0.359736, 0.352576, 0.341514, 0.142808, 0.279142,
+		0.285663, 0.407947, 0.421071, 0.434776, 0.441183, 0.441193,
+		0.298298, 0.302238, 0.288167, 0.294637, 0.291047, 0.287750,
+		0.131946, 0.475075, 0.600259, 0.602570, 0.607163, 0.614196,
+		0.614995, 0.306824, 0.308384, 0.312069, 0.306133, 0.299286,
+		0.296968, 0.500000, 0.357003, 0.654834, 0.655557, 0.662653,
+		0.681175, 0.682297, 0.438114, 0.434858, 0.432811, 0.439080,
+		0.432490, 0.429049, 0.635107, 0.694701, 0.466225, 0.608842,
+		0.619483, 0.632375, 0.644428, 0.438151, 0.440555, 0.438507,
+		0.442178, 0.446436, 0.442994, 0.637910, 0.695772, 0.645094,
+		0.477598, 0.600388, 0.613281, 0.624941, 0.444940, 0.447344,
+		0.445296, 0.448967, 0.450767, 0.457604, 0.643239, 0.703803,
+		0.656499, 0.636217, 0.490535, 0.615181, 0.624394, 0.454494,
+		0.456898, 0.454851, 0.458522, 0.460322, 0.465130, 0.651231,
+		0.724177, 0.670255, 0.649973, 0.652385, 0.507062, 0.635546,
+		0.454551, 0.456955, 0.454907, 0.458579, 0.460379, 0.465187,
+		0.652071, 0.725304, 0.683200, 0.662489, 0.662259, 0.674700,
+		0.511293,
+	},
+	{ // 77
+		0.195192, 0.523103, 0.518928, 0.520120, 0.520887, 0.536405,
+		0.537172, 0.655792, 0.673660, 0.657710, 0.659493, 0.661797,
+		0.666996, 0.549839, 0.195274, 0.511284, 0.512475, 0.513243,
+		0.528800, 0.540638, 0.656201, 0.670442, 0.668338, 0.670121,
+		0.672425, 0.677624, 0.545320, 0.537055, 0.190750, 0.496242,
+		0.497433, 0.513031, 0.524907, 0.654619, 0.666703, 0.664599,
+		0.666382, 0.668686, 0.673885, 0.546482, 0.538218, 0.520746,
+		0.190887, 0.493002, 0.508639, 0.520555, 0.641089, 0.670234,
+		0.666258, 0.668041, 0.670345, 0.675544, 0.547204, 0.538939,
+		0.521897, 0.517176, 0.187529, 0.501631, 0.513587, 0.627395,
+		0.666002, 0.669808, 0.669720, 0.672024, 0.677222, 0.563936,
+		0.555714, 0.538713, 0.534035, 0.526629, 0.184408, 0.509974,
+		0.617755, 0.665950, 0.669756, 0.677449, 0.677881, 0.683080,
+		0.564659, 0.568437, 0.551478, 0.546842, 0.539478, 0.535799,
+		0.181794, 0.607630, 0.665408, 0.669214, 0.676907, 0.684601,
+		0.688449, 0.695754, 0.696190, 0.694431, 0.679635, 0.664644,
+		0.654087, 0.642997, 0.500000, 0.817216, 0.830017, 0.847147,
+		0.864316, 0.881485, 0.709523, 0.706017, 0.701960, 0.705631,
+		0.701115, 0.701140, 0.700628, 0.865481, 0.816421, 0.812867,
+		0.817857, 0.823258, 0.831198, 0.692319, 0.703703, 0.699647,
+		0.701303, 0.704993, 0.705018, 0.704506, 0.879317, 0.853646,
+		0.812384, 0.798469, 0.803871, 0.811811, 0.694252, 0.705636,
+		0.701579, 0.703236, 0.704911, 0.713142, 0.712631, 0.897889,
+		0.858953, 0.838255, 0.812825, 0.805767, 0.813709, 0.696766,
+		0.708150, 0.704093, 0.705750, 0.707425, 0.713643, 0.720767,
+		0.916512, 0.864702, 0.844003, 0.846076, 0.813860, 0.818932,
+		0.702463, 0.713847, 0.709790, 0.711447, 0.713122, 0.719339,
+		0.725020, 0.935162, 0.873116, 0.852418, 0.854491, 0.860159,
+		0.818676,
+	},
+	{ // 76s
+		0.224746, 0.389415, 0.385545, 0.382479, 0.383941, 0.387760,
+		0.385814, 0.323967, 0.330302, 0.439340, 0.444788, 0.451004,
+		0.461391, 0.415706, 0.224692, 0.382060, 0.378993, 0.380455,
+		0.384312, 0.386875, 0.328875, 0.335009, 0.442050, 0.447499,
+		0.453331, 0.461632, 0.411601, 0.407840, 0.220586, 0.362642,
+		0.372930, 0.379458, 0.382548, 0.332220, 0.336279, 0.439388,
+		0.444837, 0.450669, 0.458970, 0.408331, 0.404570, 0.387508,
+		0.217454, 0.362232, 0.369611, 0.372755, 0.321858, 0.341028,
+		0.438156, 0.443604, 0.449436, 0.457738, 0.409546, 0.405785,
+		0.397876, 0.386514, 0.210383, 0.363520, 0.367117, 0.330268,
+		0.338762, 0.445228, 0.448209, 0.454041, 0.462342, 0.413340,
+		0.409619, 0.404533, 0.394063, 0.387487, 0.197939, 0.359836,
+		0.331871, 0.337797, 0.442297, 0.455625, 0.459094, 0.467395,
+		0.410952, 0.412069, 0.407543, 0.397131, 0.391034, 0.383355,
+		0.185035, 0.333886, 0.340950, 0.442217, 0.455545, 0.468873,
+		0.475195, 0.337934, 0.342975, 0.346322, 0.334804, 0.343059,
+		0.343909, 0.345166, 0.182784, 0.500000, 0.583680, 0.590889,
+		0.595779, 0.602723, 0.344571, 0.349405, 0.350505, 0.355355,
+		0.352060, 0.350106, 0.352575, 0.524936, 0.394789, 0.644471,
+		0.649462, 0.656491, 0.674070, 0.466937, 0.469814, 0.466976,
+		0.465634, 0.472695, 0.469510, 0.469367, 0.615172, 0.681179,
+		0.494109, 0.620376, 0.630774, 0.643360, 0.472918, 0.475795,
+		0.472958, 0.471615, 0.476134, 0.483563, 0.483421, 0.623384,
+		0.686951, 0.647550, 0.505420, 0.628241, 0.640830, 0.479741,
+		0.482207, 0.479369, 0.478027, 0.482546, 0.487560, 0.497493,
+		0.629104, 0.694990, 0.658592, 0.656197, 0.517494, 0.642938,
+		0.491069, 0.491298, 0.488460, 0.487117, 0.491636, 0.496651,
+		0.504580, 0.637088, 0.714477, 0.671913, 0.669518, 0.672071,
+		0.533006,
+	},
+	{ // 75s
+		0.209779, 0.377412, 0.373542, 0.373807, 0.370740, 0.374862,
+		0.372916, 0.309260, 0.402486, 0.317069, 0.424275, 0.430107,
+		0.438025, 0.403097, 0.212551, 0.372900, 0.373165, 0.370099,
+		0.374258, 0.376822, 0.317623, 0.403167, 0.324797, 0.435820,
+		0.441652, 0.449570, 0.398992, 0.398387, 0.208446, 0.365640,
+		0.362574, 0.369405, 0.372494, 0.321008, 0.400504, 0.326068,
+		0.433158, 0.438990, 0.446908, 0.399132, 0.398528, 0.390618,
+		0.209650, 0.356743, 0.364122, 0.367266, 0.324457, 0.405113,
+		0.332258, 0.434954, 0.440786, 0.448704, 0.395862, 0.395257,
+		0.387348, 0.380980, 0.206754, 0.354409, 0.358308, 0.316302,
+		0.396365, 0.339316, 0.434729, 0.440561, 0.448478, 0.399967,
+		0.399402, 0.394316, 0.388529, 0.378208, 0.196849, 0.352855,
+		0.328438, 0.391464, 0.338351, 0.442145, 0.445614, 0.453531,
+		0.397578, 0.401852, 0.397327, 0.391597, 0.382067, 0.376265,
+		0.184357, 0.332701, 0.389364, 0.341533, 0.442095, 0.455422,
+		0.461360, 0.321955, 0.330892, 0.334280, 0.337636, 0.328460,
+		0.340715, 0.344443, 0.169983, 0.416320, 0.500000, 0.556057,
+		0.564600, 0.569301, 0.428872, 0.429687, 0.426849, 0.431616,
+		0.422308, 0.417095, 0.414875, 0.435821, 0.337119, 0.636414,
+		0.600995, 0.613048, 0.625240, 0.330217, 0.338434, 0.339534,
+		0.345854, 0.353151, 0.351196, 0.353695, 0.524955, 0.672705,
+		0.387829, 0.629997, 0.637029, 0.654607, 0.451328, 0.463570,
+		0.460733, 0.462489, 0.462211, 0.469641, 0.469528, 0.585781,
+		0.627914, 0.665871, 0.493184, 0.623390, 0.635676, 0.457739,
+		0.469982, 0.467144, 0.468901, 0.468623, 0.473637, 0.483600,
+		0.595459, 0.640661, 0.673910, 0.651054, 0.505259, 0.634678,
+		0.466412, 0.478654, 0.475817, 0.477574, 0.477296, 0.482310,
+		0.490269, 0.600984, 0.653573, 0.693389, 0.664058, 0.663305,
+		0.520346,
+	},
+	{ // 74s
+		0.193327, 0.364896, 0.361027, 0.361291, 0.358939, 0.361150,
+		0.359506, 0.294350, 0.390824, 0.381414, 0.305171, 0.418137,
+		0.426055, 0.390094, 0.196100, 0.360385, 0.360650, 0.358298,
+		0.360546, 0.363412, 0.302753, 0.391504, 0.391211, 0.312899,
+		0.429683, 0.437600, 0.385989, 0.385385, 0.191994, 0.353125,
+		0.353390, 0.355693, 0.359084, 0.306179, 0.388842, 0.388549,
+		0.314170, 0.427020, 0.434938, 0.386129, 0.385525, 0.377615,
+		0.193199, 0.347559, 0.350410, 0.353856, 0.309668, 0.393451,
+		0.390345, 0.320360, 0.428817, 0.436734, 0.383497, 0.382893,
+		0.377750, 0.371381, 0.191321, 0.343176, 0.347075, 0.315324,
+		0.385132, 0.393239, 0.325957, 0.429003, 0.436920, 0.385889,
+		0.385324, 0.380238, 0.374451, 0.366674, 0.188908, 0.340388,
+		0.310894, 0.379390, 0.387497, 0.337276, 0.431842, 0.439760,
+		0.383811, 0.388085, 0.383560, 0.377830, 0.370533, 0.363437,
+		0.178956, 0.325690, 0.377370, 0.385477, 0.340457, 0.441651,
+		0.447589, 0.305888, 0.314865, 0.318295, 0.321692, 0.327382,
+		0.322214, 0.337347, 0.152853, 0.409111, 0.443943, 0.500000,
+		0.534183, 0.544286, 0.416902, 0.417716, 0.414879, 0.419646,
+		0.410774, 0.404671, 0.402531, 0.428528, 0.329426, 0.544163,
+		0.637520, 0.601497, 0.613690, 0.406956, 0.417401, 0.414564,
+		0.416320, 0.419252, 0.413149, 0.411009, 0.466061, 0.569175,
+		0.335635, 0.623134, 0.588385, 0.600577, 0.317421, 0.325638,
+		0.326738, 0.333058, 0.338653, 0.350525, 0.353024, 0.524991,
+		0.674329, 0.658709, 0.379517, 0.634739, 0.652322, 0.445325,
+		0.457567, 0.454729, 0.456486, 0.456535, 0.459525, 0.469488,
+		0.562652, 0.628846, 0.614976, 0.671554, 0.494106, 0.629691,
+		0.453997, 0.466240, 0.463402, 0.465159, 0.465208, 0.468198,
+		0.476157, 0.574052, 0.641758, 0.627888, 0.691033, 0.658190,
+		0.509193,
+	},
+	{ // 73s
+		0.176876, 0.352322, 0.348452, 0.348717, 0.346365, 0.348801,
+		0.345735, 0.279382, 0.379103, 0.369693, 0.372843, 0.293273,
+		0.414085, 0.377028, 0.179648, 0.347811, 0.348076, 0.345723,
+		0.348197, 0.349641, 0.287825, 0.379783, 0.379490, 0.382640,
+		0.301001, 0.425571, 0.372923, 0.372319, 0.175543, 0.340551,
+		0.340815, 0.343832, 0.345313, 0.291292, 0.377121, 0.376828,
+		0.379978, 0.302272, 0.422909, 0.373063, 0.372459, 0.364550,
+		0.176747, 0.334984, 0.338549, 0.340085, 0.294821, 0.381729,
+		0.378624, 0.381774, 0.308462, 0.424705, 0.370431, 0.369827,
+		0.364684, 0.358316, 0.174870, 0.331316, 0.333304, 0.300518,
+		0.373410, 0.381518, 0.381960, 0.314059, 0.424891, 0.372940,
+		0.372375, 0.367810, 0.362023, 0.354246, 0.172954, 0.328712,
+		0.309899, 0.367714, 0.375821, 0.387431, 0.323445, 0.427654,
+		0.369670, 0.373944, 0.369419, 0.363688, 0.356392, 0.351446,
+		0.171016, 0.308129, 0.365237, 0.373344, 0.384954, 0.339382,
+		0.433759, 0.289757, 0.298776, 0.302247, 0.305686, 0.311417,
+		0.321115, 0.318825, 0.135684, 0.404221, 0.435400, 0.465817,
+		0.500000, 0.534821, 0.404868, 0.405683, 0.402845, 0.407613,
+		0.398741, 0.392680, 0.390043, 0.423743, 0.321732, 0.530835,
+		0.544578, 0.638595, 0.602080, 0.394922, 0.405368, 0.402530,
+		0.404287, 0.407219, 0.401158, 0.398521, 0.457316, 0.555470,
+		0.327942, 0.533572, 0.624270, 0.589027, 0.398415, 0.408860,
+		0.406023, 0.407779, 0.407829, 0.413507, 0.410870, 0.490311,
+		0.569998, 0.558294, 0.337047, 0.625520, 0.590582, 0.304650,
+		0.312866, 0.313967, 0.320287, 0.325882, 0.335532, 0.352377,
+		0.525053, 0.675950, 0.660389, 0.661831, 0.371766, 0.651080,
+		0.441583, 0.453762, 0.450924, 0.452681, 0.452730, 0.455526,
+		0.461983, 0.563937, 0.629885, 0.616074, 0.617808, 0.689787,
+		0.498080,
+	},
+	{ // 72s
+		0.160424, 0.339689, 0.335819, 0.336084, 0.333731, 0.336168,
+		0.333327, 0.264357, 0.367020, 0.357971, 0.361121, 0.364655,
+		0.281375, 0.363899, 0.163197, 0.335178, 0.335442, 0.333090,
+		0.335564, 0.337233, 0.272840, 0.368003, 0.367710, 0.370860,
+		0.374394, 0.289103, 0.359794, 0.359190, 0.159092, 0.327917,
+		0.328182, 0.331199, 0.332905, 0.276347, 0.365341, 0.365048,
+		0.368198, 0.371732, 0.290374, 0.359934, 0.359330, 0.351421,
+		0.160296, 0.322351, 0.325916, 0.327677, 0.279916, 0.369949,
+		0.366844, 0.369994, 0.373528, 0.296564, 0.357302, 0.356698,
+		0.351555, 0.345187, 0.158419, 0.318682, 0.320896, 0.285653,
+		0.361630, 0.369737, 0.370180, 0.373714, 0.302161, 0.359811,
+		0.359246, 0.354681, 0.348894, 0.341118, 0.156503, 0.316304,
+		0.295074, 0.355933, 0.364041, 0.375650, 0.376477, 0.311547,
+		0.356658, 0.360932, 0.356406, 0.350676, 0.343380, 0.338434,
+		0.155062, 0.307116, 0.353502, 0.361609, 0.373219, 0.384828,
+		0.325551, 0.273563, 0.282623, 0.286136, 0.289616, 0.295389,
+		0.305128, 0.317703, 0.118515, 0.397277, 0.430699, 0.455714,
+		0.465179, 0.500000, 0.392461, 0.393586, 0.390749, 0.395516,
+		0.386644, 0.380584, 0.377990, 0.416719, 0.314039, 0.517507,
+		0.531250, 0.545080, 0.639670, 0.382889, 0.393271, 0.390434,
+		0.392191, 0.395122, 0.389062, 0.386468, 0.452744, 0.541765,
+		0.320249, 0.520245, 0.534077, 0.625345, 0.386381, 0.396764,
+		0.393926, 0.395683, 0.395732, 0.401410, 0.398816, 0.479863,
+		0.556293, 0.544589, 0.329353, 0.535688, 0.626657, 0.390304,
+		0.400686, 0.397849, 0.399606, 0.399655, 0.402450, 0.411183,
+		0.490179, 0.570930, 0.559226, 0.561020, 0.338913, 0.630943,
+		0.291911, 0.300128, 0.301229, 0.307548, 0.313143, 0.322793,
+		0.337417, 0.525147, 0.677603, 0.662042, 0.663544, 0.668286,
+		0.364712,
+	},
+	{ // A6o
+		0.064382, 0.249239, 0.254416, 0.259870, 0.273209, 0.296573,
+		0.318594, 0.349566, 0.475064, 0.545230, 0.547487, 0.549174,
+		0.553855, 0.261089, 0.283319, 0.542071, 0.537960, 0.538102,
+		0.555097, 0.567112, 0.567025, 0.711515, 0.601539, 0.602574,
+		0.603672, 0.605227, 0.266370, 0.575452, 0.282814, 0.525994,
+		0.526136, 0.543131, 0.555145, 0.567029, 0.707903, 0.598322,
+		0.599357, 0.600455, 0.602009, 0.271972, 0.571003, 0.558056,
+		0.279092, 0.510042, 0.527455, 0.539470, 0.551353, 0.705936,
+		0.594274, 0.595309, 0.596407, 0.597961, 0.285999, 0.571011,
+		0.558064, 0.540786, 0.281748, 0.520441, 0.532456, 0.544339,
+		0.691200, 0.597052, 0.595204, 0.596302, 0.597856, 0.310831,
+		0.589087, 0.576140, 0.559292, 0.551598, 0.288367, 0.527706,
+		0.539590, 0.680963, 0.590505, 0.602476, 0.600279, 0.601833,
+		0.334153, 0.601972, 0.589025, 0.572177, 0.564483, 0.559491,
+		0.288761, 0.530054, 0.666353, 0.579174, 0.591144, 0.603177,
+		0.601437, 0.367213, 0.601743, 0.601740, 0.584892, 0.577198,
+		0.572206, 0.561886, 0.290477, 0.655429, 0.571128, 0.583098,
+		0.595132, 0.607539, 0.500000, 0.753664, 0.749733, 0.747574,
+		0.731619, 0.720673, 0.704836, 0.693005, 0.304993, 0.662936,
+		0.679005, 0.695136, 0.711330, 0.577011, 0.639310, 0.635796,
+		0.631410, 0.634280, 0.627425, 0.615243, 0.606644, 0.703145,
+		0.436545, 0.589277, 0.603109, 0.617313, 0.579532, 0.640521,
+		0.637006, 0.632621, 0.632368, 0.640259, 0.628076, 0.619477,
+		0.720451, 0.625713, 0.446635, 0.604527, 0.618734, 0.581449,
+		0.641808, 0.638293, 0.633907, 0.633654, 0.637993, 0.640984,
+		0.632385, 0.737832, 0.640484, 0.642081, 0.457422, 0.620617,
+		0.586630, 0.643602, 0.640087, 0.635702, 0.635449, 0.639788,
+		0.639227, 0.645696, 0.755290, 0.655658, 0.657255, 0.659356,
+		0.469344,
+	},
+	{ // K6o
+		0.123047, 0.234492, 0.340849, 0.336744, 0.336830, 0.349223,
+		0.346853, 0.343626, 0.235731, 0.374940, 0.378485, 0.382030,
+		0.385968, 0.242928, 0.066640, 0.253138, 0.258449, 0.270688,
+		0.292350, 0.320633, 0.349574, 0.475064, 0.560127, 0.562383,
+		0.563551, 0.565068, 0.358909, 0.264934, 0.281654, 0.526648,
+		0.526790, 0.543785, 0.557302, 0.569380, 0.706450, 0.600088,
+		0.601123, 0.602221, 0.603903, 0.354460, 0.270388, 0.558845,
+		0.277931, 0.510696, 0.528109, 0.541627, 0.553704, 0.704483,
+		0.596040, 0.597075, 0.598173, 0.599855, 0.354405, 0.283229,
+		0.558853, 0.541576, 0.280545, 0.521095, 0.534613, 0.546690,
+		0.689747, 0.598818, 0.596969, 0.598068, 0.599750, 0.367579,
+		0.306241, 0.576923, 0.560075, 0.552381, 0.287067, 0.529863,
+		0.538647, 0.675818, 0.588977, 0.600948, 0.598751, 0.600433,
+		0.365023, 0.336377, 0.591241, 0.574393, 0.566699, 0.561701,
+		0.293017, 0.533046, 0.665140, 0.581580, 0.593550, 0.605584,
+		0.603971, 0.361382, 0.367213, 0.604191, 0.587343, 0.579650,
+		0.571069, 0.565142, 0.293983, 0.650595, 0.570313, 0.582284,
+		0.594317, 0.606414, 0.246336, 0.500000, 0.748296, 0.746138,
+		0.730183, 0.715226, 0.703775, 0.688004, 0.308268, 0.657854,
+		0.673881, 0.689971, 0.706123, 0.395482, 0.593109, 0.637588,
+		0.633202, 0.636072, 0.625629, 0.617839, 0.605722, 0.697888,
+		0.442573, 0.589732, 0.603563, 0.617457, 0.399468, 0.595630,
+		0.638799, 0.634413, 0.634160, 0.638462, 0.630672, 0.618555,
+		0.715153, 0.625970, 0.452411, 0.604982, 0.618877, 0.403466,
+		0.596978, 0.640085, 0.635699, 0.635447, 0.636197, 0.643581,
+		0.631463, 0.732491, 0.640741, 0.642338, 0.462903, 0.620889,
+		0.407910, 0.598723, 0.642020, 0.637634, 0.637382, 0.638132,
+		0.641964, 0.644452, 0.749908, 0.655592, 0.657189, 0.659430,
+		0.474060,
+	},
+	{ // Q6o
+		0.127422, 0.311431, 0.236082, 0.337619, 0.337705, 0.350098,
+		0.347334, 0.347731, 0.239368, 0.378204, 0.381748, 0.385293,
+		0.389231, 0.326862, 0.124451, 0.238248, 0.326866, 0.326951,
+		0.339345, 0.347989, 0.345618, 0.241053, 0.378616, 0.382160,
+		0.385705, 0.389250, 0.244655, 0.246667, 0.069309, 0.257172,
+		0.269066, 0.289489, 0.315371, 0.351613, 0.475064, 0.560135,
+		0.562391, 0.563559, 0.565076, 0.355274, 0.343708, 0.268951,
+		0.276739, 0.511226, 0.528609, 0.542127, 0.555707, 0.703142,
+		0.598237, 0.599272, 0.600370, 0.602052, 0.355218, 0.343653,
+		0.281426, 0.542235, 0.279312, 0.521595, 0.535113, 0.548693,
+		0.688406, 0.601015, 0.599167, 0.600265, 0.601947, 0.368392,
+		0.356827, 0.303108, 0.560702, 0.553008, 0.285749, 0.527069,
+		0.540650, 0.673957, 0.590653, 0.602623, 0.600427, 0.602109,
+		0.365402, 0.365933, 0.330650, 0.575014, 0.567320, 0.558739,
+		0.291560, 0.535049, 0.660108, 0.580483, 0.592454, 0.604487,
+		0.602874, 0.365831, 0.363380, 0.369437, 0.589397, 0.581703,
+		0.573122, 0.567189, 0.298040, 0.649495, 0.573151, 0.585121,
+		0.597155, 0.609251, 0.250267, 0.251704, 0.500000, 0.744814,
+		0.728859, 0.713334, 0.698441, 0.687055, 0.311512, 0.652883,
+		0.668869, 0.684917, 0.701028, 0.398997, 0.399292, 0.593109,
+		0.635491, 0.638362, 0.627348, 0.616540, 0.608816, 0.692750,
+		0.445409, 0.589349, 0.603180, 0.617073, 0.402983, 0.403279,
+		0.595630, 0.636702, 0.636449, 0.640182, 0.629373, 0.621649,
+		0.709973, 0.625545, 0.454994, 0.604599, 0.618494, 0.406981,
+		0.407277, 0.596978, 0.637988, 0.637736, 0.637916, 0.642281,
+		0.634557, 0.727270, 0.640316, 0.641913, 0.465193, 0.620506,
+		0.411425, 0.411292, 0.598723, 0.639923, 0.639671, 0.639851,
+		0.640665, 0.647545, 0.744644, 0.655167, 0.656764, 0.659006,
+		0.476013,
+	},
+	{ // J6o
+		0.127427, 0.311433, 0.308518, 0.234027, 0.335697, 0.347510,
+		0.344747, 0.345143, 0.241355, 0.378148, 0.381692, 0.385237,
+		0.389175, 0.326871, 0.124457, 0.299561, 0.236193, 0.324944,
+		0.336757, 0.345401, 0.343030, 0.243040, 0.378559, 0.382104,
+		0.385649, 0.389194, 0.323555, 0.313852, 0.121486, 0.238120,
+		0.314346, 0.326160, 0.334803, 0.343447, 0.244615, 0.376602,
+		0.380147, 0.383692, 0.387236, 0.242438, 0.244451, 0.246217,
+		0.068024, 0.263624, 0.286672, 0.311235, 0.344037, 0.475064,
+		0.558080, 0.560336, 0.561504, 0.563021, 0.353050, 0.341484,
+		0.330080, 0.275602, 0.276602, 0.519360, 0.532848, 0.546429,
+		0.683317, 0.599980, 0.597720, 0.598818, 0.600500, 0.365591,
+		0.354025, 0.342622, 0.300135, 0.550819, 0.282386, 0.523972,
+		0.537553, 0.669107, 0.589308, 0.601278, 0.599081, 0.600764,
+		0.362601, 0.363132, 0.351728, 0.326269, 0.565099, 0.555630,
+		0.288070, 0.529052, 0.655258, 0.578616, 0.590587, 0.602620,
+		0.601008, 0.363023, 0.360572, 0.360837, 0.361325, 0.579477,
+		0.570007, 0.560920, 0.294369, 0.644645, 0.568384, 0.580354,
+		0.592387, 0.604484, 0.252426, 0.253862, 0.255186, 0.500000,
+		0.723558, 0.708286, 0.693393, 0.682002, 0.313029, 0.648208,
+		0.664153, 0.680159, 0.696229, 0.398936, 0.399232, 0.396943,
+		0.590884, 0.637278, 0.625945, 0.614567, 0.603683, 0.687969,
+		0.444702, 0.587418, 0.601250, 0.615143, 0.402922, 0.403218,
+		0.400929, 0.593405, 0.634937, 0.638779, 0.627400, 0.616516,
+		0.705151, 0.623483, 0.454034, 0.602668, 0.616564, 0.406921,
+		0.407217, 0.404928, 0.594754, 0.636223, 0.636513, 0.640309,
+		0.629425, 0.722406, 0.638254, 0.639851, 0.463939, 0.618575,
+		0.411364, 0.411232, 0.408943, 0.596498, 0.638158, 0.638448,
+		0.638692, 0.642413, 0.739739, 0.653105, 0.654702, 0.656944,
+		0.474422,
+	},
+	{ // T6o
+		0.144961, 0.324227, 0.321311, 0.318161, 0.246989, 0.359396,
+		0.356632, 0.356635, 0.256236, 0.393736, 0.393746, 0.397290,
+		0.401228, 0.340470, 0.141990, 0.312355, 0.309204, 0.249155,
+		0.348701, 0.357286, 0.354522, 0.257921, 0.394148, 0.394158,
+		0.397702, 0.401247, 0.337155, 0.327452, 0.139019, 0.300403,
+		0.251081, 0.338104, 0.346688, 0.355332, 0.259496, 0.392190,
+		0.392200, 0.395745, 0.399290, 0.333752, 0.324049, 0.314508,
+		0.135750, 0.237770, 0.328229, 0.336814, 0.345457, 0.264644,
+		0.391072, 0.391082, 0.394627, 0.398172, 0.256315, 0.258328,
+		0.260093, 0.245540, 0.085760, 0.288425, 0.312906, 0.345594,
+		0.475064, 0.566372, 0.567066, 0.568234, 0.569751, 0.377901,
+		0.366398, 0.354995, 0.344361, 0.301515, 0.287197, 0.530748,
+		0.544299, 0.675917, 0.598578, 0.610505, 0.608308, 0.609991,
+		0.374910, 0.375441, 0.364038, 0.353404, 0.327565, 0.562570,
+		0.292755, 0.535505, 0.661787, 0.587988, 0.599915, 0.611948,
+		0.610336, 0.374904, 0.372453, 0.373146, 0.362512, 0.362506,
+		0.576916, 0.567510, 0.298885, 0.647940, 0.577692, 0.589226,
+		0.601259, 0.613356, 0.268381, 0.269817, 0.271141, 0.276442,
+		0.500000, 0.715441, 0.700232, 0.685341, 0.327583, 0.657237,
+		0.658308, 0.674273, 0.690301, 0.415722, 0.416018, 0.413729,
+		0.412494, 0.599849, 0.635431, 0.624163, 0.613215, 0.697993,
+		0.461219, 0.590218, 0.604050, 0.617943, 0.415743, 0.416039,
+		0.413750, 0.412516, 0.600553, 0.648215, 0.636946, 0.625570,
+		0.699043, 0.626420, 0.465044, 0.604511, 0.618406, 0.419742,
+		0.420038, 0.417749, 0.416514, 0.601902, 0.645949, 0.649855,
+		0.638479, 0.716256, 0.641191, 0.641655, 0.474653, 0.620418,
+		0.424185, 0.424053, 0.421764, 0.420529, 0.603646, 0.647885,
+		0.648238, 0.651467, 0.733548, 0.656042, 0.656506, 0.658747,
+		0.484801,
+	},
+	{ // 96o
+		0.155993, 0.336026, 0.336422, 0.333272, 0.332201, 0.261290,
+		0.363727, 0.363731, 0.266356, 0.396509, 0.404544, 0.404554,
+		0.408492, 0.353287, 0.157187, 0.330444, 0.327294, 0.326223,
+		0.263604, 0.367803, 0.364930, 0.271733, 0.400233, 0.408268,
+		0.408278, 0.411823, 0.353555, 0.346949, 0.154786, 0.318848,
+		0.317777, 0.265659, 0.357677, 0.366261, 0.273828, 0.398797,
+		0.406832, 0.406842, 0.410387, 0.350152, 0.343546, 0.334373,
+		0.151516, 0.306903, 0.253866, 0.347701, 0.356285, 0.278737,
+		0.397578, 0.405613, 0.405623, 0.409168, 0.348428, 0.341823,
+		0.332650, 0.320957, 0.139744, 0.261433, 0.339292, 0.347876,
+		0.272896, 0.401418, 0.407432, 0.407442, 0.410986, 0.271707,
+		0.273880, 0.275780, 0.262824, 0.270201, 0.101469, 0.314540,
+		0.347186, 0.475065, 0.565653, 0.573101, 0.573827, 0.575344,
+		0.382194, 0.386454, 0.375543, 0.364799, 0.355794, 0.328821,
+		0.292898, 0.539013, 0.664023, 0.593493, 0.605917, 0.617908,
+		0.616295, 0.382188, 0.383325, 0.384588, 0.373845, 0.364839,
+		0.363720, 0.570951, 0.298860, 0.649894, 0.582905, 0.595329,
+		0.607320, 0.619416, 0.279327, 0.284774, 0.286666, 0.291714,
+		0.284559, 0.500000, 0.702518, 0.687312, 0.337845, 0.644366,
+		0.662868, 0.663967, 0.679954, 0.418389, 0.422274, 0.420554,
+		0.419210, 0.422585, 0.598668, 0.629669, 0.618403, 0.683967,
+		0.461331, 0.596298, 0.605447, 0.619340, 0.427503, 0.431387,
+		0.429668, 0.428324, 0.429670, 0.607080, 0.642933, 0.631666,
+		0.704309, 0.632489, 0.477237, 0.604604, 0.618499, 0.427537,
+		0.431421, 0.429702, 0.428358, 0.429704, 0.607827, 0.655792,
+		0.644525, 0.705391, 0.642278, 0.641704, 0.481297, 0.619032,
+		0.431981, 0.435436, 0.433717, 0.432373, 0.433719, 0.609571,
+		0.654175, 0.657513, 0.722641, 0.657129, 0.656555, 0.657093,
+		0.491108,
+	},
+	{ // 86o
+		0.173526, 0.348641, 0.349038, 0.345887, 0.344816, 0.348304,
+		0.274243, 0.375497, 0.281117, 0.406085, 0.411988, 0.420416,
+		0.420426, 0.366690, 0.169077, 0.341003, 0.337859, 0.339573,
+		0.343622, 0.277715, 0.372505, 0.282330, 0.405619, 0.411522,
+		0.419557, 0.419567, 0.366964, 0.358383, 0.170270, 0.331980,
+		0.333694, 0.337800, 0.279918, 0.376737, 0.287597, 0.406974,
+		0.412877, 0.420912, 0.420922, 0.363561, 0.354980, 0.348476,
+		0.167001, 0.322763, 0.327767, 0.268254, 0.366820, 0.292506,
+		0.405755, 0.411658, 0.419693, 0.419703, 0.361838, 0.356269,
+		0.349765, 0.338011, 0.155798, 0.319281, 0.277092, 0.358722,
+		0.286945, 0.410408, 0.413896, 0.421931, 0.421941, 0.365087,
+		0.360130, 0.353686, 0.342882, 0.333772, 0.141896, 0.277550,
+		0.353455, 0.285655, 0.407249, 0.420954, 0.426968, 0.426978,
+		0.285576, 0.289059, 0.291119, 0.278298, 0.287018, 0.286674,
+		0.117830, 0.348738, 0.475065, 0.564895, 0.572270, 0.577170,
+		0.577927, 0.394371, 0.391115, 0.395537, 0.384857, 0.376171,
+		0.370575, 0.364893, 0.299372, 0.647425, 0.585125, 0.597469,
+		0.609957, 0.622010, 0.295164, 0.296225, 0.301559, 0.306607,
+		0.299768, 0.297482, 0.500000, 0.684537, 0.348725, 0.638979,
+		0.646076, 0.664599, 0.665720, 0.428280, 0.427772, 0.429071,
+		0.427728, 0.431991, 0.428581, 0.597454, 0.620353, 0.678011,
+		0.464741, 0.595964, 0.608546, 0.620206, 0.435069, 0.434561,
+		0.435860, 0.434517, 0.436323, 0.443191, 0.605784, 0.633527,
+		0.686042, 0.631685, 0.477677, 0.607679, 0.616892, 0.444624,
+		0.443687, 0.444986, 0.443642, 0.445449, 0.450288, 0.611456,
+		0.646866, 0.706416, 0.645117, 0.644516, 0.493775, 0.618887,
+		0.444675, 0.443737, 0.445037, 0.443693, 0.445499, 0.450339,
+		0.612249, 0.659805, 0.707534, 0.657627, 0.654385, 0.656899,
+		0.497995,
+	},
+	{ // 76o
+		0.186675, 0.361195, 0.357090, 0.353946, 0.355660, 0.359669,
+		0.357760, 0.285602, 0.292171, 0.412726, 0.418236, 0.424532,
+		0.435093, 0.380029, 0.186608, 0.353557, 0.350413, 0.352127,
+		0.356176, 0.358877, 0.290665, 0.297029, 0.415527, 0.421036,
+		0.426939, 0.435368, 0.375586, 0.371721, 0.182158, 0.333222,
+		0.344198, 0.351089, 0.354350, 0.294027, 0.298132, 0.412691,
+		0.418201, 0.424104, 0.432532, 0.372190, 0.368325, 0.349918,
+		0.178902, 0.333286, 0.341075, 0.344394, 0.282510, 0.303049,
+		0.411478, 0.416988, 0.422891, 0.431319, 0.373479, 0.369614,
+		0.361134, 0.349408, 0.171282, 0.334978, 0.338777, 0.291717,
+		0.300711, 0.419027, 0.422012, 0.427914, 0.436343, 0.377298,
+		0.373474, 0.368068, 0.357292, 0.350653, 0.157949, 0.331434,
+		0.293509, 0.299703, 0.416178, 0.429883, 0.433371, 0.441799,
+		0.374677, 0.375867, 0.371072, 0.360356, 0.354235, 0.346453,
+		0.144124, 0.295709, 0.303117, 0.416355, 0.430060, 0.443764,
+		0.450171, 0.297719, 0.302929, 0.306299, 0.293638, 0.302746,
+		0.303821, 0.305299, 0.134519, 0.475064, 0.564179, 0.571472,
+		0.576257, 0.583281, 0.306995, 0.311996, 0.312945, 0.317998,
+		0.314659, 0.312688, 0.315463, 0.500000, 0.359571, 0.631730,
+		0.636595, 0.643712, 0.662256, 0.435017, 0.438027, 0.434933,
+		0.433595, 0.441012, 0.437922, 0.438126, 0.596281, 0.670039,
+		0.468545, 0.595665, 0.606370, 0.619325, 0.441377, 0.444387,
+		0.441293, 0.439956, 0.444775, 0.452531, 0.452735, 0.604528,
+		0.675624, 0.630816, 0.480841, 0.603516, 0.616473, 0.448607,
+		0.451188, 0.448094, 0.446757, 0.451576, 0.456875, 0.467357,
+		0.610077, 0.683687, 0.642297, 0.639553, 0.493928, 0.618444,
+		0.460497, 0.460759, 0.457666, 0.456328, 0.461147, 0.466447,
+		0.474900, 0.618073, 0.704097, 0.656132, 0.653388, 0.655875,
+		0.510573,
+	},
+	{ // 66
+		0.195425, 0.523059, 0.519847, 0.515672, 0.516864, 0.532382,
+		0.533149, 0.537131, 0.654977, 0.656228, 0.658010, 0.660315,
+		0.665513, 0.549886, 0.195078, 0.509146, 0.504971, 0.506162,
+		0.521720, 0.533558, 0.534325, 0.651998, 0.666131, 0.667914,
+		0.669697, 0.672001, 0.546378, 0.534770, 0.194730, 0.494262,
+		0.495453, 0.511050, 0.522927, 0.534765, 0.649051, 0.663142,
+		0.664925, 0.666708, 0.669012, 0.541859, 0.530250, 0.518631,
+		0.190332, 0.479369, 0.495430, 0.507347, 0.519224, 0.647697,
+		0.659631, 0.661414, 0.663197, 0.665502, 0.543021, 0.531413,
+		0.519794, 0.502480, 0.190721, 0.491188, 0.503144, 0.515061,
+		0.634396, 0.663391, 0.663302, 0.665085, 0.667389, 0.559754,
+		0.548188, 0.536611, 0.519768, 0.515248, 0.188171, 0.500062,
+		0.512017, 0.625032, 0.663536, 0.671229, 0.671140, 0.673444,
+		0.560476, 0.560911, 0.549376, 0.532575, 0.528097, 0.524972,
+		0.185556, 0.508073, 0.615105, 0.663191, 0.670884, 0.678578,
+		0.679010, 0.564713, 0.561636, 0.562102, 0.545343, 0.540906,
+		0.537824, 0.533775, 0.183579, 0.605211, 0.662881, 0.670574,
+		0.678268, 0.685961, 0.695007, 0.691732, 0.688488, 0.686971,
+		0.672417, 0.662155, 0.651275, 0.640429, 0.500000, 0.814130,
+		0.831183, 0.848313, 0.865482, 0.690699, 0.701453, 0.698188,
+		0.694373, 0.698286, 0.698521, 0.698220, 0.697953, 0.862211,
+		0.813593, 0.810054, 0.815044, 0.820445, 0.692632, 0.703385,
+		0.700121, 0.696306, 0.698204, 0.706646, 0.706344, 0.706077,
+		0.880700, 0.850674, 0.814034, 0.816419, 0.821822, 0.695146,
+		0.705330, 0.702065, 0.698250, 0.700149, 0.706576, 0.714481,
+		0.714214, 0.899280, 0.855994, 0.857497, 0.814499, 0.823726,
+		0.700830, 0.707861, 0.704596, 0.700781, 0.702679, 0.709107,
+		0.714998, 0.722367, 0.917914, 0.861758, 0.863262, 0.865348,
+		0.815567,
+	},
+	{ // 65s
+		0.224988, 0.389054, 0.388238, 0.384368, 0.381302, 0.388040,
+		0.386583, 0.385456, 0.323113, 0.330174, 0.432509, 0.438341,
+		0.446258, 0.415324, 0.224934, 0.384752, 0.380882, 0.377816,
+		0.384592, 0.387644, 0.385698, 0.328061, 0.334881, 0.441959,
+		0.447407, 0.453239, 0.414440, 0.410679, 0.224879, 0.377492,
+		0.374425, 0.381256, 0.384346, 0.386909, 0.332898, 0.339476,
+		0.442350, 0.447798, 0.453631, 0.410335, 0.406574, 0.402910,
+		0.220892, 0.358152, 0.374357, 0.380118, 0.383208, 0.337496,
+		0.341959, 0.440314, 0.445762, 0.451594, 0.407065, 0.403304,
+		0.399640, 0.382658, 0.217996, 0.364666, 0.371183, 0.374422,
+		0.329483, 0.349016, 0.440088, 0.445537, 0.451369, 0.413942,
+		0.410221, 0.406614, 0.399364, 0.389068, 0.211409, 0.367872,
+		0.371754, 0.342196, 0.351012, 0.450226, 0.453207, 0.459039,
+		0.412076, 0.413192, 0.409625, 0.405199, 0.395693, 0.392081,
+		0.199439, 0.367208, 0.348004, 0.354439, 0.450464, 0.463792,
+		0.467261, 0.410578, 0.410807, 0.412078, 0.408213, 0.398865,
+		0.395935, 0.391158, 0.187133, 0.355529, 0.363586, 0.455837,
+		0.469165, 0.482493, 0.337064, 0.342146, 0.347117, 0.351792,
+		0.342763, 0.355634, 0.361021, 0.368270, 0.185870, 0.500000,
+		0.554983, 0.563446, 0.568038, 0.344458, 0.349291, 0.354013,
+		0.356401, 0.363697, 0.364978, 0.367758, 0.376762, 0.524956,
+		0.397450, 0.615288, 0.620524, 0.627570, 0.459666, 0.469753,
+		0.470136, 0.467959, 0.467681, 0.478011, 0.478190, 0.483846,
+		0.584231, 0.649778, 0.496625, 0.615618, 0.626077, 0.466077,
+		0.475753, 0.476136, 0.473959, 0.473681, 0.481467, 0.492262,
+		0.497918, 0.593827, 0.655824, 0.642385, 0.508051, 0.623222,
+		0.474750, 0.482189, 0.482572, 0.480395, 0.480117, 0.487904,
+		0.496283, 0.512015, 0.599240, 0.663903, 0.653510, 0.650773,
+		0.520214,
+	},
+	{ // 64s
+		0.208536, 0.376538, 0.375721, 0.371851, 0.372116, 0.374327,
+		0.373171, 0.372045, 0.308202, 0.389646, 0.318275, 0.426370,
+		0.434287, 0.402321, 0.208482, 0.372236, 0.368366, 0.368631,
+		0.370879, 0.374233, 0.372287, 0.313190, 0.399096, 0.322981,
+		0.435436, 0.441268, 0.401437, 0.397675, 0.208427, 0.364975,
+		0.365240, 0.367543, 0.370934, 0.373498, 0.318067, 0.399487,
+		0.327576, 0.435827, 0.441659, 0.397332, 0.393571, 0.389906,
+		0.204440, 0.357793, 0.360644, 0.366707, 0.369796, 0.322705,
+		0.397451, 0.330059, 0.433791, 0.439623, 0.397472, 0.393711,
+		0.390047, 0.382216, 0.205881, 0.355820, 0.362336, 0.365575,
+		0.328503, 0.403067, 0.338558, 0.436594, 0.442426, 0.399864,
+		0.396142, 0.392535, 0.385285, 0.379982, 0.203468, 0.355403,
+		0.359588, 0.324650, 0.397384, 0.349877, 0.439434, 0.445266,
+		0.398308, 0.399424, 0.395857, 0.391431, 0.386607, 0.379251,
+		0.194037, 0.356869, 0.340991, 0.395653, 0.353305, 0.450020,
+		0.453489, 0.396810, 0.397039, 0.398310, 0.394445, 0.389779,
+		0.383417, 0.380517, 0.182143, 0.350538, 0.399005, 0.362480,
+		0.455422, 0.468750, 0.320995, 0.326119, 0.331131, 0.335847,
+		0.341692, 0.337132, 0.353924, 0.363405, 0.168817, 0.445017,
+		0.500000, 0.533067, 0.543101, 0.415292, 0.425379, 0.425763,
+		0.423585, 0.429417, 0.423377, 0.421529, 0.425108, 0.466777,
+		0.335671, 0.606080, 0.576563, 0.588676, 0.331661, 0.336494,
+		0.341216, 0.343604, 0.352370, 0.364242, 0.367022, 0.376056,
+		0.524991, 0.640053, 0.388860, 0.615402, 0.622453, 0.453661,
+		0.463337, 0.463720, 0.461543, 0.464364, 0.467354, 0.478149,
+		0.483835, 0.561060, 0.602058, 0.650380, 0.496857, 0.615554,
+		0.462334, 0.469773, 0.470156, 0.467979, 0.470801, 0.473791,
+		0.482170, 0.497932, 0.572389, 0.614888, 0.658459, 0.642803,
+		0.509020,
+	},
+	{ // 63s
+		0.192085, 0.363963, 0.363147, 0.359277, 0.359542, 0.362467,
+		0.359400, 0.358576, 0.293234, 0.377925, 0.381075, 0.306377,
+		0.422317, 0.389255, 0.192030, 0.359661, 0.355792, 0.356056,
+		0.359019, 0.360462, 0.358818, 0.298262, 0.387375, 0.390525,
+		0.311083, 0.429239, 0.388371, 0.384610, 0.191975, 0.352401,
+		0.352666, 0.355682, 0.357163, 0.360029, 0.303180, 0.387766,
+		0.390916, 0.315678, 0.429630, 0.384266, 0.380505, 0.376840,
+		0.187988, 0.345218, 0.351400, 0.352936, 0.356328, 0.307858,
+		0.385730, 0.388880, 0.318161, 0.427594, 0.384406, 0.380645,
+		0.376981, 0.369150, 0.189429, 0.346576, 0.348565, 0.352106,
+		0.313697, 0.391345, 0.391683, 0.326660, 0.430397, 0.387436,
+		0.383714, 0.380107, 0.375624, 0.370321, 0.188035, 0.344111,
+		0.348296, 0.323655, 0.386092, 0.397642, 0.336519, 0.433649,
+		0.384167, 0.385283, 0.381716, 0.377290, 0.372466, 0.367654,
+		0.186097, 0.344343, 0.323430, 0.383520, 0.395070, 0.352230,
+		0.439658, 0.382980, 0.383209, 0.384480, 0.380615, 0.375949,
+		0.371819, 0.367625, 0.176742, 0.343509, 0.386952, 0.398503,
+		0.361405, 0.454920, 0.304864, 0.310029, 0.315083, 0.319841,
+		0.325727, 0.336033, 0.335401, 0.356288, 0.151687, 0.436554,
+		0.466933, 0.500000, 0.533746, 0.403259, 0.413346, 0.413729,
+		0.411552, 0.417384, 0.411780, 0.409042, 0.412701, 0.458115,
+		0.327978, 0.519731, 0.607187, 0.577125, 0.406751, 0.416838,
+		0.417222, 0.415044, 0.417866, 0.424066, 0.421327, 0.424986,
+		0.491069, 0.543300, 0.336807, 0.608191, 0.578392, 0.318889,
+		0.323723, 0.328444, 0.330832, 0.339599, 0.349769, 0.366375,
+		0.375409, 0.525052, 0.641702, 0.642864, 0.380715, 0.619953,
+		0.449919, 0.457295, 0.457678, 0.455501, 0.458323, 0.461640,
+		0.467995, 0.483757, 0.562388, 0.603074, 0.604498, 0.655889,
+		0.497867,
+	},
+	{ // 62s
+		0.175633, 0.351330, 0.350513, 0.346644, 0.346908, 0.349833,
+		0.346992, 0.344746, 0.278208, 0.366204, 0.369354, 0.372888,
+		0.294479, 0.376126, 0.175579, 0.347028, 0.343158, 0.343423,
+		0.346385, 0.348054, 0.344988, 0.283277, 0.375595, 0.378745,
+		0.381895, 0.299185, 0.375242, 0.371481, 0.175524, 0.339767,
+		0.340032, 0.343049, 0.344755, 0.346199, 0.288235, 0.375986,
+		0.379136, 0.382286, 0.303780, 0.371137, 0.367376, 0.363711,
+		0.171537, 0.332585, 0.338767, 0.341016, 0.342497, 0.292954,
+		0.373950, 0.377100, 0.380250, 0.306263, 0.371277, 0.367516,
+		0.363852, 0.356021, 0.172978, 0.333943, 0.336645, 0.338276,
+		0.298832, 0.379565, 0.379903, 0.383053, 0.314762, 0.374307,
+		0.370585, 0.366978, 0.362495, 0.357192, 0.171584, 0.332192,
+		0.334466, 0.308831, 0.374311, 0.385862, 0.386305, 0.324621,
+		0.371155, 0.372271, 0.368703, 0.364799, 0.359975, 0.355163,
+		0.170143, 0.332608, 0.322417, 0.371785, 0.383335, 0.394945,
+		0.338398, 0.368776, 0.369005, 0.370275, 0.366410, 0.361745,
+		0.357615, 0.355572, 0.168802, 0.325930, 0.374760, 0.386310,
+		0.397920, 0.360330, 0.288670, 0.293877, 0.298972, 0.303771,
+		0.309699, 0.320046, 0.334280, 0.337744, 0.134518, 0.431962,
+		0.456899, 0.466254, 0.500000, 0.391225, 0.401249, 0.401633,
+		0.399455, 0.405287, 0.399683, 0.396988, 0.400150, 0.453657,
+		0.320285, 0.506403, 0.520206, 0.608263, 0.394718, 0.404742,
+		0.405125, 0.402948, 0.405769, 0.411969, 0.409274, 0.412436,
+		0.480694, 0.529595, 0.329114, 0.521528, 0.609329, 0.398640,
+		0.408253, 0.408636, 0.406458, 0.409280, 0.412597, 0.421641,
+		0.424803, 0.490897, 0.544200, 0.545684, 0.338357, 0.610654,
+		0.306151, 0.310985, 0.315706, 0.318094, 0.326861, 0.337031,
+		0.351415, 0.374795, 0.525146, 0.643356, 0.644577, 0.646095,
+		0.373109,
+	},
+	{ // A5o
+		0.081021, 0.261398, 0.266615, 0.275756, 0.284971, 0.311547,
+		0.333927, 0.364081, 0.401317, 0.475044, 0.527935, 0.530358,
+		0.531402, 0.274150, 0.298477, 0.547252, 0.544034, 0.539986,
+		0.557399, 0.569414, 0.569327, 0.569713, 0.714619, 0.615089,
+		0.616187, 0.617285, 0.279473, 0.580813, 0.297972, 0.532068,
+		0.528020, 0.545433, 0.557447, 0.569331, 0.566496, 0.711006,
+		0.611871, 0.612969, 0.614067, 0.289056, 0.577298, 0.564351,
+		0.298203, 0.516117, 0.533530, 0.545544, 0.557428, 0.566562,
+		0.707457, 0.608717, 0.609815, 0.610913, 0.298740, 0.572913,
+		0.559966, 0.547082, 0.295216, 0.517499, 0.529932, 0.541815,
+		0.550950, 0.705553, 0.604732, 0.605830, 0.606928, 0.327055,
+		0.591424, 0.578477, 0.565593, 0.548379, 0.305001, 0.527418,
+		0.539302, 0.548436, 0.695316, 0.612010, 0.610225, 0.611323,
+		0.350768, 0.604309, 0.591362, 0.578478, 0.561693, 0.559025,
+		0.305965, 0.530178, 0.539313, 0.680707, 0.601090, 0.613124,
+		0.610927, 0.382939, 0.604080, 0.604077, 0.591193, 0.574408,
+		0.571740, 0.561849, 0.307681, 0.533063, 0.669783, 0.593044,
+		0.605078, 0.617111, 0.422989, 0.604518, 0.601003, 0.601064,
+		0.584278, 0.581611, 0.571720, 0.564983, 0.309301, 0.655542,
+		0.584708, 0.596741, 0.608775, 0.500000, 0.756923, 0.752992,
+		0.749123, 0.747028, 0.736090, 0.720254, 0.708422, 0.693124,
+		0.326268, 0.679843, 0.695893, 0.711941, 0.558056, 0.653483,
+		0.649968, 0.646516, 0.642194, 0.650090, 0.638336, 0.629737,
+		0.620839, 0.720845, 0.453369, 0.621146, 0.635042, 0.560778,
+		0.654769, 0.651254, 0.647802, 0.643480, 0.648253, 0.651245,
+		0.642645, 0.633747, 0.738142, 0.659336, 0.464156, 0.636532,
+		0.562017, 0.656072, 0.652557, 0.649105, 0.644782, 0.649556,
+		0.648996, 0.655570, 0.646672, 0.755454, 0.674186, 0.675859,
+		0.475649,
+	},
+	{ // K5o
+		0.120271, 0.230015, 0.337876, 0.337038, 0.332933, 0.348118,
+		0.346269, 0.343042, 0.343114, 0.232773, 0.366191, 0.369736,
+		0.373280, 0.238004, 0.066910, 0.252503, 0.261500, 0.269615,
+		0.294490, 0.323131, 0.351254, 0.386445, 0.475044, 0.528651,
+		0.531157, 0.532315, 0.355547, 0.264303, 0.281921, 0.527262,
+		0.523214, 0.540626, 0.554144, 0.566222, 0.566199, 0.706170,
+		0.604006, 0.605104, 0.606265, 0.354616, 0.273738, 0.559481,
+		0.282152, 0.511311, 0.528723, 0.542241, 0.554319, 0.566265,
+		0.702621, 0.600852, 0.601950, 0.603111, 0.350167, 0.282236,
+		0.555096, 0.542211, 0.279123, 0.512693, 0.526628, 0.538706,
+		0.550653, 0.700717, 0.596867, 0.597965, 0.599126, 0.366360,
+		0.308731, 0.573600, 0.560716, 0.543502, 0.288811, 0.524115,
+		0.532899, 0.544845, 0.686788, 0.600851, 0.599066, 0.600227,
+		0.364374, 0.339259, 0.587919, 0.575035, 0.558249, 0.555575,
+		0.295330, 0.527710, 0.539657, 0.676111, 0.593865, 0.605899,
+		0.603765, 0.360733, 0.369205, 0.600869, 0.587985, 0.571200,
+		0.564943, 0.559445, 0.296297, 0.530186, 0.661566, 0.582599,
+		0.594632, 0.606729, 0.360690, 0.406891, 0.600708, 0.600768,
+		0.583982, 0.577726, 0.572228, 0.561973, 0.298547, 0.650709,
+		0.574621, 0.586654, 0.598751, 0.243077, 0.500000, 0.748012,
+		0.744144, 0.742049, 0.727100, 0.715649, 0.699878, 0.688114,
+		0.313728, 0.658888, 0.674980, 0.691132, 0.386147, 0.559228,
+		0.641849, 0.638397, 0.634075, 0.638382, 0.631022, 0.618904,
+		0.610372, 0.698938, 0.447873, 0.606952, 0.620848, 0.390145,
+		0.562034, 0.643135, 0.639683, 0.635361, 0.636546, 0.643930,
+		0.631813, 0.623281, 0.716277, 0.644567, 0.458366, 0.622338,
+		0.394160, 0.563387, 0.644501, 0.641049, 0.636727, 0.637911,
+		0.641744, 0.644801, 0.636269, 0.733693, 0.659418, 0.661090,
+		0.469522,
+	},
+	{ // Q5o
+		0.124646, 0.308458, 0.231605, 0.337913, 0.333808, 0.348993,
+		0.346750, 0.347147, 0.346377, 0.236409, 0.369454, 0.372999,
+		0.376544, 0.323499, 0.124719, 0.237434, 0.330079, 0.325974,
+		0.341159, 0.350323, 0.347953, 0.344726, 0.241293, 0.378900,
+		0.382445, 0.385989, 0.239730, 0.245847, 0.069579, 0.260222,
+		0.267992, 0.291629, 0.317870, 0.353293, 0.386453, 0.475044,
+		0.528659, 0.531165, 0.532323, 0.355430, 0.347182, 0.272302,
+		0.280960, 0.511840, 0.529223, 0.542741, 0.556322, 0.568463,
+		0.701280, 0.603049, 0.604147, 0.605308, 0.350981, 0.342734,
+		0.280433, 0.542870, 0.277890, 0.513193, 0.527128, 0.540709,
+		0.552850, 0.699376, 0.599064, 0.600162, 0.601323, 0.367174,
+		0.358927, 0.305599, 0.561343, 0.544129, 0.287493, 0.521321,
+		0.534902, 0.546521, 0.684927, 0.602527, 0.600742, 0.601903,
+		0.364753, 0.368603, 0.333532, 0.575656, 0.558870, 0.552614,
+		0.293874, 0.529713, 0.538560, 0.671078, 0.592769, 0.604802,
+		0.602668, 0.365182, 0.366049, 0.371430, 0.590039, 0.573253,
+		0.566997, 0.561493, 0.300353, 0.533024, 0.660466, 0.585436,
+		0.597470, 0.609566, 0.364204, 0.362412, 0.406891, 0.603057,
+		0.586271, 0.579446, 0.570929, 0.565067, 0.301812, 0.645987,
+		0.574237, 0.586271, 0.598367, 0.247008, 0.251988, 0.500000,
+		0.742820, 0.740725, 0.725208, 0.710315, 0.698929, 0.683226,
+		0.316719, 0.653876, 0.669926, 0.686037, 0.389662, 0.399643,
+		0.559228, 0.640686, 0.636364, 0.640102, 0.629722, 0.621998,
+		0.609948, 0.693758, 0.450457, 0.606569, 0.620465, 0.393660,
+		0.403642, 0.562034, 0.641972, 0.637650, 0.638265, 0.642630,
+		0.634906, 0.622856, 0.711055, 0.644142, 0.460655, 0.621955,
+		0.397675, 0.407656, 0.563387, 0.643338, 0.639016, 0.639631,
+		0.640445, 0.647894, 0.635844, 0.728430, 0.658993, 0.660665,
+		0.471475,
+	},
+	{ // J5o
+		0.129020, 0.311721, 0.308806, 0.233195, 0.334683, 0.349809,
+		0.347566, 0.347570, 0.350423, 0.239986, 0.372659, 0.376203,
+		0.379748, 0.327020, 0.129094, 0.302768, 0.239024, 0.326849,
+		0.341974, 0.351139, 0.348375, 0.348772, 0.244870, 0.382104,
+		0.385649, 0.389194, 0.323705, 0.317320, 0.126123, 0.240991,
+		0.316251, 0.331377, 0.340542, 0.349185, 0.346815, 0.246445,
+		0.380147, 0.383692, 0.387236, 0.241456, 0.247573, 0.249381,
+		0.072657, 0.266715, 0.289804, 0.314408, 0.347251, 0.388492,
+		0.475044, 0.528667, 0.531173, 0.532331, 0.351794, 0.343547,
+		0.332144, 0.278997, 0.276624, 0.512961, 0.526867, 0.540448,
+		0.554092, 0.696509, 0.600500, 0.601598, 0.602759, 0.367924,
+		0.359677, 0.348274, 0.303577, 0.543969, 0.286144, 0.520640,
+		0.534220, 0.547864, 0.682299, 0.604064, 0.602279, 0.603440,
+		0.365503, 0.369353, 0.357949, 0.329752, 0.558678, 0.551962,
+		0.292398, 0.526259, 0.539903, 0.667930, 0.593784, 0.605818,
+		0.603684, 0.365498, 0.366365, 0.367058, 0.364850, 0.573055,
+		0.566339, 0.557822, 0.298697, 0.534366, 0.654146, 0.583680,
+		0.595713, 0.607809, 0.368590, 0.366798, 0.364509, 0.409116,
+		0.587506, 0.580790, 0.572272, 0.566405, 0.305627, 0.643599,
+		0.576415, 0.588448, 0.600545, 0.250877, 0.255856, 0.257180,
+		0.500000, 0.737780, 0.722516, 0.707055, 0.692227, 0.680909,
+		0.319680, 0.647578, 0.663586, 0.679656, 0.393113, 0.403095,
+		0.400806, 0.559228, 0.637833, 0.641681, 0.630732, 0.619988,
+		0.612332, 0.687210, 0.453009, 0.605526, 0.619421, 0.397112,
+		0.407093, 0.404804, 0.562034, 0.639120, 0.639844, 0.643640,
+		0.632897, 0.625240, 0.704465, 0.643008, 0.462913, 0.620911,
+		0.401126, 0.411108, 0.408819, 0.563387, 0.640485, 0.641210,
+		0.641454, 0.645885, 0.638228, 0.721798, 0.657859, 0.659531,
+		0.473396,
+	},
+	{ // T5o
+		0.129026, 0.311724, 0.308809, 0.305893, 0.231140, 0.347683,
+		0.344919, 0.344923, 0.347776, 0.241914, 0.372543, 0.376088,
+		0.379633, 0.327029, 0.129099, 0.302771, 0.299855, 0.236969,
+		0.339908, 0.348492, 0.345728, 0.346125, 0.246798, 0.381989,
+		0.385534, 0.389078, 0.323714, 0.317329, 0.126128, 0.291055,
+		0.238936, 0.329310, 0.337895, 0.346538, 0.344168, 0.248373,
+		0.380032, 0.383576, 0.387121, 0.320399, 0.314014, 0.304473,
+		0.123284, 0.240682, 0.319476, 0.328061, 0.336704, 0.345348,
+		0.251476, 0.378838, 0.382382, 0.385927, 0.239240, 0.245357,
+		0.247164, 0.248743, 0.071782, 0.284362, 0.311389, 0.342255,
+		0.380136, 0.475044, 0.526612, 0.529118, 0.530276, 0.365630,
+		0.357445, 0.346042, 0.335458, 0.297753, 0.283318, 0.517340,
+		0.530891, 0.544535, 0.674763, 0.601964, 0.599767, 0.600928,
+		0.362639, 0.366489, 0.355085, 0.344501, 0.326560, 0.548625,
+		0.288877, 0.522097, 0.535741, 0.660633, 0.591374, 0.603407,
+		0.601274, 0.362633, 0.363500, 0.364194, 0.353609, 0.359538,
+		0.562970, 0.553564, 0.295007, 0.527305, 0.646849, 0.580748,
+		0.592781, 0.604878, 0.365720, 0.363928, 0.361638, 0.362722,
+		0.400151, 0.577415, 0.568009, 0.558988, 0.301714, 0.636303,
+		0.570583, 0.582616, 0.594713, 0.252972, 0.257951, 0.259275,
+		0.262220, 0.500000, 0.714620, 0.699411, 0.684583, 0.673260,
+		0.320912, 0.640414, 0.656381, 0.672409, 0.392990, 0.402972,
+		0.400683, 0.399213, 0.557004, 0.639449, 0.628181, 0.616868,
+		0.606051, 0.679792, 0.452017, 0.602530, 0.616426, 0.396988,
+		0.406970, 0.404681, 0.403211, 0.559810, 0.637184, 0.641089,
+		0.629776, 0.618960, 0.697005, 0.639798, 0.461627, 0.617916,
+		0.401003, 0.410985, 0.408696, 0.407226, 0.561163, 0.638550,
+		0.638903, 0.642764, 0.631948, 0.714297, 0.654649, 0.656321,
+		0.471774,
+	},
+	{ // 95o
+		0.140059, 0.323523, 0.323919, 0.321525, 0.318375, 0.245450,
+		0.352015, 0.352018, 0.354478, 0.252034, 0.383342, 0.383352,
+		0.386896, 0.339846, 0.144296, 0.320861, 0.318466, 0.315316,
+		0.251425, 0.359010, 0.356136, 0.356140, 0.260610, 0.396100,
+		0.396110, 0.399654, 0.340114, 0.336827, 0.141895, 0.310020,
+		0.306870, 0.253522, 0.348883, 0.357468, 0.354704, 0.262705,
+		0.394664, 0.394674, 0.398218, 0.337368, 0.334081, 0.324908,
+		0.139050, 0.298150, 0.256778, 0.338948, 0.347532, 0.356176,
+		0.265569, 0.393369, 0.393378, 0.396923, 0.333965, 0.330678,
+		0.321505, 0.312047, 0.136033, 0.245956, 0.330140, 0.338724,
+		0.347368, 0.273166, 0.393317, 0.393327, 0.396872, 0.254640,
+		0.260918, 0.262860, 0.266035, 0.254120, 0.087507, 0.313134,
+		0.343918, 0.381685, 0.475044, 0.535415, 0.536100, 0.537258,
+		0.369923, 0.377502, 0.366590, 0.355896, 0.346411, 0.327940,
+		0.289020, 0.525605, 0.539219, 0.662869, 0.597376, 0.609367,
+		0.607233, 0.369917, 0.374373, 0.375635, 0.364942, 0.355456,
+		0.360834, 0.557006, 0.294982, 0.530490, 0.648804, 0.586851,
+		0.598842, 0.610938, 0.372575, 0.374371, 0.372652, 0.374055,
+		0.364569, 0.401332, 0.571419, 0.562078, 0.301479, 0.635022,
+		0.576623, 0.588220, 0.600317, 0.263910, 0.272900, 0.274792,
+		0.277484, 0.285380, 0.500000, 0.701689, 0.686546, 0.671723,
+		0.330695, 0.644827, 0.645920, 0.661907, 0.404750, 0.418320,
+		0.416600, 0.415021, 0.414934, 0.566538, 0.634167, 0.622964,
+		0.612084, 0.684897, 0.464169, 0.602062, 0.615958, 0.404784,
+		0.418354, 0.416634, 0.415055, 0.414969, 0.567242, 0.647026,
+		0.635823, 0.624514, 0.685979, 0.639236, 0.468229, 0.616490,
+		0.408798, 0.422369, 0.420649, 0.419070, 0.418983, 0.568595,
+		0.644840, 0.648811, 0.637502, 0.703229, 0.654086, 0.654625,
+		0.478040,
+	},
+	{ // 85o
+		0.157591, 0.336138, 0.336535, 0.334140, 0.330990, 0.334795,
+		0.258402, 0.363784, 0.366244, 0.266795, 0.390786, 0.398821,
+		0.398831, 0.353250, 0.156186, 0.331420, 0.331816, 0.328666,
+		0.333031, 0.265537, 0.363712, 0.363715, 0.271207, 0.399353,
+		0.407388, 0.407398, 0.353523, 0.348260, 0.157379, 0.325937,
+		0.322787, 0.327210, 0.267781, 0.367944, 0.365070, 0.276473,
+		0.400708, 0.408743, 0.408753, 0.350777, 0.348527, 0.342023,
+		0.155104, 0.314422, 0.319426, 0.271167, 0.358479, 0.367064,
+		0.279857, 0.399934, 0.407969, 0.407979, 0.347375, 0.345125,
+		0.338620, 0.329531, 0.152087, 0.309719, 0.261624, 0.349570,
+		0.358154, 0.287215, 0.399782, 0.407817, 0.407827, 0.350953,
+		0.349314, 0.342871, 0.334408, 0.323972, 0.140835, 0.273481,
+		0.344385, 0.352970, 0.285926, 0.406846, 0.412859, 0.412869,
+		0.268509, 0.276097, 0.278199, 0.281509, 0.270938, 0.282904,
+		0.103910, 0.345551, 0.383277, 0.475045, 0.534697, 0.543515,
+		0.544231, 0.382100, 0.382162, 0.386585, 0.376383, 0.366788,
+		0.361281, 0.362090, 0.295494, 0.530633, 0.646305, 0.588991,
+		0.601479, 0.613532, 0.384757, 0.382161, 0.383460, 0.385433,
+		0.375837, 0.370331, 0.402546, 0.561874, 0.301780, 0.632242,
+		0.578471, 0.590958, 0.603012, 0.279746, 0.284351, 0.289685,
+		0.292945, 0.300589, 0.298311, 0.500000, 0.683739, 0.668601,
+		0.341239, 0.627180, 0.645704, 0.646825, 0.412316, 0.421493,
+		0.422793, 0.421783, 0.421587, 0.428461, 0.565357, 0.624825,
+		0.613626, 0.665737, 0.464566, 0.604777, 0.613990, 0.421442,
+		0.430619, 0.431919, 0.430909, 0.430713, 0.435559, 0.575269,
+		0.638164, 0.626965, 0.686111, 0.641659, 0.480665, 0.612697,
+		0.421492, 0.430670, 0.431969, 0.430959, 0.430763, 0.435609,
+		0.576020, 0.651102, 0.639903, 0.687229, 0.651527, 0.650459,
+		0.484884,
+	},
+	{ // 75o
+		0.170740, 0.348692, 0.344587, 0.344984, 0.341833, 0.346159,
+		0.344250, 0.269762, 0.374682, 0.277850, 0.397034, 0.402937,
+		0.410972, 0.366588, 0.173717, 0.343974, 0.344370, 0.341220,
+		0.345585, 0.348286, 0.278487, 0.375420, 0.285906, 0.408868,
+		0.414771, 0.422806, 0.362146, 0.361599, 0.169268, 0.336435,
+		0.333291, 0.340498, 0.343760, 0.281890, 0.372584, 0.287008,
+		0.406032, 0.411935, 0.419970, 0.362419, 0.361872, 0.353392,
+		0.170587, 0.327493, 0.335281, 0.338600, 0.285422, 0.377479,
+		0.293564, 0.408049, 0.413952, 0.421987, 0.359016, 0.358469,
+		0.349990, 0.343568, 0.167571, 0.325417, 0.329533, 0.276248,
+		0.368628, 0.300922, 0.407897, 0.413800, 0.421835, 0.363164,
+		0.362659, 0.357253, 0.351458, 0.340853, 0.156888, 0.324068,
+		0.289440, 0.363754, 0.299913, 0.415775, 0.419263, 0.427298,
+		0.360543, 0.365052, 0.360257, 0.354523, 0.344758, 0.338928,
+		0.143492, 0.294111, 0.361855, 0.303360, 0.415983, 0.429688,
+		0.435701, 0.280652, 0.289967, 0.293379, 0.296849, 0.286665,
+		0.300051, 0.304228, 0.120683, 0.384828, 0.475045, 0.533939,
+		0.542684, 0.547256, 0.393356, 0.394278, 0.391184, 0.396317,
+		0.386785, 0.381597, 0.379647, 0.403719, 0.302047, 0.623238,
+		0.574892, 0.587299, 0.599850, 0.291578, 0.300122, 0.301071,
+		0.307773, 0.315417, 0.313454, 0.316261, 0.500000, 0.658731,
+		0.351832, 0.615456, 0.622575, 0.641119, 0.418624, 0.431319,
+		0.428226, 0.430235, 0.430039, 0.437802, 0.438037, 0.564142,
+		0.609279, 0.652896, 0.467689, 0.598745, 0.611391, 0.425425,
+		0.438120, 0.435027, 0.437036, 0.436840, 0.442146, 0.452659,
+		0.573973, 0.622529, 0.660960, 0.634667, 0.480776, 0.610074,
+		0.434567, 0.447263, 0.444169, 0.446179, 0.445983, 0.451288,
+		0.459773, 0.579300, 0.635947, 0.681370, 0.648179, 0.647084,
+		0.496991,
+	},
+	{ // 65o
+		0.186922, 0.360829, 0.359991, 0.355886, 0.352741, 0.359852,
+		0.358465, 0.357397, 0.284729, 0.292039, 0.405768, 0.411671,
+		0.419706, 0.379655, 0.186864, 0.356464, 0.352359, 0.349215,
+		0.356365, 0.359588, 0.357679, 0.289840, 0.296897, 0.415446,
+		0.420955, 0.426858, 0.378731, 0.374872, 0.186796, 0.348925,
+		0.345781, 0.352989, 0.356250, 0.358951, 0.294833, 0.301644,
+		0.415877, 0.421386, 0.427289, 0.374288, 0.370429, 0.366665,
+		0.182473, 0.328671, 0.345722, 0.351826, 0.355087, 0.299526,
+		0.304036, 0.413703, 0.419213, 0.425116, 0.370891, 0.367032,
+		0.363268, 0.344945, 0.179468, 0.335877, 0.342777, 0.346198,
+		0.290498, 0.311402, 0.413557, 0.419067, 0.424970, 0.378052,
+		0.374235, 0.370532, 0.362762, 0.352183, 0.172368, 0.339601,
+		0.343703, 0.304298, 0.313617, 0.424329, 0.427314, 0.433217,
+		0.376000, 0.377197, 0.373536, 0.368839, 0.359101, 0.355633,
+		0.159542, 0.339264, 0.310628, 0.317343, 0.424848, 0.438553,
+		0.442041, 0.374310, 0.374579, 0.375931, 0.371846, 0.362278,
+		0.359546, 0.354906, 0.146354, 0.318821, 0.327295, 0.430825,
+		0.444530, 0.458235, 0.296855, 0.302112, 0.307250, 0.312031,
+		0.302007, 0.316033, 0.321989, 0.329961, 0.137789, 0.475044,
+		0.533223, 0.541885, 0.546343, 0.306876, 0.311886, 0.316774,
+		0.319091, 0.326740, 0.328277, 0.331399, 0.341269, 0.500000,
+		0.362388, 0.600306, 0.605433, 0.612572, 0.427522, 0.437988,
+		0.438413, 0.436029, 0.435839, 0.446756, 0.447311, 0.453812,
+		0.562969, 0.636290, 0.471203, 0.590416, 0.601185, 0.434323,
+		0.444361, 0.444785, 0.442402, 0.442212, 0.450530, 0.461932,
+		0.468434, 0.572716, 0.642159, 0.625067, 0.483609, 0.597982,
+		0.443459, 0.451178, 0.451603, 0.449219, 0.449029, 0.457348,
+		0.466293, 0.483072, 0.577920, 0.650259, 0.636627, 0.633499,
+		0.496770,
+	},
+	{ // 55
+		0.191114, 0.519656, 0.516445, 0.513234, 0.509059, 0.525001,
+		0.525769, 0.529751, 0.536016, 0.635349, 0.663137, 0.664920,
+		0.666703, 0.546172, 0.195155, 0.505177, 0.501966, 0.497791,
+		0.513773, 0.525610, 0.526377, 0.530359, 0.646845, 0.665087,
+		0.666870, 0.668653, 0.542663, 0.530547, 0.194808, 0.491453,
+		0.487278, 0.503300, 0.515177, 0.527014, 0.527781, 0.644134,
+		0.662334, 0.664117, 0.665900, 0.539154, 0.527039, 0.515630,
+		0.194587, 0.476758, 0.492819, 0.504736, 0.516612, 0.528450,
+		0.641455, 0.659613, 0.661396, 0.663179, 0.534635, 0.522520,
+		0.511111, 0.499692, 0.190441, 0.476964, 0.489344, 0.501260,
+		0.513137, 0.640369, 0.656370, 0.658153, 0.659936, 0.551809,
+		0.539736, 0.528369, 0.516992, 0.499920, 0.191638, 0.489067,
+		0.501023, 0.512939, 0.631439, 0.664546, 0.664457, 0.666240,
+		0.552532, 0.552459, 0.541134, 0.529799, 0.513198, 0.513001,
+		0.189594, 0.497648, 0.509604, 0.621827, 0.664438, 0.672131,
+		0.672043, 0.556769, 0.553184, 0.553860, 0.542567, 0.526008,
+		0.525853, 0.522402, 0.187616, 0.505891, 0.612171, 0.664365,
+		0.672058, 0.679751, 0.563455, 0.557427, 0.554591, 0.555298,
+		0.538781, 0.538669, 0.535259, 0.531455, 0.186407, 0.602550,
+		0.664329, 0.672022, 0.679715, 0.673732, 0.686272, 0.683281,
+		0.680320, 0.679088, 0.669305, 0.658761, 0.648168, 0.637612,
+		0.500000, 0.810646, 0.827700, 0.844830, 0.698491, 0.700493,
+		0.697481, 0.694500, 0.690969, 0.699675, 0.699626, 0.699611,
+		0.699634, 0.858544, 0.814765, 0.830071, 0.835062, 0.700436,
+		0.702438, 0.699425, 0.696445, 0.692913, 0.699606, 0.707763,
+		0.707748, 0.707771, 0.877041, 0.872164, 0.815231, 0.836445,
+		0.702397, 0.704399, 0.701386, 0.698406, 0.694875, 0.701567,
+		0.707710, 0.715901, 0.715924, 0.895632, 0.877499, 0.879015,
+		0.815729,
+	},
+	{ // 54s
+		0.210936, 0.386618, 0.385801, 0.384985, 0.381115, 0.383326,
+		0.384788, 0.384150, 0.385991, 0.307955, 0.315742, 0.434745,
+		0.440193, 0.412419, 0.225171, 0.383739, 0.382923, 0.379053,
+		0.381790, 0.387761, 0.386304, 0.385178, 0.327039, 0.334543,
+		0.444210, 0.449659, 0.411535, 0.409606, 0.225116, 0.379532,
+		0.375663, 0.378454, 0.384463, 0.387515, 0.385569, 0.331916,
+		0.339138, 0.444601, 0.450050, 0.410651, 0.408722, 0.405057,
+		0.225180, 0.372350, 0.375689, 0.381753, 0.384842, 0.387406,
+		0.338005, 0.344945, 0.445619, 0.451067, 0.406546, 0.404617,
+		0.400952, 0.397367, 0.221429, 0.360423, 0.375765, 0.381622,
+		0.384711, 0.344952, 0.349737, 0.444589, 0.450038, 0.408937,
+		0.407569, 0.403962, 0.400958, 0.385041, 0.219016, 0.368854,
+		0.375656, 0.378991, 0.341241, 0.361056, 0.447429, 0.452878,
+		0.410154, 0.413624, 0.410056, 0.407109, 0.400824, 0.393491,
+		0.212904, 0.374985, 0.379398, 0.358386, 0.367444, 0.460737,
+		0.463717, 0.409178, 0.411760, 0.413031, 0.410123, 0.406762,
+		0.400423, 0.399612, 0.201531, 0.379624, 0.370003, 0.376866,
+		0.466428, 0.479755, 0.410723, 0.410268, 0.410651, 0.412582,
+		0.409782, 0.403702, 0.404036, 0.404335, 0.189946, 0.384712,
+		0.393920, 0.480269, 0.493597, 0.320157, 0.341112, 0.346124,
+		0.352422, 0.359586, 0.355173, 0.372820, 0.384544, 0.399694,
+		0.189354, 0.500000, 0.531808, 0.541773, 0.328313, 0.348978,
+		0.353700, 0.359708, 0.364543, 0.376415, 0.382429, 0.391744,
+		0.409450, 0.524994, 0.399984, 0.593049, 0.598041, 0.462147,
+		0.472219, 0.472602, 0.473645, 0.472533, 0.475522, 0.489218,
+		0.495196, 0.509867, 0.559188, 0.625717, 0.501985, 0.599585,
+		0.468171, 0.478243, 0.478626, 0.479670, 0.478557, 0.481547,
+		0.492699, 0.509293, 0.523964, 0.570446, 0.631504, 0.625268,
+		0.513461,
+	},
+	{ // 53s
+		0.194483, 0.374041, 0.373225, 0.372408, 0.368539, 0.374081,
+		0.371014, 0.370679, 0.372520, 0.293065, 0.389831, 0.303841,
+		0.428162, 0.399352, 0.208718, 0.371163, 0.370346, 0.366477,
+		0.372545, 0.373988, 0.372833, 0.371706, 0.312108, 0.401347,
+		0.322642, 0.437628, 0.398468, 0.396539, 0.208663, 0.366956,
+		0.363086, 0.369209, 0.370689, 0.374044, 0.372097, 0.317026,
+		0.401738, 0.327237, 0.438019, 0.397583, 0.395654, 0.391990,
+		0.208727, 0.359773, 0.366444, 0.367979, 0.371371, 0.373935,
+		0.323155, 0.402755, 0.333044, 0.439036, 0.393479, 0.391549,
+		0.387885, 0.384300, 0.204976, 0.360004, 0.361992, 0.368151,
+		0.371240, 0.330143, 0.401726, 0.337836, 0.438007, 0.399281,
+		0.397912, 0.394305, 0.391301, 0.384536, 0.206900, 0.359948,
+		0.366750, 0.370084, 0.340243, 0.410407, 0.350597, 0.443875,
+		0.396012, 0.399481, 0.395914, 0.392967, 0.386681, 0.384342,
+		0.204962, 0.362456, 0.367172, 0.340822, 0.407894, 0.366308,
+		0.449885, 0.395346, 0.397929, 0.399199, 0.396292, 0.392931,
+		0.391274, 0.386719, 0.196129, 0.369226, 0.362971, 0.411615,
+		0.375730, 0.465923, 0.396891, 0.396437, 0.396820, 0.398750,
+		0.395950, 0.394553, 0.391454, 0.393630, 0.184956, 0.379476,
+		0.423437, 0.392813, 0.479794, 0.304107, 0.325020, 0.330074,
+		0.336414, 0.343619, 0.354080, 0.354296, 0.377425, 0.394567,
+		0.172300, 0.468192, 0.500000, 0.532524, 0.415646, 0.427826,
+		0.428209, 0.429252, 0.428140, 0.437240, 0.434565, 0.438516,
+		0.451109, 0.491854, 0.338118, 0.582616, 0.556901, 0.315540,
+		0.336205, 0.340926, 0.346935, 0.351770, 0.365111, 0.381717,
+		0.391032, 0.408768, 0.525052, 0.614657, 0.391442, 0.591709,
+		0.455691, 0.465763, 0.466146, 0.467190, 0.466077, 0.472167,
+		0.478522, 0.495115, 0.509816, 0.560556, 0.581157, 0.624741,
+		0.502266,
+	},
+	{ // 52s
+		0.178032, 0.361408, 0.360592, 0.359775, 0.355905, 0.361447,
+		0.359095, 0.356849, 0.358690, 0.278178, 0.378051, 0.381201,
+		0.291943, 0.386223, 0.192267, 0.358530, 0.357713, 0.353843,
+		0.359911, 0.362069, 0.359002, 0.358178, 0.297123, 0.389567,
+		0.392717, 0.310744, 0.385339, 0.383410, 0.192212, 0.354322,
+		0.350453, 0.356575, 0.358770, 0.360213, 0.358569, 0.302081,
+		0.389958, 0.393108, 0.315339, 0.384455, 0.382525, 0.378861,
+		0.192275, 0.347140, 0.353810, 0.356060, 0.357541, 0.360407,
+		0.308250, 0.390975, 0.394125, 0.321146, 0.380350, 0.378420,
+		0.374756, 0.371171, 0.188525, 0.347370, 0.352690, 0.354320,
+		0.357712, 0.315278, 0.389946, 0.393096, 0.325938, 0.386152,
+		0.384783, 0.381176, 0.378172, 0.371407, 0.190449, 0.350645,
+		0.352919, 0.356556, 0.325419, 0.398627, 0.398965, 0.338699,
+		0.383521, 0.386991, 0.383423, 0.380476, 0.376957, 0.374618,
+		0.189530, 0.351105, 0.355820, 0.339809, 0.396543, 0.408093,
+		0.352950, 0.381142, 0.383724, 0.384995, 0.382087, 0.378727,
+		0.377070, 0.375059, 0.188189, 0.356640, 0.345393, 0.399423,
+		0.410973, 0.374655, 0.382687, 0.382543, 0.382927, 0.384857,
+		0.382057, 0.380660, 0.379794, 0.380675, 0.179555, 0.372430,
+		0.411324, 0.422875, 0.391737, 0.288059, 0.308868, 0.313963,
+		0.320344, 0.327591, 0.338093, 0.353175, 0.358881, 0.387428,
+		0.155170, 0.458227, 0.467476, 0.500000, 0.403550, 0.415729,
+		0.416112, 0.417156, 0.416043, 0.425143, 0.422905, 0.425965,
+		0.438639, 0.481552, 0.330425, 0.497715, 0.583724, 0.407060,
+		0.419240, 0.419623, 0.420667, 0.419554, 0.425644, 0.435209,
+		0.438269, 0.450943, 0.491643, 0.519956, 0.339353, 0.584745,
+		0.302801, 0.323466, 0.328188, 0.334196, 0.339031, 0.352373,
+		0.367277, 0.390418, 0.408154, 0.525146, 0.616338, 0.617516,
+		0.383363,
+	},
+	{ // A4o
+		0.076639, 0.256775, 0.262033, 0.271215, 0.284116, 0.306569,
+		0.332162, 0.362674, 0.398831, 0.419459, 0.475008, 0.502625,
+		0.505309, 0.269207, 0.294499, 0.546327, 0.543110, 0.539956,
+		0.553177, 0.565610, 0.565524, 0.565910, 0.578750, 0.716164,
+		0.615262, 0.616360, 0.274571, 0.579851, 0.293994, 0.531144,
+		0.527989, 0.541211, 0.553644, 0.565527, 0.562692, 0.575533,
+		0.712552, 0.612045, 0.613143, 0.284196, 0.576337, 0.563389,
+		0.294224, 0.516086, 0.529308, 0.541741, 0.553624, 0.562759,
+		0.572379, 0.709003, 0.608890, 0.609988, 0.297861, 0.572885,
+		0.559938, 0.547054, 0.295191, 0.517468, 0.529901, 0.541784,
+		0.550919, 0.572508, 0.705517, 0.605799, 0.606897, 0.321833,
+		0.587003, 0.574056, 0.561172, 0.548351, 0.299333, 0.518371,
+		0.530672, 0.539807, 0.561396, 0.708112, 0.606315, 0.607413,
+		0.349029, 0.600323, 0.587376, 0.574492, 0.561671, 0.549483,
+		0.303462, 0.523785, 0.532920, 0.554509, 0.693503, 0.609219,
+		0.607434, 0.381592, 0.600094, 0.600091, 0.587207, 0.574386,
+		0.562627, 0.555060, 0.305748, 0.527082, 0.548672, 0.682579,
+		0.601585, 0.613619, 0.420468, 0.600532, 0.597017, 0.597078,
+		0.584257, 0.572497, 0.564931, 0.558623, 0.307368, 0.540334,
+		0.668339, 0.593249, 0.605282, 0.441944, 0.613853, 0.610338,
+		0.606887, 0.607010, 0.595250, 0.587684, 0.581376, 0.572478,
+		0.301509, 0.671687, 0.584354, 0.596450, 0.500000, 0.758641,
+		0.754710, 0.750841, 0.747037, 0.749958, 0.734130, 0.722299,
+		0.707000, 0.710063, 0.330764, 0.697460, 0.713511, 0.530887,
+		0.653804, 0.650289, 0.646838, 0.643449, 0.644152, 0.647150,
+		0.638979, 0.630081, 0.620493, 0.739857, 0.460605, 0.635604,
+		0.533909, 0.655107, 0.651592, 0.648140, 0.644752, 0.645455,
+		0.645329, 0.651904, 0.643006, 0.633481, 0.757168, 0.674891,
+		0.472097,
+	},
+	{ // K4o
+		0.115864, 0.225184, 0.334224, 0.333386, 0.332548, 0.343542,
+		0.344484, 0.341778, 0.341850, 0.329859, 0.231146, 0.366024,
+		0.369569, 0.232840, 0.062527, 0.247921, 0.256959, 0.268760,
+		0.289512, 0.321366, 0.349847, 0.383959, 0.417907, 0.475008,
+		0.503383, 0.506140, 0.351622, 0.259401, 0.277943, 0.526337,
+		0.523183, 0.536405, 0.550341, 0.562418, 0.562395, 0.565871,
+		0.707716, 0.604179, 0.605341, 0.350692, 0.268878, 0.558519,
+		0.278174, 0.511280, 0.524502, 0.538438, 0.550515, 0.562462,
+		0.562717, 0.704167, 0.601025, 0.602186, 0.349761, 0.281357,
+		0.555068, 0.542184, 0.279098, 0.512662, 0.526597, 0.538675,
+		0.550622, 0.562846, 0.700681, 0.597934, 0.599095, 0.361561,
+		0.303509, 0.569179, 0.556295, 0.543474, 0.283143, 0.515067,
+		0.524269, 0.536216, 0.548441, 0.699584, 0.595155, 0.596317,
+		0.362594, 0.337519, 0.583932, 0.571048, 0.558227, 0.546033,
+		0.292828, 0.521316, 0.533263, 0.545488, 0.688907, 0.601995,
+		0.600273, 0.359522, 0.367858, 0.596883, 0.583999, 0.571178,
+		0.555830, 0.552656, 0.294364, 0.524205, 0.536430, 0.674362,
+		0.591140, 0.603236, 0.359479, 0.404370, 0.596721, 0.596782,
+		0.583961, 0.568613, 0.565439, 0.555613, 0.296615, 0.530247,
+		0.663506, 0.583162, 0.595258, 0.346517, 0.440772, 0.600357,
+		0.596905, 0.597028, 0.581680, 0.578507, 0.568681, 0.562012,
+		0.299507, 0.651022, 0.572174, 0.584271, 0.241359, 0.500000,
+		0.749730, 0.745862, 0.742057, 0.740968, 0.729525, 0.713754,
+		0.701991, 0.688448, 0.317928, 0.676546, 0.692702, 0.386154,
+		0.532102, 0.642170, 0.638719, 0.635330, 0.632445, 0.639835,
+		0.628147, 0.619615, 0.607816, 0.717991, 0.454814, 0.621410,
+		0.390169, 0.535197, 0.643536, 0.640084, 0.636696, 0.633810,
+		0.638078, 0.641135, 0.632603, 0.620804, 0.735407, 0.660122,
+		0.465970,
+	},
+	{ // Q4o
+		0.120239, 0.304806, 0.226774, 0.334261, 0.333422, 0.344417,
+		0.344965, 0.345883, 0.345113, 0.333123, 0.234783, 0.369288,
+		0.372832, 0.319575, 0.120312, 0.232643, 0.326426, 0.325588,
+		0.336582, 0.348538, 0.346689, 0.343463, 0.340771, 0.239667,
+		0.378733, 0.382278, 0.234566, 0.240725, 0.065196, 0.255681,
+		0.267138, 0.286651, 0.316104, 0.351886, 0.383967, 0.417915,
+		0.475008, 0.503392, 0.506148, 0.351505, 0.343258, 0.267442,
+		0.276982, 0.511810, 0.525002, 0.538938, 0.552518, 0.564659,
+		0.564914, 0.702826, 0.603222, 0.604383, 0.350575, 0.342327,
+		0.279555, 0.542842, 0.277864, 0.513162, 0.527097, 0.540678,
+		0.552819, 0.565044, 0.699340, 0.600131, 0.601292, 0.362374,
+		0.354127, 0.300377, 0.556922, 0.544101, 0.281825, 0.512274,
+		0.526272, 0.537892, 0.550116, 0.697723, 0.596831, 0.597992,
+		0.362973, 0.366822, 0.331792, 0.571669, 0.558848, 0.543071,
+		0.291372, 0.523319, 0.532167, 0.544391, 0.683874, 0.600898,
+		0.599176, 0.363971, 0.364838, 0.370082, 0.586053, 0.573232,
+		0.557884, 0.554704, 0.298421, 0.527042, 0.539267, 0.673262,
+		0.593977, 0.606074, 0.362994, 0.361201, 0.404370, 0.599071,
+		0.586250, 0.570332, 0.564140, 0.558707, 0.299879, 0.529864,
+		0.658784, 0.582778, 0.594875, 0.350032, 0.358151, 0.440772,
+		0.599194, 0.599317, 0.583400, 0.577207, 0.571774, 0.561587,
+		0.302519, 0.646300, 0.571791, 0.583888, 0.245290, 0.250270,
+		0.500000, 0.744538, 0.740733, 0.739076, 0.724192, 0.712806,
+		0.697102, 0.683559, 0.320626, 0.671493, 0.687607, 0.389669,
+		0.399651, 0.532102, 0.641008, 0.637619, 0.634164, 0.638536,
+		0.631240, 0.619190, 0.607392, 0.712769, 0.457103, 0.621027,
+		0.393684, 0.403666, 0.535197, 0.642373, 0.638985, 0.635530,
+		0.636778, 0.644228, 0.632178, 0.620380, 0.730144, 0.659698,
+		0.467923,
+	},
+	{ // J4o
+		0.124613, 0.308069, 0.305153, 0.228364, 0.334297, 0.345233,
+		0.345781, 0.346306, 0.349159, 0.336327, 0.238360, 0.372492,
+		0.376037, 0.323096, 0.124687, 0.299116, 0.234233, 0.326463,
+		0.337398, 0.349354, 0.347111, 0.347508, 0.343976, 0.243244,
+		0.381938, 0.385482, 0.319780, 0.313396, 0.121716, 0.236242,
+		0.315866, 0.326801, 0.338757, 0.347921, 0.345551, 0.342018,
+		0.244819, 0.379980, 0.383525, 0.236292, 0.242451, 0.244300,
+		0.068274, 0.265860, 0.284826, 0.312643, 0.345844, 0.386006,
+		0.417923, 0.475008, 0.503400, 0.506156, 0.351388, 0.343141,
+		0.331737, 0.278118, 0.276599, 0.512930, 0.526836, 0.540417,
+		0.554061, 0.566480, 0.696473, 0.601567, 0.602728, 0.363125,
+		0.354878, 0.343474, 0.298355, 0.543941, 0.280476, 0.511592,
+		0.525591, 0.539235, 0.551653, 0.695095, 0.598368, 0.599529,
+		0.363723, 0.367573, 0.356169, 0.328013, 0.558656, 0.542419,
+		0.289896, 0.519865, 0.533509, 0.545407, 0.680726, 0.601914,
+		0.600191, 0.364287, 0.365154, 0.365847, 0.363502, 0.573033,
+		0.557225, 0.551033, 0.296764, 0.528385, 0.537511, 0.666942,
+		0.592221, 0.604317, 0.367379, 0.365587, 0.363298, 0.406595,
+		0.587484, 0.571676, 0.565483, 0.560044, 0.303694, 0.532041,
+		0.656396, 0.584956, 0.597052, 0.353484, 0.361603, 0.359314,
+		0.440772, 0.600787, 0.584979, 0.578217, 0.569765, 0.563971,
+		0.305500, 0.640292, 0.570748, 0.582844, 0.249159, 0.254138,
+		0.255462, 0.500000, 0.737788, 0.736384, 0.720931, 0.706104,
+		0.694785, 0.677303, 0.323292, 0.665153, 0.681226, 0.393121,
+		0.403103, 0.400814, 0.532102, 0.639089, 0.635743, 0.639545,
+		0.629231, 0.621574, 0.606257, 0.706179, 0.459361, 0.619984,
+		0.397136, 0.407118, 0.404829, 0.535197, 0.640454, 0.637109,
+		0.637788, 0.642219, 0.634562, 0.619245, 0.723512, 0.658563,
+		0.469844,
+	},
+	{ // T4o
+		0.128988, 0.311332, 0.308417, 0.305501, 0.229954, 0.345989,
+		0.346538, 0.347062, 0.349522, 0.340313, 0.241878, 0.375637,
+		0.379182, 0.326617, 0.129062, 0.302379, 0.299463, 0.235823,
+		0.338214, 0.350111, 0.347868, 0.347872, 0.347962, 0.246761,
+		0.385083, 0.388627, 0.323301, 0.316917, 0.126091, 0.290663,
+		0.237832, 0.327617, 0.339513, 0.348678, 0.345914, 0.346005,
+		0.248336, 0.383125, 0.386670, 0.319986, 0.313602, 0.304061,
+		0.123246, 0.239619, 0.317783, 0.329679, 0.338844, 0.347488,
+		0.344811, 0.251439, 0.381931, 0.385476, 0.238018, 0.244177,
+		0.246026, 0.247646, 0.071762, 0.283548, 0.310616, 0.341523,
+		0.379445, 0.419963, 0.475008, 0.503408, 0.506164, 0.363812,
+		0.355628, 0.344225, 0.333640, 0.296919, 0.279095, 0.510295,
+		0.524264, 0.537908, 0.551830, 0.689781, 0.598739, 0.599900,
+		0.364410, 0.368260, 0.356856, 0.346272, 0.325773, 0.541111,
+		0.288389, 0.518119, 0.531763, 0.545685, 0.675651, 0.602386,
+		0.600664, 0.364974, 0.365841, 0.366534, 0.355950, 0.358792,
+		0.555885, 0.549233, 0.295089, 0.523866, 0.537789, 0.661347,
+		0.592171, 0.604268, 0.367632, 0.365840, 0.363551, 0.365063,
+		0.399447, 0.570330, 0.563677, 0.555225, 0.301796, 0.532319,
+		0.647630, 0.582134, 0.594231, 0.357806, 0.365925, 0.363636,
+		0.362167, 0.442996, 0.585066, 0.578413, 0.569961, 0.564161,
+		0.309031, 0.635457, 0.571860, 0.583957, 0.252963, 0.257943,
+		0.259267, 0.262212, 0.500000, 0.730843, 0.715643, 0.700247,
+		0.685487, 0.672390, 0.325926, 0.656366, 0.672397, 0.396510,
+		0.406492, 0.404202, 0.402733, 0.532102, 0.636065, 0.639977,
+		0.629093, 0.618417, 0.607493, 0.696993, 0.461587, 0.617876,
+		0.400525, 0.410506, 0.408217, 0.406748, 0.535197, 0.637431,
+		0.638219, 0.642081, 0.631405, 0.620481, 0.714285, 0.656281,
+		0.471734,
+	},
+	{ // 94o
+		0.122493, 0.310340, 0.310737, 0.308343, 0.305427, 0.229247,
+		0.339622, 0.339626, 0.342086, 0.332877, 0.239045, 0.370732,
+		0.374277, 0.325844, 0.126731, 0.307678, 0.305284, 0.302368,
+		0.235263, 0.346617, 0.343744, 0.343747, 0.343838, 0.247620,
+		0.383490, 0.387035, 0.326111, 0.322824, 0.124330, 0.296838,
+		0.293922, 0.237401, 0.336491, 0.345075, 0.342311, 0.342402,
+		0.249715, 0.382054, 0.385599, 0.323365, 0.320078, 0.310905,
+		0.121485, 0.285202, 0.240698, 0.326555, 0.335140, 0.343783,
+		0.341107, 0.252579, 0.380759, 0.384303, 0.320050, 0.316763,
+		0.307590, 0.298132, 0.118892, 0.244934, 0.317788, 0.326373,
+		0.335016, 0.343354, 0.258131, 0.380632, 0.384176, 0.237326,
+		0.243645, 0.245629, 0.248846, 0.253062, 0.068876, 0.305166,
+		0.338294, 0.373321, 0.411079, 0.475008, 0.501344, 0.504101,
+		0.357090, 0.364669, 0.353757, 0.343063, 0.333627, 0.319949,
+		0.280895, 0.511551, 0.525166, 0.539087, 0.663540, 0.597018,
+		0.594884, 0.357084, 0.361540, 0.362802, 0.352109, 0.342672,
+		0.355382, 0.542396, 0.286858, 0.516437, 0.530359, 0.649475,
+		0.586493, 0.598590, 0.359741, 0.361538, 0.359818, 0.361221,
+		0.351785, 0.392920, 0.556809, 0.547469, 0.293354, 0.521989,
+		0.635758, 0.575934, 0.588031, 0.349910, 0.361618, 0.359898,
+		0.358319, 0.360551, 0.433462, 0.571539, 0.562198, 0.553244,
+		0.300325, 0.623585, 0.562760, 0.574857, 0.250042, 0.259032,
+		0.260924, 0.263616, 0.269157, 0.500000, 0.702870, 0.687727,
+		0.672967, 0.659864, 0.322345, 0.644585, 0.660575, 0.391361,
+		0.404931, 0.403211, 0.401632, 0.401310, 0.529878, 0.634246,
+		0.623042, 0.611797, 0.597714, 0.684657, 0.456188, 0.611612,
+		0.395376, 0.408946, 0.407226, 0.405647, 0.405325, 0.532972,
+		0.632060, 0.636030, 0.624785, 0.610702, 0.701907, 0.649571,
+		0.465999,
+	},
+	{ // 84o
+		0.140026, 0.322956, 0.323352, 0.320958, 0.318042, 0.320288,
+		0.242208, 0.351392, 0.353852, 0.344249, 0.253806, 0.386201,
+		0.386211, 0.339247, 0.138621, 0.318237, 0.318634, 0.316239,
+		0.318525, 0.249384, 0.351319, 0.351323, 0.351020, 0.258217,
+		0.394768, 0.394778, 0.339520, 0.334257, 0.139814, 0.312755,
+		0.310360, 0.312703, 0.251668, 0.355551, 0.352678, 0.352375,
+		0.263484, 0.396123, 0.396133, 0.336775, 0.334525, 0.328021,
+		0.137539, 0.301995, 0.304919, 0.255095, 0.346087, 0.354671,
+		0.351601, 0.266868, 0.395350, 0.395359, 0.333459, 0.331779,
+		0.325275, 0.316185, 0.134947, 0.297368, 0.260601, 0.337219,
+		0.345803, 0.354140, 0.272180, 0.395121, 0.395131, 0.335928,
+		0.334289, 0.327846, 0.319383, 0.311182, 0.132449, 0.254069,
+		0.331634, 0.340219, 0.348556, 0.284330, 0.398294, 0.398304,
+		0.251203, 0.258832, 0.260976, 0.264327, 0.269888, 0.262562,
+		0.085295, 0.340038, 0.374984, 0.412628, 0.475008, 0.510408,
+		0.511082, 0.369266, 0.369329, 0.373752, 0.363550, 0.354004,
+		0.348018, 0.356761, 0.287369, 0.516579, 0.530472, 0.646976,
+		0.589130, 0.601184, 0.371924, 0.369328, 0.370627, 0.372600,
+		0.363054, 0.357067, 0.394216, 0.547265, 0.293656, 0.521810,
+		0.632978, 0.578673, 0.590726, 0.361664, 0.368978, 0.370278,
+		0.369268, 0.371819, 0.365833, 0.434643, 0.561963, 0.552689,
+		0.300374, 0.617571, 0.565435, 0.577095, 0.265870, 0.270475,
+		0.275808, 0.279069, 0.284357, 0.297130, 0.500000, 0.684912,
+		0.669837, 0.653235, 0.332367, 0.644223, 0.645339, 0.408019,
+		0.417196, 0.418496, 0.417486, 0.417055, 0.420468, 0.539695,
+		0.625383, 0.614248, 0.600101, 0.684628, 0.468582, 0.607779,
+		0.408070, 0.417247, 0.418546, 0.417537, 0.417105, 0.420518,
+		0.540405, 0.638322, 0.627186, 0.612611, 0.685747, 0.645364,
+		0.472801,
+	},
+	{ // 74o
+		0.153175, 0.335510, 0.331405, 0.331801, 0.329407, 0.331653,
+		0.330061, 0.253567, 0.362290, 0.352688, 0.264860, 0.390317,
+		0.398352, 0.352586, 0.156152, 0.330791, 0.331188, 0.328793,
+		0.331079, 0.334097, 0.262333, 0.363028, 0.362725, 0.272916,
+		0.402151, 0.410186, 0.348143, 0.347596, 0.151703, 0.323252,
+		0.323649, 0.325992, 0.329571, 0.265777, 0.360192, 0.359889,
+		0.274019, 0.399315, 0.407350, 0.348416, 0.347869, 0.339390,
+		0.153022, 0.317851, 0.320775, 0.324411, 0.269351, 0.365086,
+		0.361906, 0.280575, 0.401333, 0.409368, 0.345671, 0.345124,
+		0.339657, 0.333236, 0.150999, 0.313477, 0.317593, 0.275226,
+		0.356689, 0.364967, 0.286407, 0.401625, 0.409661, 0.348139,
+		0.347634, 0.342228, 0.336433, 0.328491, 0.148502, 0.310908,
+		0.270037, 0.351003, 0.359281, 0.298317, 0.404697, 0.412732,
+		0.345847, 0.350356, 0.345561, 0.339827, 0.332403, 0.325247,
+		0.137756, 0.286107, 0.349186, 0.357464, 0.301764, 0.415128,
+		0.421142, 0.263346, 0.272702, 0.276156, 0.279668, 0.285615,
+		0.279709, 0.296197, 0.102111, 0.376616, 0.414219, 0.475009,
+		0.509689, 0.520137, 0.380523, 0.381445, 0.378351, 0.383484,
+		0.374430, 0.368334, 0.366473, 0.395472, 0.293923, 0.516154,
+		0.623944, 0.575014, 0.587564, 0.370263, 0.381096, 0.378002,
+		0.380012, 0.383132, 0.377036, 0.375175, 0.435858, 0.546188,
+		0.300389, 0.608256, 0.561484, 0.574035, 0.277701, 0.286246,
+		0.287194, 0.293896, 0.299753, 0.312273, 0.315088, 0.500000,
+		0.659935, 0.643017, 0.342582, 0.619999, 0.638546, 0.412002,
+		0.424698, 0.421604, 0.423613, 0.423751, 0.427055, 0.437574,
+		0.538514, 0.609811, 0.595346, 0.658331, 0.468651, 0.604695,
+		0.421144, 0.433840, 0.430746, 0.432756, 0.432894, 0.436197,
+		0.444688, 0.550214, 0.623230, 0.608764, 0.678741, 0.641490,
+		0.484867,
+	},
+	{ // 64o
+		0.169356, 0.347646, 0.346808, 0.342703, 0.343100, 0.345346,
+		0.344275, 0.343208, 0.268534, 0.361422, 0.279049, 0.399052,
+		0.407087, 0.365652, 0.169299, 0.343282, 0.339177, 0.339573,
+		0.341859, 0.345398, 0.343489, 0.273687, 0.371099, 0.283907,
+		0.408335, 0.414238, 0.364728, 0.360869, 0.169231, 0.335743,
+		0.336139, 0.338482, 0.342061, 0.344762, 0.278721, 0.371531,
+		0.288655, 0.408767, 0.414670, 0.360285, 0.356426, 0.352662,
+		0.164907, 0.328285, 0.331215, 0.337636, 0.340898, 0.283454,
+		0.369357, 0.291046, 0.406593, 0.412496, 0.360559, 0.356700,
+		0.352936, 0.344539, 0.166479, 0.326484, 0.333385, 0.336805,
+		0.289476, 0.375318, 0.300051, 0.409677, 0.415580, 0.363027,
+		0.359210, 0.355507, 0.347737, 0.342463, 0.163982, 0.326441,
+		0.330860, 0.284895, 0.369692, 0.311961, 0.412749, 0.418652,
+		0.361305, 0.362501, 0.358840, 0.354143, 0.349387, 0.341952,
+		0.153806, 0.328300, 0.302624, 0.368185, 0.315688, 0.423994,
+		0.427481, 0.359614, 0.359883, 0.361235, 0.357150, 0.352565,
+		0.346189, 0.343501, 0.141047, 0.313049, 0.372086, 0.325671,
+		0.430002, 0.443707, 0.279549, 0.284847, 0.290027, 0.294849,
+		0.300957, 0.295691, 0.313958, 0.324376, 0.119300, 0.415769,
+		0.475009, 0.508931, 0.519306, 0.379161, 0.389628, 0.390052,
+		0.387668, 0.393949, 0.387916, 0.386374, 0.390721, 0.437031,
+		0.300366, 0.590550, 0.548891, 0.561361, 0.293000, 0.298009,
+		0.302898, 0.305215, 0.314513, 0.327033, 0.330163, 0.340065,
+		0.500000, 0.623626, 0.352844, 0.599771, 0.606913, 0.420900,
+		0.430938, 0.431362, 0.428979, 0.432136, 0.435439, 0.446847,
+		0.453381, 0.537300, 0.581194, 0.636180, 0.471442, 0.589749,
+		0.430036, 0.437756, 0.438180, 0.435796, 0.438954, 0.442257,
+		0.451208, 0.468019, 0.548918, 0.594523, 0.644280, 0.624803,
+		0.484604,
+	},
+	{ // 54o
+		0.171859, 0.358418, 0.357580, 0.356742, 0.352637, 0.354889,
+		0.356603, 0.356057, 0.358027, 0.268768, 0.276808, 0.407991,
+		0.413500, 0.376516, 0.187094, 0.355399, 0.354561, 0.350456,
+		0.353269, 0.359593, 0.358205, 0.357138, 0.288780, 0.296534,
+		0.417752, 0.423261, 0.375592, 0.373740, 0.187026, 0.351127,
+		0.347022, 0.349892, 0.356256, 0.359478, 0.357569, 0.293814,
+		0.301282, 0.418183, 0.423692, 0.374667, 0.372816, 0.369052,
+		0.187084, 0.343669, 0.347121, 0.353542, 0.356803, 0.359504,
+		0.300137, 0.307319, 0.419276, 0.424786, 0.370224, 0.368373,
+		0.364609, 0.360928, 0.183014, 0.331077, 0.347240, 0.353445,
+		0.356707, 0.307318, 0.312159, 0.418169, 0.423679, 0.372699,
+		0.371459, 0.367756, 0.364702, 0.347526, 0.180529, 0.340315,
+		0.347520, 0.351041, 0.302884, 0.324077, 0.421247, 0.426757,
+		0.373990, 0.377763, 0.374102, 0.371109, 0.364377, 0.356969,
+		0.173935, 0.347146, 0.351813, 0.321460, 0.331028, 0.435387,
+		0.438372, 0.372869, 0.375715, 0.377067, 0.374116, 0.370567,
+		0.364219, 0.363783, 0.161745, 0.352450, 0.334129, 0.341291,
+		0.441706, 0.455411, 0.374287, 0.374030, 0.374455, 0.376517,
+		0.373580, 0.367511, 0.368315, 0.369184, 0.149326, 0.350222,
+		0.359947, 0.456700, 0.470405, 0.279155, 0.301062, 0.306242,
+		0.312790, 0.320208, 0.315103, 0.334263, 0.347104, 0.363710,
+		0.141456, 0.475006, 0.508146, 0.518448, 0.289937, 0.311552,
+		0.316441, 0.322697, 0.327610, 0.340136, 0.346765, 0.356983,
+		0.376374, 0.500000, 0.365060, 0.576251, 0.581099, 0.430059,
+		0.440551, 0.440975, 0.442110, 0.440874, 0.444183, 0.458745,
+		0.465597, 0.481903, 0.535924, 0.610288, 0.476778, 0.572695,
+		0.436448, 0.446939, 0.447364, 0.448498, 0.447263, 0.450572,
+		0.462536, 0.480235, 0.496541, 0.547469, 0.615857, 0.605524,
+		0.489217,
+	},
+	{ // 44
+		0.186715, 0.514132, 0.510920, 0.507709, 0.504498, 0.515074,
+		0.516265, 0.520247, 0.526513, 0.520040, 0.631037, 0.658686,
+		0.660469, 0.540312, 0.190756, 0.499889, 0.496677, 0.493466,
+		0.504082, 0.516343, 0.517111, 0.521093, 0.525354, 0.642809,
+		0.660912, 0.662695, 0.536803, 0.524940, 0.190408, 0.486402,
+		0.483190, 0.493845, 0.506147, 0.517984, 0.518751, 0.523012,
+		0.640375, 0.658435, 0.660218, 0.533294, 0.521431, 0.510275,
+		0.190187, 0.472907, 0.483601, 0.495942, 0.507819, 0.519656,
+		0.520702, 0.637971, 0.655989, 0.657772, 0.529786, 0.517922,
+		0.506766, 0.495599, 0.190218, 0.473349, 0.485729, 0.497646,
+		0.509522, 0.521639, 0.635600, 0.653575, 0.655358, 0.541278,
+		0.529457, 0.518343, 0.507218, 0.496083, 0.186880, 0.473878,
+		0.486258, 0.498175, 0.510330, 0.638923, 0.654788, 0.656571,
+		0.542442, 0.542621, 0.531549, 0.520466, 0.509373, 0.496666,
+		0.188583, 0.485728, 0.497685, 0.509880, 0.629784, 0.662751,
+		0.662662, 0.546679, 0.543346, 0.544275, 0.533234, 0.522183,
+		0.509947, 0.509465, 0.187175, 0.494580, 0.506816, 0.620483,
+		0.662953, 0.670647, 0.553365, 0.547589, 0.545006, 0.545966,
+		0.534956, 0.522763, 0.522323, 0.519159, 0.185966, 0.503375,
+		0.611140, 0.663193, 0.670886, 0.546631, 0.552127, 0.549543,
+		0.546991, 0.547983, 0.535831, 0.535434, 0.532311, 0.528797,
+		0.185235, 0.600016, 0.661882, 0.669575, 0.669236, 0.682072,
+		0.679374, 0.676708, 0.674074, 0.677655, 0.667633, 0.657418,
+		0.647156, 0.634940, 0.500000, 0.826189, 0.843244, 0.693835,
+		0.696131, 0.693414, 0.690727, 0.688072, 0.689377, 0.697841,
+		0.698121, 0.698438, 0.697067, 0.875501, 0.810106, 0.831913,
+		0.695796, 0.698093, 0.695375, 0.692688, 0.690033, 0.691338,
+		0.697788, 0.706274, 0.706591, 0.705220, 0.894008, 0.874187,
+		0.810604,
+	},
+	{ // 43s
+		0.190410, 0.370547, 0.369730, 0.368914, 0.368097, 0.369505,
+		0.366438, 0.368720, 0.371050, 0.355299, 0.291582, 0.300013,
+		0.424608, 0.395664, 0.204645, 0.367668, 0.366852, 0.366035,
+		0.367969, 0.369412, 0.370874, 0.370236, 0.368774, 0.310625,
+		0.318815, 0.434074, 0.394780, 0.392851, 0.204590, 0.363461,
+		0.362645, 0.364633, 0.366113, 0.372085, 0.370627, 0.369165,
+		0.315543, 0.323410, 0.434465, 0.393895, 0.391966, 0.388302,
+		0.204653, 0.359332, 0.361868, 0.363403, 0.369412, 0.372464,
+		0.370182, 0.321672, 0.329217, 0.435482, 0.393011, 0.391082,
+		0.387418, 0.383833, 0.204953, 0.359562, 0.361550, 0.367709,
+		0.370799, 0.373026, 0.330110, 0.337333, 0.437506, 0.394568,
+		0.393200, 0.389593, 0.386589, 0.384068, 0.201686, 0.349064,
+		0.364692, 0.370644, 0.373397, 0.341360, 0.346387, 0.439543,
+		0.391299, 0.394769, 0.391201, 0.388254, 0.386214, 0.373261,
+		0.199748, 0.360420, 0.367753, 0.370847, 0.342081, 0.362098,
+		0.445552, 0.393406, 0.395989, 0.397259, 0.394352, 0.392470,
+		0.389351, 0.384819, 0.194233, 0.371759, 0.376610, 0.365261,
+		0.374480, 0.464312, 0.395473, 0.395018, 0.395401, 0.397332,
+		0.395489, 0.395396, 0.392321, 0.396484, 0.183581, 0.384382,
+		0.384598, 0.391809, 0.478472, 0.378854, 0.393048, 0.393431,
+		0.394474, 0.397470, 0.397938, 0.395223, 0.401255, 0.409584,
+		0.169929, 0.406951, 0.417384, 0.502285, 0.302540, 0.323454,
+		0.328507, 0.334847, 0.343634, 0.355415, 0.355777, 0.380001,
+		0.400229, 0.423749, 0.173811, 0.500000, 0.532528, 0.311384,
+		0.332049, 0.336771, 0.342779, 0.351235, 0.360645, 0.377250,
+		0.389800, 0.407817, 0.434872, 0.525057, 0.386146, 0.540542,
+		0.451938, 0.462010, 0.462393, 0.463437, 0.465545, 0.467700,
+		0.474055, 0.493549, 0.508542, 0.533806, 0.560569, 0.568899,
+		0.497342,
+	},
+	{ // 42s
+		0.173956, 0.357910, 0.357094, 0.356277, 0.355461, 0.356868,
+		0.357133, 0.354886, 0.357216, 0.341768, 0.276690, 0.377644,
+		0.288111, 0.382533, 0.188191, 0.355032, 0.354215, 0.353399,
+		0.355332, 0.360106, 0.357040, 0.356705, 0.355243, 0.295636,
+		0.389160, 0.306912, 0.381648, 0.379719, 0.188136, 0.350824,
+		0.350008, 0.351996, 0.356808, 0.358251, 0.357096, 0.355634,
+		0.300593, 0.389551, 0.311507, 0.380764, 0.378835, 0.375171,
+		0.188200, 0.346695, 0.349231, 0.354098, 0.355579, 0.358933,
+		0.356651, 0.306763, 0.390568, 0.317314, 0.379880, 0.377951,
+		0.374287, 0.370702, 0.188500, 0.346925, 0.352245, 0.353876,
+		0.357267, 0.359495, 0.315242, 0.392592, 0.325430, 0.381437,
+		0.380069, 0.376462, 0.373457, 0.370937, 0.185232, 0.348584,
+		0.350858, 0.357112, 0.359866, 0.326531, 0.394628, 0.334484,
+		0.381579, 0.385048, 0.381481, 0.378534, 0.376493, 0.372692,
+		0.187631, 0.351453, 0.358786, 0.361880, 0.341064, 0.406479,
+		0.351637, 0.379200, 0.381782, 0.383053, 0.380145, 0.378263,
+		0.375144, 0.375606, 0.186291, 0.359170, 0.364324, 0.347678,
+		0.409418, 0.373343, 0.381266, 0.381123, 0.381506, 0.383436,
+		0.381594, 0.381501, 0.383108, 0.383527, 0.178178, 0.373923,
+		0.377547, 0.421608, 0.390671, 0.364958, 0.379152, 0.379535,
+		0.380579, 0.383574, 0.384042, 0.386010, 0.388609, 0.398815,
+		0.164938, 0.401959, 0.443099, 0.416276, 0.286489, 0.307298,
+		0.312393, 0.318774, 0.327603, 0.339425, 0.354661, 0.361454,
+		0.393087, 0.418901, 0.156756, 0.467472, 0.500000, 0.403304,
+		0.415484, 0.415867, 0.416910, 0.419019, 0.421174, 0.433639,
+		0.436763, 0.449729, 0.472604, 0.491651, 0.333974, 0.528566,
+		0.298643, 0.319308, 0.324029, 0.330038, 0.338494, 0.347903,
+		0.365979, 0.389120, 0.407137, 0.434222, 0.525146, 0.556164,
+		0.377747,
+	},
+	{ // A3o
+		0.072247, 0.252106, 0.257406, 0.266627, 0.279570, 0.305709,
+		0.327178, 0.360903, 0.396899, 0.416790, 0.444479, 0.474947,
+		0.502078, 0.264221, 0.290508, 0.545384, 0.542167, 0.539013,
+		0.553128, 0.561370, 0.561702, 0.562088, 0.574929, 0.578595,
+		0.717685, 0.615417, 0.269627, 0.578877, 0.290003, 0.530201,
+		0.527046, 0.541162, 0.549404, 0.561706, 0.558870, 0.571711,
+		0.575377, 0.714073, 0.612200, 0.279293, 0.575362, 0.562415,
+		0.290234, 0.515143, 0.529259, 0.537501, 0.549802, 0.558937,
+		0.568557, 0.572223, 0.710524, 0.609045, 0.293000, 0.571911,
+		0.558964, 0.546079, 0.291200, 0.517419, 0.525661, 0.537962,
+		0.547097, 0.568686, 0.569131, 0.707038, 0.605954, 0.320953,
+		0.586963, 0.574016, 0.561131, 0.548310, 0.299295, 0.518321,
+		0.530623, 0.539758, 0.561347, 0.573762, 0.708051, 0.607363,
+		0.343806, 0.595890, 0.582943, 0.570059, 0.557237, 0.549442,
+		0.297782, 0.514719, 0.523860, 0.545861, 0.558276, 0.706274,
+		0.603505, 0.379852, 0.596096, 0.596093, 0.583209, 0.570388,
+		0.562592, 0.545506, 0.303234, 0.520259, 0.542261, 0.554675,
+		0.695350, 0.609696, 0.418551, 0.596534, 0.593019, 0.593079,
+		0.580258, 0.572463, 0.555376, 0.551393, 0.304854, 0.533923,
+		0.546339, 0.681111, 0.601360, 0.439222, 0.609855, 0.606340,
+		0.602888, 0.603012, 0.595216, 0.578558, 0.574575, 0.565677,
+		0.299564, 0.537853, 0.684460, 0.592940, 0.469113, 0.613846,
+		0.610331, 0.606879, 0.603490, 0.608639, 0.591981, 0.587998,
+		0.579100, 0.569941, 0.306165, 0.688616, 0.596696, 0.500000,
+		0.760359, 0.756428, 0.752560, 0.748755, 0.749966, 0.747998,
+		0.736175, 0.720877, 0.723940, 0.728421, 0.335939, 0.715065,
+		0.530496, 0.654124, 0.650609, 0.647157, 0.643768, 0.645406,
+		0.641210, 0.647791, 0.638893, 0.629797, 0.633850, 0.758874,
+		0.468521,
+	},
+	{ // K3o
+		0.111445, 0.220277, 0.330494, 0.329656, 0.328818, 0.343079,
+		0.339830, 0.339916, 0.340508, 0.328518, 0.329363, 0.229496,
+		0.365839, 0.227601, 0.058136, 0.243293, 0.252372, 0.264214,
+		0.288652, 0.316382, 0.348076, 0.382547, 0.415156, 0.442887,
+		0.474947, 0.502797, 0.347622, 0.254457, 0.273953, 0.525395,
+		0.522240, 0.536356, 0.546101, 0.558596, 0.558573, 0.562049,
+		0.565715, 0.709237, 0.604398, 0.346692, 0.263976, 0.557545,
+		0.274183, 0.510337, 0.524453, 0.534198, 0.546693, 0.558640,
+		0.558895, 0.562561, 0.705688, 0.601243, 0.345761, 0.276496,
+		0.554093, 0.541209, 0.275108, 0.512613, 0.522358, 0.534853,
+		0.546800, 0.559025, 0.559470, 0.702202, 0.598152, 0.361079,
+		0.302629, 0.569139, 0.556255, 0.543434, 0.283106, 0.515018,
+		0.524220, 0.536167, 0.548391, 0.560806, 0.699523, 0.596267,
+		0.357719, 0.332296, 0.579499, 0.566615, 0.553794, 0.545993,
+		0.287148, 0.512250, 0.524615, 0.536840, 0.549255, 0.701678,
+		0.596344, 0.357667, 0.366118, 0.592885, 0.580000, 0.567179,
+		0.555796, 0.543102, 0.291850, 0.517793, 0.530018, 0.542433,
+		0.687134, 0.599314, 0.358192, 0.403022, 0.592723, 0.592783,
+		0.579962, 0.568579, 0.556313, 0.548812, 0.294670, 0.524247,
+		0.536663, 0.676277, 0.591747, 0.345231, 0.437966, 0.596358,
+		0.592907, 0.593030, 0.581646, 0.569381, 0.561880, 0.555639,
+		0.297562, 0.527781, 0.663795, 0.580760, 0.346196, 0.467898,
+		0.600349, 0.596897, 0.593508, 0.595069, 0.582804, 0.575302,
+		0.569062, 0.559449, 0.303869, 0.667951, 0.584516, 0.239641,
+		0.500000, 0.751448, 0.747580, 0.743775, 0.740977, 0.743394,
+		0.727631, 0.715867, 0.702324, 0.706806, 0.322852, 0.694255,
+		0.386160, 0.531669, 0.642553, 0.639101, 0.635712, 0.633761,
+		0.633959, 0.637021, 0.628918, 0.617120, 0.621174, 0.737113,
+		0.462394,
+	},
+	{ // Q3o
+		0.115819, 0.301076, 0.221867, 0.330531, 0.329693, 0.343954,
+		0.340311, 0.344021, 0.343772, 0.331781, 0.332626, 0.233132,
+		0.369102, 0.315575, 0.115893, 0.227777, 0.322696, 0.321858,
+		0.336119, 0.343884, 0.344826, 0.342121, 0.339430, 0.340275,
+		0.238016, 0.378489, 0.229327, 0.235528, 0.060805, 0.251094,
+		0.262591, 0.285790, 0.311120, 0.350115, 0.382555, 0.415164,
+		0.442896, 0.474947, 0.502805, 0.347505, 0.339258, 0.262539,
+		0.272991, 0.510867, 0.524953, 0.534698, 0.548696, 0.560837,
+		0.561092, 0.564758, 0.704347, 0.603440, 0.346575, 0.338327,
+		0.274694, 0.541868, 0.273874, 0.513113, 0.522858, 0.536856,
+		0.548997, 0.561222, 0.561667, 0.700861, 0.600349, 0.361893,
+		0.353645, 0.299497, 0.556882, 0.544061, 0.281788, 0.512224,
+		0.526223, 0.537842, 0.550067, 0.562482, 0.697662, 0.597943,
+		0.358098, 0.361947, 0.326569, 0.567236, 0.554415, 0.543031,
+		0.285692, 0.514253, 0.523518, 0.535743, 0.548158, 0.696645,
+		0.595247, 0.362115, 0.362983, 0.368342, 0.582054, 0.569233,
+		0.557849, 0.545149, 0.295907, 0.520631, 0.532856, 0.545271,
+		0.686033, 0.602151, 0.361707, 0.359915, 0.403022, 0.595072,
+		0.582251, 0.570298, 0.555014, 0.551906, 0.297935, 0.523864,
+		0.536280, 0.671556, 0.591364, 0.348746, 0.356865, 0.437966,
+		0.595196, 0.595319, 0.583366, 0.568081, 0.564973, 0.555215,
+		0.300575, 0.527398, 0.659074, 0.580377, 0.349711, 0.357830,
+		0.467898, 0.599186, 0.595798, 0.596789, 0.581504, 0.578396,
+		0.568638, 0.559025, 0.306586, 0.663229, 0.584133, 0.243572,
+		0.248552, 0.500000, 0.746256, 0.742451, 0.739084, 0.738060,
+		0.726682, 0.710979, 0.697436, 0.701917, 0.325213, 0.689161,
+		0.389675, 0.399594, 0.531669, 0.641390, 0.638002, 0.635481,
+		0.632659, 0.640115, 0.628494, 0.616695, 0.620749, 0.731850,
+		0.464347,
+	},
+	{ // J3o
+		0.120194, 0.304339, 0.301423, 0.223457, 0.330567, 0.344769,
+		0.341127, 0.344443, 0.347818, 0.334985, 0.335831, 0.236709,
+		0.372307, 0.319096, 0.120268, 0.295386, 0.229367, 0.322733,
+		0.336935, 0.344700, 0.345249, 0.346167, 0.342634, 0.343480,
+		0.241593, 0.381693, 0.315780, 0.309396, 0.117297, 0.231416,
+		0.312136, 0.326338, 0.334103, 0.346059, 0.344210, 0.340677,
+		0.341522, 0.243168, 0.379736, 0.231054, 0.237254, 0.239145,
+		0.063883, 0.261314, 0.283966, 0.307659, 0.344074, 0.384594,
+		0.415172, 0.442904, 0.474947, 0.502813, 0.347388, 0.339141,
+		0.327737, 0.273257, 0.272608, 0.512881, 0.522596, 0.536595,
+		0.550239, 0.562658, 0.563103, 0.697994, 0.601785, 0.362643,
+		0.354396, 0.342992, 0.297475, 0.543901, 0.280438, 0.511543,
+		0.525541, 0.539185, 0.551604, 0.564019, 0.695034, 0.599480,
+		0.358848, 0.362698, 0.351294, 0.322790, 0.554223, 0.542379,
+		0.284216, 0.510799, 0.524861, 0.536759, 0.549174, 0.693497,
+		0.596263, 0.362431, 0.363298, 0.363991, 0.361762, 0.569035,
+		0.557191, 0.541478, 0.294250, 0.521973, 0.531099, 0.543514,
+		0.679713, 0.600394, 0.366093, 0.364301, 0.362012, 0.405246,
+		0.583486, 0.571642, 0.556358, 0.553243, 0.301750, 0.526041,
+		0.538457, 0.669168, 0.593542, 0.352198, 0.360317, 0.358028,
+		0.437966, 0.596789, 0.584945, 0.569091, 0.562964, 0.557598,
+		0.303555, 0.526355, 0.653065, 0.579333, 0.353162, 0.361281,
+		0.358992, 0.467898, 0.597267, 0.598368, 0.582514, 0.576387,
+		0.571021, 0.557890, 0.309273, 0.657221, 0.583090, 0.247440,
+		0.252420, 0.253744, 0.500000, 0.739506, 0.736392, 0.734799,
+		0.719980, 0.708662, 0.691179, 0.695661, 0.327543, 0.682779,
+		0.393127, 0.403046, 0.400757, 0.531669, 0.639471, 0.637060,
+		0.633669, 0.638106, 0.630877, 0.615561, 0.619614, 0.725218,
+		0.466268,
+	},
+	{ // T3o
+		0.124569, 0.307603, 0.304687, 0.301771, 0.225047, 0.345526,
+		0.341884, 0.345200, 0.348181, 0.338972, 0.338976, 0.240227,
+		0.375452, 0.322617, 0.124643, 0.298649, 0.295733, 0.230957,
+		0.337751, 0.345457, 0.346005, 0.346530, 0.346621, 0.346625,
+		0.245111, 0.384838, 0.319301, 0.312917, 0.121672, 0.286933,
+		0.233007, 0.327153, 0.334860, 0.346815, 0.344573, 0.344664,
+		0.344667, 0.246686, 0.382881, 0.315986, 0.309602, 0.300061,
+		0.118827, 0.234834, 0.317319, 0.325026, 0.336981, 0.346146,
+		0.343470, 0.343473, 0.249789, 0.381687, 0.232780, 0.238980,
+		0.240871, 0.242533, 0.067371, 0.282688, 0.305632, 0.339752,
+		0.378033, 0.417212, 0.442912, 0.474947, 0.502821, 0.363331,
+		0.355146, 0.343743, 0.333159, 0.296039, 0.279057, 0.510246,
+		0.524215, 0.537859, 0.551781, 0.564390, 0.689720, 0.599851,
+		0.359536, 0.363385, 0.351982, 0.341397, 0.320550, 0.541070,
+		0.282708, 0.509053, 0.523115, 0.537037, 0.549646, 0.688422,
+		0.596735, 0.363118, 0.363985, 0.364679, 0.354094, 0.357052,
+		0.555851, 0.539678, 0.292575, 0.517454, 0.531377, 0.543465,
+		0.674118, 0.600345, 0.366346, 0.364553, 0.362264, 0.363777,
+		0.398098, 0.570296, 0.554551, 0.548424, 0.299851, 0.526319,
+		0.535636, 0.660401, 0.590720, 0.356520, 0.364639, 0.362350,
+		0.360880, 0.440190, 0.585031, 0.569287, 0.563160, 0.557788,
+		0.307087, 0.527467, 0.648230, 0.580446, 0.356551, 0.364670,
+		0.362381, 0.360911, 0.467898, 0.598690, 0.582945, 0.576249,
+		0.567864, 0.559126, 0.311928, 0.648765, 0.580981, 0.251245,
+		0.256225, 0.257549, 0.260494, 0.500000, 0.730851, 0.729511,
+		0.714123, 0.699364, 0.686266, 0.686808, 0.329840, 0.673950,
+		0.396516, 0.406434, 0.404145, 0.402676, 0.531669, 0.637382,
+		0.634100, 0.637967, 0.627720, 0.616797, 0.617332, 0.715991,
+		0.468158,
+	},
+	{ // 93o
+		0.122443, 0.309871, 0.310268, 0.307873, 0.304957, 0.227985,
+		0.337851, 0.341167, 0.344148, 0.334546, 0.338173, 0.238984,
+		0.373807, 0.325356, 0.126681, 0.307209, 0.304814, 0.301899,
+		0.234042, 0.344846, 0.345285, 0.345810, 0.345507, 0.349134,
+		0.247560, 0.386506, 0.325623, 0.322336, 0.124280, 0.296368,
+		0.293453, 0.236221, 0.334719, 0.346616, 0.344374, 0.344071,
+		0.347698, 0.249654, 0.385070, 0.322877, 0.319590, 0.310417,
+		0.121435, 0.284733, 0.239559, 0.324784, 0.336681, 0.345846,
+		0.342776, 0.346403, 0.252518, 0.383775, 0.319562, 0.316275,
+		0.307102, 0.297644, 0.118843, 0.243835, 0.316017, 0.327914,
+		0.337079, 0.345416, 0.346276, 0.258070, 0.383648, 0.236030,
+		0.242391, 0.244416, 0.247675, 0.251932, 0.068848, 0.304346,
+		0.337515, 0.372584, 0.410382, 0.444943, 0.474947, 0.502821,
+		0.355197, 0.362776, 0.351864, 0.341171, 0.331734, 0.319113,
+		0.276659, 0.504488, 0.518521, 0.532443, 0.546555, 0.678533,
+		0.593838, 0.358780, 0.363236, 0.364498, 0.353805, 0.344368,
+		0.354593, 0.534870, 0.286357, 0.512440, 0.526363, 0.540475,
+		0.664468, 0.597550, 0.362007, 0.363803, 0.362084, 0.363487,
+		0.354051, 0.392173, 0.549712, 0.543125, 0.293424, 0.518533,
+		0.532646, 0.650231, 0.587403, 0.351747, 0.363454, 0.361735,
+		0.360156, 0.362816, 0.432758, 0.564441, 0.557854, 0.549470,
+		0.300394, 0.524478, 0.634889, 0.574356, 0.355848, 0.367555,
+		0.365836, 0.364257, 0.363935, 0.470122, 0.579532, 0.572945,
+		0.564561, 0.555817, 0.310623, 0.639355, 0.578826, 0.250034,
+		0.259023, 0.260916, 0.263608, 0.269149, 0.500000, 0.719094,
+		0.703959, 0.688631, 0.672092, 0.677018, 0.327619, 0.660546,
+		0.394879, 0.408386, 0.406666, 0.405087, 0.404765, 0.531669,
+		0.630923, 0.634899, 0.624083, 0.610140, 0.615069, 0.701886,
+		0.465934,
+	},
+	{ // 83o
+		0.122448, 0.309696, 0.310092, 0.307698, 0.304782, 0.307263,
+		0.225929, 0.338922, 0.341382, 0.331780, 0.335407, 0.240792,
+		0.373573, 0.325169, 0.121043, 0.304977, 0.305374, 0.302979,
+		0.305500, 0.233146, 0.338849, 0.338853, 0.338550, 0.342177,
+		0.245203, 0.382081, 0.325442, 0.320179, 0.122237, 0.299495,
+		0.297100, 0.299678, 0.235471, 0.343081, 0.340208, 0.339905,
+		0.343532, 0.250470, 0.383436, 0.322696, 0.320447, 0.313942,
+		0.119962, 0.288735, 0.291894, 0.238938, 0.333617, 0.342201,
+		0.339131, 0.342758, 0.253853, 0.382662, 0.319381, 0.317701,
+		0.311197, 0.302107, 0.117369, 0.284342, 0.244486, 0.324748,
+		0.333333, 0.341670, 0.342530, 0.259166, 0.382434, 0.321937,
+		0.320299, 0.313855, 0.305392, 0.297192, 0.115296, 0.253012,
+		0.319205, 0.327790, 0.336127, 0.348001, 0.269270, 0.385530,
+		0.233814, 0.241485, 0.243671, 0.247064, 0.252666, 0.261472,
+		0.066656, 0.332065, 0.369152, 0.402879, 0.435799, 0.474947,
+		0.500758, 0.356358, 0.356421, 0.360843, 0.350641, 0.341096,
+		0.335159, 0.348769, 0.279233, 0.502507, 0.516400, 0.530512,
+		0.647623, 0.588817, 0.359016, 0.356419, 0.357719, 0.359691,
+		0.350145, 0.344208, 0.388544, 0.532643, 0.285519, 0.507738,
+		0.521851, 0.633625, 0.578359, 0.348755, 0.356070, 0.357370,
+		0.356360, 0.358911, 0.352974, 0.424731, 0.547341, 0.538068,
+		0.292237, 0.510782, 0.618283, 0.564791, 0.352850, 0.360165,
+		0.361464, 0.360455, 0.360023, 0.365754, 0.460305, 0.562426,
+		0.553153, 0.541255, 0.302159, 0.622750, 0.566361, 0.252002,
+		0.256606, 0.261940, 0.265201, 0.270489, 0.280906, 0.500000,
+		0.686093, 0.671018, 0.654479, 0.659400, 0.324235, 0.643991,
+		0.394628, 0.403742, 0.405042, 0.404032, 0.403601, 0.406778,
+		0.529444, 0.625523, 0.614387, 0.599875, 0.601644, 0.684416,
+		0.460736,
+	},
+	{ // 73o
+		0.135598, 0.322250, 0.318145, 0.318541, 0.316147, 0.318628,
+		0.315477, 0.237297, 0.349820, 0.340218, 0.343451, 0.251846,
+		0.385714, 0.338507, 0.138575, 0.317531, 0.317928, 0.315533,
+		0.318054, 0.319513, 0.246103, 0.350558, 0.350255, 0.353489,
+		0.259902, 0.397489, 0.334065, 0.333518, 0.134125, 0.309992,
+		0.310389, 0.313488, 0.314987, 0.249588, 0.347722, 0.347419,
+		0.350653, 0.261005, 0.394653, 0.334338, 0.333791, 0.325311,
+		0.135445, 0.304591, 0.308271, 0.309827, 0.253203, 0.352616,
+		0.349436, 0.352670, 0.267560, 0.396670, 0.331593, 0.331046,
+		0.325579, 0.319157, 0.133422, 0.300973, 0.303009, 0.259119,
+		0.344219, 0.352497, 0.352963, 0.273393, 0.396963, 0.334148,
+		0.333644, 0.328807, 0.323012, 0.315070, 0.131349, 0.298479,
+		0.268979, 0.338574, 0.346852, 0.358726, 0.283258, 0.399959,
+		0.330747, 0.335255, 0.330461, 0.324727, 0.317302, 0.312382,
+		0.129358, 0.266660, 0.336357, 0.344635, 0.356509, 0.300143,
+		0.406499, 0.245966, 0.255363, 0.258859, 0.262412, 0.268401,
+		0.278627, 0.275823, 0.083488, 0.370896, 0.404541, 0.437348,
+		0.474947, 0.509821, 0.367615, 0.368537, 0.365443, 0.370575,
+		0.361521, 0.355475, 0.353134, 0.389923, 0.285786, 0.502082,
+		0.516165, 0.624591, 0.575197, 0.357355, 0.368187, 0.365094,
+		0.367103, 0.370224, 0.364177, 0.361836, 0.426027, 0.531566,
+		0.292252, 0.504804, 0.608968, 0.561731, 0.361021, 0.371853,
+		0.368760, 0.370769, 0.370907, 0.376958, 0.374617, 0.461486,
+		0.546619, 0.534403, 0.301879, 0.610200, 0.563237, 0.263825,
+		0.272369, 0.273318, 0.280020, 0.285877, 0.296041, 0.313907,
+		0.500000, 0.661108, 0.644253, 0.645675, 0.333887, 0.637051,
+		0.407703, 0.420336, 0.417242, 0.419251, 0.419390, 0.422458,
+		0.429516, 0.539262, 0.610431, 0.596028, 0.597734, 0.677250,
+		0.472760,
+	},
+	{ // 63o
+		0.151779, 0.334386, 0.333548, 0.329443, 0.329840, 0.332842,
+		0.329691, 0.328941, 0.252264, 0.348952, 0.352186, 0.266035,
+		0.394449, 0.351574, 0.151721, 0.330022, 0.325917, 0.326313,
+		0.329355, 0.330814, 0.329223, 0.257457, 0.358630, 0.361863,
+		0.270893, 0.401541, 0.350650, 0.346791, 0.151653, 0.322483,
+		0.322879, 0.325978, 0.327477, 0.330495, 0.262532, 0.359061,
+		0.362294, 0.275641, 0.401972, 0.346207, 0.342348, 0.338584,
+		0.147330, 0.315025, 0.321496, 0.323052, 0.326631, 0.267306,
+		0.356887, 0.360121, 0.278032, 0.399799, 0.346481, 0.342621,
+		0.338857, 0.330461, 0.148902, 0.316765, 0.318801, 0.322538,
+		0.273369, 0.362848, 0.363205, 0.287037, 0.402883, 0.349606,
+		0.345789, 0.342086, 0.337329, 0.332055, 0.147399, 0.314423,
+		0.318843, 0.283837, 0.357675, 0.369489, 0.297421, 0.406400,
+		0.346204, 0.347401, 0.343739, 0.339043, 0.334287, 0.329516,
+		0.145408, 0.315062, 0.283186, 0.355356, 0.367171, 0.314067,
+		0.412838, 0.344843, 0.345112, 0.346464, 0.342379, 0.337793,
+		0.333759, 0.329745, 0.135298, 0.305010, 0.359339, 0.371154,
+		0.324050, 0.429070, 0.262168, 0.267509, 0.272730, 0.277594,
+		0.283744, 0.294609, 0.293584, 0.316313, 0.100720, 0.406173,
+		0.438940, 0.474948, 0.509103, 0.366253, 0.376719, 0.377144,
+		0.374760, 0.381040, 0.375486, 0.373035, 0.377471, 0.427284,
+		0.292229, 0.490133, 0.591232, 0.549057, 0.369919, 0.380385,
+		0.380810, 0.378426, 0.381583, 0.388203, 0.385752, 0.390189,
+		0.462700, 0.518097, 0.301562, 0.592183, 0.550271, 0.279123,
+		0.284133, 0.289021, 0.291338, 0.300636, 0.311369, 0.328982,
+		0.338892, 0.500000, 0.624830, 0.625936, 0.343728, 0.604084,
+		0.416595, 0.424251, 0.424676, 0.422292, 0.425449, 0.429087,
+		0.436036, 0.452852, 0.538081, 0.581788, 0.583174, 0.641390,
+		0.472455,
+	},
+	{ // 53o
+		0.154281, 0.345158, 0.344320, 0.343482, 0.339377, 0.345170,
+		0.342019, 0.341791, 0.343760, 0.252579, 0.361519, 0.263794,
+		0.400803, 0.362438, 0.169516, 0.342139, 0.341301, 0.337196,
+		0.343550, 0.345009, 0.343939, 0.342871, 0.272550, 0.373387,
+		0.283520, 0.410564, 0.361513, 0.359662, 0.169449, 0.337867,
+		0.333762, 0.340173, 0.341672, 0.345211, 0.343303, 0.277625,
+		0.373818, 0.288268, 0.410995, 0.360589, 0.358738, 0.354974,
+		0.169507, 0.330409, 0.337401, 0.338958, 0.342536, 0.345237,
+		0.283989, 0.374911, 0.294305, 0.412088, 0.356146, 0.354295,
+		0.350531, 0.346850, 0.165436, 0.330614, 0.332656, 0.339178,
+		0.342440, 0.291211, 0.373805, 0.299145, 0.410982, 0.362291,
+		0.361051, 0.357348, 0.354294, 0.347045, 0.167527, 0.330846,
+		0.338050, 0.341571, 0.301827, 0.382990, 0.312701, 0.417290,
+		0.358889, 0.362663, 0.359002, 0.356008, 0.349277, 0.347174,
+		0.165537, 0.333908, 0.338892, 0.302022, 0.380731, 0.329347,
+		0.423728, 0.358097, 0.360943, 0.362296, 0.359344, 0.355796,
+		0.354430, 0.350027, 0.155997, 0.341408, 0.326090, 0.385024,
+		0.339611, 0.440774, 0.359516, 0.359259, 0.359684, 0.361746,
+		0.358809, 0.357722, 0.354883, 0.357703, 0.144006, 0.344176,
+		0.397942, 0.358298, 0.455800, 0.261858, 0.283723, 0.288945,
+		0.295535, 0.302995, 0.314021, 0.313889, 0.339040, 0.357841,
+		0.122959, 0.440812, 0.474948, 0.508357, 0.379507, 0.392184,
+		0.392608, 0.393743, 0.392507, 0.402286, 0.399899, 0.404654,
+		0.418806, 0.464076, 0.302933, 0.565128, 0.527396, 0.276060,
+		0.297676, 0.302564, 0.308821, 0.313734, 0.327908, 0.345521,
+		0.355747, 0.375170, 0.500000, 0.596172, 0.355524, 0.574080,
+		0.422944, 0.433435, 0.433860, 0.434994, 0.433758, 0.440415,
+		0.447364, 0.465069, 0.481407, 0.536747, 0.557663, 0.608404,
+		0.477025,
+	},
+	{ // 43o
+		0.149874, 0.341446, 0.340608, 0.339770, 0.338932, 0.340224,
+		0.337079, 0.339635, 0.342126, 0.325937, 0.250953, 0.259639,
+		0.397032, 0.358450, 0.165109, 0.338427, 0.337589, 0.336751,
+		0.338603, 0.340069, 0.341783, 0.341237, 0.339821, 0.270924,
+		0.279365, 0.406793, 0.357526, 0.355675, 0.165042, 0.334155,
+		0.333317, 0.335227, 0.336732, 0.343056, 0.341669, 0.340252,
+		0.275999, 0.284113, 0.407224, 0.356601, 0.354750, 0.350986,
+		0.165100, 0.329964, 0.332455, 0.334017, 0.340381, 0.343603,
+		0.341345, 0.282363, 0.290150, 0.408318, 0.355677, 0.353826,
+		0.350062, 0.346381, 0.165411, 0.330169, 0.332211, 0.338734,
+		0.341995, 0.344347, 0.291175, 0.298635, 0.410478, 0.357105,
+		0.355865, 0.352162, 0.349108, 0.346575, 0.161860, 0.319088,
+		0.335555, 0.341861, 0.344773, 0.302950, 0.308027, 0.412595,
+		0.353710, 0.357483, 0.353822, 0.350829, 0.348813, 0.334809,
+		0.159881, 0.331433, 0.339202, 0.342475, 0.303292, 0.324682,
+		0.419040, 0.355931, 0.358777, 0.360129, 0.357178, 0.355333,
+		0.351991, 0.347615, 0.153924, 0.343803, 0.348946, 0.328446,
+		0.338169, 0.438980, 0.357919, 0.357662, 0.358087, 0.360149,
+		0.358345, 0.358296, 0.355484, 0.360447, 0.142503, 0.357615,
+		0.349620, 0.357136, 0.454316, 0.340664, 0.355433, 0.355858,
+		0.356992, 0.360202, 0.360764, 0.358341, 0.365333, 0.374933,
+		0.127836, 0.374283, 0.385343, 0.480044, 0.260143, 0.282009,
+		0.287231, 0.293821, 0.303007, 0.315343, 0.315372, 0.341669,
+		0.363820, 0.389712, 0.124499, 0.474943, 0.508349, 0.271579,
+		0.293194, 0.298083, 0.304339, 0.313192, 0.322982, 0.340600,
+		0.354325, 0.374064, 0.403828, 0.500000, 0.349795, 0.518218,
+		0.418890, 0.429382, 0.429806, 0.430941, 0.433223, 0.435486,
+		0.442441, 0.463301, 0.479957, 0.507946, 0.536747, 0.547725,
+		0.471707,
+	},
+	{ // 33
+		0.182290, 0.507939, 0.504728, 0.501517, 0.498305, 0.509845,
+		0.505670, 0.510076, 0.516342, 0.509870, 0.513088, 0.626046,
+		0.653504, 0.533755, 0.186331, 0.493972, 0.490761, 0.487550,
+		0.499129, 0.506024, 0.507216, 0.511198, 0.515459, 0.518677,
+		0.638054, 0.656045, 0.530246, 0.518677, 0.185984, 0.480761,
+		0.477550, 0.489169, 0.496103, 0.508365, 0.509133, 0.513393,
+		0.516612, 0.635935, 0.653884, 0.526737, 0.515168, 0.504306,
+		0.185762, 0.467542, 0.479201, 0.486175, 0.498476, 0.510313,
+		0.511359, 0.514578, 0.633847, 0.651753, 0.523228, 0.511659,
+		0.500797, 0.489925, 0.185793, 0.469224, 0.476238, 0.488578,
+		0.500456, 0.512572, 0.512575, 0.631791, 0.649655, 0.535732,
+		0.524205, 0.513385, 0.502554, 0.491713, 0.186632, 0.470029,
+		0.482409, 0.494326, 0.506481, 0.517555, 0.633868, 0.651735,
+		0.531213, 0.531687, 0.520909, 0.510121, 0.499322, 0.492594,
+		0.183800, 0.470345, 0.482725, 0.494921, 0.506034, 0.637023,
+		0.652775, 0.535892, 0.532853, 0.534076, 0.523330, 0.512573,
+		0.505887, 0.492938, 0.186140, 0.482506, 0.494741, 0.505894,
+		0.628234, 0.661087, 0.542578, 0.537097, 0.534807, 0.536061,
+		0.525347, 0.518703, 0.506225, 0.506072, 0.185501, 0.491949,
+		0.503143, 0.619285, 0.661643, 0.535844, 0.541634, 0.539345,
+		0.537087, 0.538373, 0.531771, 0.519335, 0.519224, 0.516391,
+		0.184769, 0.498015, 0.608558, 0.660647, 0.539395, 0.545186,
+		0.542897, 0.540639, 0.538413, 0.543812, 0.531418, 0.531349,
+		0.528558, 0.523222, 0.189894, 0.613854, 0.666026, 0.664061,
+		0.677148, 0.674787, 0.672457, 0.670160, 0.672381, 0.675765,
+		0.666113, 0.656272, 0.644476, 0.650205, 0.500000, 0.841314,
+		0.688423, 0.691056, 0.688674, 0.686324, 0.684006, 0.686229,
+		0.687334, 0.696168, 0.696822, 0.695787, 0.701576, 0.892034,
+		0.804295,
+	},
+	{ // 32s
+		0.169875, 0.354344, 0.353527, 0.352711, 0.351894, 0.356355,
+		0.352485, 0.350239, 0.355291, 0.340226, 0.341049, 0.275191,
+		0.284266, 0.378773, 0.184110, 0.351465, 0.350649, 0.349832,
+		0.354819, 0.355459, 0.352393, 0.354674, 0.353701, 0.354524,
+		0.294136, 0.303010, 0.377889, 0.375960, 0.184055, 0.347258,
+		0.346442, 0.351483, 0.352160, 0.353604, 0.355065, 0.354092,
+		0.354915, 0.299094, 0.307605, 0.377005, 0.375076, 0.371411,
+		0.184118, 0.343129, 0.348718, 0.349450, 0.350931, 0.356902,
+		0.355109, 0.355932, 0.305263, 0.313412, 0.376121, 0.374192,
+		0.370527, 0.366942, 0.184418, 0.346412, 0.347597, 0.349228,
+		0.355237, 0.357953, 0.357956, 0.313742, 0.321528, 0.380898,
+		0.379530, 0.375923, 0.372919, 0.370399, 0.185201, 0.348071,
+		0.350345, 0.356599, 0.359353, 0.363866, 0.326483, 0.333906,
+		0.376795, 0.380264, 0.376697, 0.373750, 0.371709, 0.372154,
+		0.182409, 0.340498, 0.356656, 0.362856, 0.367407, 0.342164,
+		0.347352, 0.374416, 0.376998, 0.378269, 0.375361, 0.373479,
+		0.374606, 0.364454, 0.181068, 0.357062, 0.365322, 0.370309,
+		0.348920, 0.369057, 0.379383, 0.379111, 0.379494, 0.381425,
+		0.379582, 0.380968, 0.381113, 0.381556, 0.176274, 0.376778,
+		0.384446, 0.380047, 0.389346, 0.363468, 0.377662, 0.378045,
+		0.379089, 0.382084, 0.383510, 0.387303, 0.389926, 0.402018,
+		0.163555, 0.400415, 0.408291, 0.415255, 0.364396, 0.378590,
+		0.378973, 0.380016, 0.382124, 0.388388, 0.392221, 0.395305,
+		0.410251, 0.427305, 0.168087, 0.459458, 0.471434, 0.284935,
+		0.305745, 0.310839, 0.317221, 0.326050, 0.339454, 0.356009,
+		0.362949, 0.395916, 0.425920, 0.481782, 0.158686, 0.500000,
+		0.294476, 0.315078, 0.319800, 0.325808, 0.334264, 0.347294,
+		0.361438, 0.384579, 0.405831, 0.433260, 0.494624, 0.525158,
+		0.372088,
+	},
+	{ // A2o
+		0.067845, 0.247385, 0.252726, 0.261989, 0.274972, 0.301152,
+		0.326307, 0.355908, 0.391954, 0.415478, 0.441528, 0.444700,
+		0.474853, 0.259188, 0.286501, 0.544417, 0.541199, 0.538045,
+		0.552161, 0.561296, 0.557437, 0.557829, 0.571082, 0.574748,
+		0.578414, 0.719174, 0.264637, 0.577887, 0.285996, 0.529233,
+		0.526079, 0.540194, 0.549330, 0.557441, 0.554612, 0.567865,
+		0.571531, 0.575197, 0.715561, 0.274344, 0.574372, 0.561425,
+		0.286227, 0.514176, 0.528291, 0.537427, 0.545538, 0.554679,
+		0.564710, 0.568376, 0.572042, 0.712012, 0.288093, 0.570920,
+		0.557973, 0.545089, 0.287193, 0.516451, 0.525587, 0.533698,
+		0.542839, 0.564840, 0.565285, 0.568951, 0.708526, 0.316088,
+		0.585972, 0.573025, 0.560141, 0.547320, 0.295288, 0.518247,
+		0.526358, 0.535499, 0.557500, 0.569915, 0.570360, 0.709539,
+		0.342922, 0.595833, 0.582886, 0.570002, 0.557181, 0.549386,
+		0.297728, 0.514645, 0.523786, 0.545787, 0.558202, 0.570617,
+		0.706180, 0.374624, 0.591646, 0.591643, 0.578759, 0.565938,
+		0.558143, 0.545449, 0.297537, 0.508931, 0.533588, 0.546003,
+		0.558417, 0.708089, 0.413370, 0.592090, 0.588575, 0.588636,
+		0.575815, 0.568019, 0.555325, 0.539503, 0.299170, 0.525250,
+		0.537666, 0.550081, 0.693849, 0.437983, 0.605840, 0.602325,
+		0.598874, 0.598997, 0.591202, 0.578508, 0.565433, 0.556541,
+		0.297603, 0.531829, 0.544309, 0.697199, 0.466091, 0.609831,
+		0.606316, 0.602864, 0.599475, 0.604624, 0.591930, 0.578856,
+		0.569964, 0.563552, 0.304204, 0.548062, 0.701357, 0.469504,
+		0.613840, 0.610325, 0.606873, 0.603484, 0.605121, 0.605372,
+		0.592297, 0.583405, 0.577056, 0.581110, 0.311577, 0.705524,
+		0.500000, 0.762077, 0.758146, 0.754278, 0.750473, 0.751685,
+		0.748007, 0.750043, 0.734753, 0.737817, 0.742298, 0.746798,
+		0.341799,
+	},
+	{ // K2o
+		0.107009, 0.215288, 0.326680, 0.325842, 0.325004, 0.339265,
+		0.339283, 0.335178, 0.338671, 0.327152, 0.327997, 0.328842,
+		0.227812, 0.222285, 0.053734, 0.238613, 0.247733, 0.259616,
+		0.284094, 0.315511, 0.343081, 0.380765, 0.413733, 0.439866,
+		0.443151, 0.474853, 0.343543, 0.249466, 0.269945, 0.524427,
+		0.521273, 0.535388, 0.546027, 0.554332, 0.554727, 0.558203,
+		0.561869, 0.565598, 0.710725, 0.342612, 0.259027, 0.556555,
+		0.270176, 0.509369, 0.523485, 0.534124, 0.542429, 0.554793,
+		0.555048, 0.558714, 0.562443, 0.707176, 0.341682, 0.271589,
+		0.553103, 0.540219, 0.271101, 0.511645, 0.522284, 0.530589,
+		0.542953, 0.555178, 0.555623, 0.559352, 0.703690, 0.357000,
+		0.297764, 0.568149, 0.555265, 0.542443, 0.279099, 0.514944,
+		0.519955, 0.532320, 0.544545, 0.556959, 0.557467, 0.701011,
+		0.357158, 0.331412, 0.579442, 0.566558, 0.553737, 0.545936,
+		0.287094, 0.512176, 0.524541, 0.536766, 0.549181, 0.561658,
+		0.701584, 0.352712, 0.360890, 0.588435, 0.575551, 0.562730,
+		0.551346, 0.543045, 0.286153, 0.508702, 0.521346, 0.533760,
+		0.546238, 0.699872, 0.356398, 0.401277, 0.588708, 0.588768,
+		0.575947, 0.564564, 0.556263, 0.539241, 0.292139, 0.517811,
+		0.530227, 0.542705, 0.689015, 0.343928, 0.436613, 0.592344,
+		0.588892, 0.589015, 0.577631, 0.569330, 0.552737, 0.548822,
+		0.295601, 0.521757, 0.534237, 0.676534, 0.344893, 0.464803,
+		0.596334, 0.592882, 0.589494, 0.591054, 0.582753, 0.566160,
+		0.562244, 0.553061, 0.301907, 0.537990, 0.680692, 0.345876,
+		0.468331, 0.600406, 0.596954, 0.593566, 0.591614, 0.596258,
+		0.579664, 0.575749, 0.566565, 0.570618, 0.308944, 0.684922,
+		0.237923, 0.500000, 0.753166, 0.749298, 0.745493, 0.742695,
+		0.743402, 0.741499, 0.729744, 0.716201, 0.720682, 0.725246,
+		0.328501,
+	},
+	{ // Q2o
+		0.111384, 0.297262, 0.216878, 0.326717, 0.325879, 0.340140,
+		0.339764, 0.339283, 0.341935, 0.330415, 0.331260, 0.332105,
+		0.231448, 0.311495, 0.111457, 0.222829, 0.318883, 0.318044,
+		0.332305, 0.343337, 0.340089, 0.340174, 0.338005, 0.338850,
+		0.339695, 0.236332, 0.224011, 0.230254, 0.056403, 0.246455,
+		0.257993, 0.281233, 0.310249, 0.345120, 0.380773, 0.413741,
+		0.439874, 0.443159, 0.474853, 0.343426, 0.335179, 0.257590,
+		0.268984, 0.509899, 0.523985, 0.534624, 0.544432, 0.556991,
+		0.557246, 0.560912, 0.564641, 0.705835, 0.342495, 0.334248,
+		0.269786, 0.540878, 0.269867, 0.512145, 0.522784, 0.532592,
+		0.545150, 0.557375, 0.557820, 0.561549, 0.702349, 0.357813,
+		0.349566, 0.294631, 0.555892, 0.543071, 0.277781, 0.512150,
+		0.521958, 0.533996, 0.546220, 0.558635, 0.559143, 0.699150,
+		0.357537, 0.361386, 0.325685, 0.567179, 0.554358, 0.542974,
+		0.285638, 0.514179, 0.523444, 0.535669, 0.548084, 0.560562,
+		0.696551, 0.357161, 0.358028, 0.363115, 0.577605, 0.564783,
+		0.553400, 0.545093, 0.290210, 0.511540, 0.524183, 0.536598,
+		0.549076, 0.698771, 0.359913, 0.357980, 0.401277, 0.591057,
+		0.578236, 0.566283, 0.554963, 0.542334, 0.295404, 0.517428,
+		0.529844, 0.542322, 0.684294, 0.347443, 0.355499, 0.436613,
+		0.591181, 0.591304, 0.579351, 0.568031, 0.555831, 0.548397,
+		0.298614, 0.521374, 0.533854, 0.671812, 0.348408, 0.356464,
+		0.464803, 0.595171, 0.591783, 0.592774, 0.581454, 0.569254,
+		0.561820, 0.552636, 0.304625, 0.537607, 0.675971, 0.349391,
+		0.357447, 0.468331, 0.599243, 0.595855, 0.593334, 0.594958,
+		0.582758, 0.575324, 0.566140, 0.570194, 0.311326, 0.680200,
+		0.241854, 0.246834, 0.500000, 0.747974, 0.744169, 0.740802,
+		0.738068, 0.740550, 0.724855, 0.711312, 0.715794, 0.720357,
+		0.330484,
+	},
+	{ // J2o
+		0.115759, 0.300525, 0.297610, 0.218468, 0.326754, 0.340956,
+		0.340580, 0.339705, 0.345981, 0.333619, 0.334464, 0.335310,
+		0.235026, 0.315016, 0.115832, 0.291572, 0.224419, 0.318919,
+		0.333121, 0.344153, 0.340511, 0.344220, 0.341209, 0.342054,
+		0.342899, 0.239909, 0.311701, 0.305316, 0.112861, 0.226510,
+		0.308322, 0.322524, 0.333556, 0.341321, 0.342263, 0.339252,
+		0.340097, 0.340942, 0.241484, 0.225738, 0.231980, 0.233912,
+		0.059481, 0.256716, 0.279409, 0.306788, 0.339079, 0.382812,
+		0.413750, 0.439882, 0.443167, 0.474853, 0.343309, 0.335062,
+		0.323658, 0.268350, 0.268601, 0.511913, 0.522522, 0.532330,
+		0.546392, 0.558811, 0.559256, 0.562985, 0.699482, 0.358564,
+		0.350317, 0.338913, 0.292610, 0.542910, 0.276431, 0.511469,
+		0.521277, 0.535339, 0.547758, 0.560172, 0.560680, 0.696522,
+		0.358287, 0.362136, 0.350733, 0.321906, 0.554166, 0.542322,
+		0.284162, 0.510725, 0.524787, 0.536685, 0.549100, 0.561577,
+		0.693403, 0.357477, 0.358344, 0.359037, 0.356535, 0.564585,
+		0.552741, 0.541421, 0.288553, 0.512883, 0.522426, 0.534841,
+		0.547319, 0.692452, 0.364298, 0.362366, 0.360077, 0.403502,
+		0.579471, 0.567627, 0.556307, 0.543672, 0.299219, 0.519605,
+		0.532021, 0.544499, 0.681906, 0.350895, 0.358951, 0.356662,
+		0.436613, 0.592774, 0.580930, 0.569041, 0.553821, 0.550781,
+		0.301594, 0.520330, 0.532810, 0.665804, 0.351860, 0.359916,
+		0.357627, 0.464803, 0.593252, 0.594353, 0.582463, 0.567244,
+		0.564204, 0.551502, 0.307312, 0.536563, 0.669962, 0.352843,
+		0.360899, 0.358610, 0.468331, 0.597324, 0.594913, 0.595968,
+		0.580749, 0.577708, 0.565006, 0.569059, 0.313676, 0.674192,
+		0.245722, 0.250702, 0.252026, 0.500000, 0.741224, 0.738110,
+		0.734808, 0.733848, 0.722538, 0.705056, 0.709537, 0.714101,
+		0.332435,
+	},
+	{ // T2o
+		0.120133, 0.303789, 0.300873, 0.297957, 0.220058, 0.341712,
+		0.341337, 0.340462, 0.346344, 0.337606, 0.337610, 0.338455,
+		0.238543, 0.318537, 0.120207, 0.294835, 0.291920, 0.226009,
+		0.333937, 0.344910, 0.341268, 0.344584, 0.345196, 0.345199,
+		0.346045, 0.243427, 0.315222, 0.308837, 0.117236, 0.283119,
+		0.228100, 0.323340, 0.334312, 0.342078, 0.342626, 0.343238,
+		0.343242, 0.344087, 0.245002, 0.311907, 0.305522, 0.295981,
+		0.114391, 0.229968, 0.313506, 0.324478, 0.332244, 0.344200,
+		0.342044, 0.342048, 0.342893, 0.248105, 0.227464, 0.233706,
+		0.235638, 0.237342, 0.062969, 0.278131, 0.304761, 0.334758,
+		0.376251, 0.415789, 0.439890, 0.443176, 0.474853, 0.359251,
+		0.351067, 0.339663, 0.329079, 0.291173, 0.275050, 0.510172,
+		0.519951, 0.534012, 0.547934, 0.560543, 0.561051, 0.691208,
+		0.358974, 0.362824, 0.351420, 0.340836, 0.319666, 0.541014,
+		0.282654, 0.508979, 0.523041, 0.536963, 0.549572, 0.562050,
+		0.688328, 0.358164, 0.359031, 0.359724, 0.349140, 0.351825,
+		0.551401, 0.539621, 0.286878, 0.508364, 0.522704, 0.534792,
+		0.547270, 0.686857, 0.364551, 0.362618, 0.360329, 0.361842,
+		0.396354, 0.566281, 0.554501, 0.538853, 0.297321, 0.519883,
+		0.529199, 0.541677, 0.673139, 0.355218, 0.363273, 0.360984,
+		0.359515, 0.438837, 0.581017, 0.569237, 0.554017, 0.550971,
+		0.305125, 0.521443, 0.533923, 0.660969, 0.355248, 0.363304,
+		0.361015, 0.359546, 0.464803, 0.594675, 0.582895, 0.567106,
+		0.561046, 0.552737, 0.309967, 0.534455, 0.661506, 0.356232,
+		0.364288, 0.361998, 0.360529, 0.468331, 0.595235, 0.596399,
+		0.580610, 0.574551, 0.566242, 0.566777, 0.315994, 0.665736,
+		0.249527, 0.254507, 0.255831, 0.258776, 0.500000, 0.732569,
+		0.729520, 0.727992, 0.713240, 0.700143, 0.700684, 0.705248,
+		0.334355,
+	},
+	{ // 92o
+		0.118008, 0.306057, 0.306454, 0.304059, 0.301144, 0.222996,
+		0.337304, 0.336429, 0.342311, 0.333180, 0.336807, 0.336810,
+		0.237300, 0.321276, 0.122246, 0.303395, 0.301001, 0.298085,
+		0.229095, 0.344299, 0.340547, 0.343863, 0.344082, 0.347709,
+		0.347712, 0.245876, 0.321544, 0.318256, 0.119844, 0.292555,
+		0.289639, 0.231314, 0.334172, 0.341878, 0.342427, 0.342646,
+		0.346273, 0.346276, 0.247971, 0.318798, 0.315511, 0.306338,
+		0.116999, 0.280919, 0.234693, 0.324237, 0.331943, 0.343899,
+		0.341350, 0.344978, 0.344981, 0.250835, 0.315483, 0.312196,
+		0.303023, 0.293565, 0.114407, 0.239010, 0.315470, 0.323176,
+		0.335132, 0.343991, 0.344850, 0.344854, 0.256386, 0.230714,
+		0.237117, 0.239183, 0.242484, 0.246783, 0.064446, 0.303476,
+		0.332521, 0.370802, 0.408959, 0.441921, 0.443176, 0.474853,
+		0.354636, 0.362215, 0.351303, 0.340609, 0.331173, 0.318229,
+		0.276605, 0.504414, 0.518447, 0.532369, 0.546481, 0.559153,
+		0.678439, 0.353825, 0.358281, 0.359544, 0.348850, 0.339414,
+		0.349366, 0.534813, 0.280661, 0.503349, 0.517690, 0.531802,
+		0.544474, 0.677207, 0.360212, 0.361868, 0.360149, 0.361552,
+		0.352115, 0.390429, 0.549661, 0.533553, 0.290893, 0.512096,
+		0.526209, 0.538360, 0.662969, 0.350444, 0.362089, 0.360369,
+		0.358790, 0.361450, 0.431405, 0.564391, 0.548712, 0.542652,
+		0.298433, 0.518453, 0.527833, 0.647627, 0.354545, 0.366190,
+		0.364470, 0.362891, 0.362569, 0.467028, 0.579482, 0.563803,
+		0.557743, 0.549428, 0.308662, 0.532300, 0.652097, 0.354594,
+		0.366239, 0.364519, 0.362940, 0.362618, 0.468331, 0.593222,
+		0.577542, 0.570913, 0.559585, 0.564514, 0.313771, 0.652706,
+		0.248315, 0.257305, 0.259198, 0.261890, 0.267431, 0.500000,
+		0.719102, 0.717827, 0.702507, 0.685968, 0.690895, 0.691519,
+		0.331755,
+	},
+	{ // 82o
+		0.122382, 0.309142, 0.309539, 0.307145, 0.304229, 0.306710,
+		0.224585, 0.337067, 0.342949, 0.333817, 0.337051, 0.340678,
+		0.240698, 0.324601, 0.120977, 0.304424, 0.304820, 0.302426,
+		0.304946, 0.231843, 0.336994, 0.340310, 0.340529, 0.343762,
+		0.347389, 0.245109, 0.324875, 0.319612, 0.122170, 0.298941,
+		0.296547, 0.299125, 0.234209, 0.341226, 0.341665, 0.341884,
+		0.345117, 0.348744, 0.250376, 0.322129, 0.319879, 0.313375,
+		0.119895, 0.288182, 0.291341, 0.237718, 0.331762, 0.343658,
+		0.341110, 0.344343, 0.347971, 0.253760, 0.318814, 0.317133,
+		0.310629, 0.301539, 0.117303, 0.283789, 0.243306, 0.322893,
+		0.334790, 0.343649, 0.344115, 0.347742, 0.259072, 0.321370,
+		0.319731, 0.313288, 0.304825, 0.296624, 0.115230, 0.251873,
+		0.317350, 0.329247, 0.338105, 0.349979, 0.350839, 0.269176,
+		0.232441, 0.240153, 0.242380, 0.245815, 0.251459, 0.260306,
+		0.066617, 0.331235, 0.368363, 0.402130, 0.435092, 0.445206,
+		0.474853, 0.354386, 0.354449, 0.358871, 0.348669, 0.339123,
+		0.333186, 0.347929, 0.274980, 0.495420, 0.509731, 0.523843,
+		0.538017, 0.662583, 0.360773, 0.358036, 0.359335, 0.361308,
+		0.351762, 0.345825, 0.387751, 0.525100, 0.285002, 0.503717,
+		0.517830, 0.532005, 0.648585, 0.351004, 0.358256, 0.359555,
+		0.358546, 0.361097, 0.355160, 0.423980, 0.540227, 0.533707,
+		0.292290, 0.507301, 0.521478, 0.632723, 0.354671, 0.361922,
+		0.363222, 0.362212, 0.361781, 0.367940, 0.459595, 0.555312,
+		0.548792, 0.537464, 0.302212, 0.525945, 0.634021, 0.358790,
+		0.366041, 0.367341, 0.366331, 0.365900, 0.369077, 0.470556,
+		0.570484, 0.563964, 0.552636, 0.557559, 0.312666, 0.638562,
+		0.251993, 0.256598, 0.261932, 0.265192, 0.270480, 0.280898,
+		0.500000, 0.702317, 0.687250, 0.670143, 0.671628, 0.676637,
+		0.329689,
+	},
+	{ // 72o
+		0.118004, 0.308906, 0.304801, 0.305197, 0.302803, 0.305284,
+		0.302368, 0.220936, 0.336955, 0.327723, 0.330957, 0.334584,
+		0.238799, 0.324350, 0.120981, 0.304187, 0.304584, 0.302189,
+		0.304710, 0.306404, 0.229784, 0.338004, 0.337701, 0.340935,
+		0.344562, 0.246855, 0.319907, 0.319360, 0.116531, 0.296648,
+		0.297045, 0.300144, 0.301878, 0.233310, 0.335168, 0.334866,
+		0.338099, 0.341726, 0.247958, 0.320181, 0.319634, 0.311154,
+		0.117851, 0.291247, 0.294927, 0.296718, 0.236965, 0.340062,
+		0.336883, 0.340116, 0.343743, 0.254513, 0.317435, 0.316888,
+		0.311421, 0.305000, 0.115828, 0.287629, 0.289900, 0.242922,
+		0.331665, 0.339943, 0.340409, 0.344036, 0.260346, 0.319991,
+		0.319486, 0.314649, 0.308855, 0.300913, 0.113755, 0.285370,
+		0.252823, 0.326020, 0.334298, 0.346172, 0.347032, 0.270211,
+		0.316677, 0.321185, 0.316391, 0.310657, 0.303232, 0.298312,
+		0.112188, 0.265562, 0.323844, 0.332122, 0.343996, 0.355870,
+		0.285051, 0.228499, 0.237939, 0.241476, 0.245071, 0.251102,
+		0.261369, 0.274696, 0.064838, 0.362912, 0.399016, 0.425948,
+		0.436063, 0.474853, 0.354304, 0.355548, 0.352455, 0.357587,
+		0.348533, 0.342487, 0.340195, 0.381927, 0.277633, 0.487985,
+		0.502068, 0.516243, 0.625205, 0.344430, 0.355199, 0.352106,
+		0.354115, 0.357236, 0.351189, 0.348898, 0.420700, 0.516928,
+		0.284099, 0.490707, 0.504885, 0.609582, 0.348096, 0.358865,
+		0.355772, 0.357781, 0.357919, 0.363970, 0.361678, 0.449786,
+		0.531981, 0.519765, 0.293726, 0.506451, 0.610880, 0.352209,
+		0.362979, 0.359885, 0.361894, 0.362033, 0.365101, 0.374477,
+		0.460738, 0.547148, 0.534931, 0.536699, 0.303832, 0.615421,
+		0.249957, 0.258501, 0.259450, 0.266152, 0.272008, 0.282173,
+		0.297683, 0.500000, 0.662289, 0.645434, 0.646920, 0.651923,
+		0.325892,
+	},
+	{ // 62o
+		0.134185, 0.321042, 0.320204, 0.316099, 0.316496, 0.319498,
+		0.316582, 0.314273, 0.235912, 0.336457, 0.339691, 0.343318,
+		0.252988, 0.337417, 0.134127, 0.316678, 0.312573, 0.312969,
+		0.316011, 0.317705, 0.314555, 0.241146, 0.346076, 0.349309,
+		0.352543, 0.257846, 0.336492, 0.332633, 0.134059, 0.309139,
+		0.309536, 0.312634, 0.314368, 0.315828, 0.246261, 0.346507,
+		0.349741, 0.352974, 0.262594, 0.332049, 0.328190, 0.324426,
+		0.129736, 0.301681, 0.308152, 0.310465, 0.311963, 0.251076,
+		0.344333, 0.347567, 0.350801, 0.264985, 0.332323, 0.328464,
+		0.324700, 0.316303, 0.131308, 0.303421, 0.306213, 0.307871,
+		0.257180, 0.350294, 0.350651, 0.353885, 0.273989, 0.335448,
+		0.331631, 0.327928, 0.323171, 0.317897, 0.129805, 0.301835,
+		0.304175, 0.267689, 0.345121, 0.356935, 0.357402, 0.284374,
+		0.332134, 0.333331, 0.329669, 0.325542, 0.320786, 0.316015,
+		0.128238, 0.302549, 0.282087, 0.342843, 0.354658, 0.366532,
+		0.298975, 0.329663, 0.329932, 0.331284, 0.327199, 0.322613,
+		0.318579, 0.316800, 0.126884, 0.285523, 0.346427, 0.358242,
+		0.370115, 0.322397, 0.244710, 0.250092, 0.255356, 0.260261,
+		0.266452, 0.277359, 0.292466, 0.295903, 0.082086, 0.400760,
+		0.427611, 0.437612, 0.474854, 0.353328, 0.363731, 0.364156,
+		0.361772, 0.368052, 0.362498, 0.360097, 0.364053, 0.422080,
+		0.284076, 0.476036, 0.490184, 0.591846, 0.356994, 0.367397,
+		0.367822, 0.365438, 0.368595, 0.375215, 0.372814, 0.376770,
+		0.451082, 0.503459, 0.293409, 0.491458, 0.592863, 0.361107,
+		0.371082, 0.371506, 0.369123, 0.372280, 0.375917, 0.385613,
+		0.389569, 0.461919, 0.518593, 0.520043, 0.303178, 0.594169,
+		0.265247, 0.270256, 0.275145, 0.277462, 0.286760, 0.297493,
+		0.312750, 0.337711, 0.500000, 0.626003, 0.627173, 0.628677,
+		0.335128,
+	},
+	{ // 52o
+		0.136687, 0.331814, 0.330976, 0.330138, 0.326033, 0.331826,
+		0.329432, 0.327123, 0.329099, 0.236368, 0.348965, 0.352198,
+		0.250747, 0.348280, 0.151922, 0.328795, 0.327957, 0.323852,
+		0.330206, 0.332421, 0.329271, 0.328521, 0.256239, 0.360833,
+		0.364067, 0.270473, 0.347356, 0.345505, 0.151855, 0.324523,
+		0.320418, 0.326829, 0.329084, 0.330544, 0.328952, 0.261355,
+		0.361264, 0.364498, 0.275221, 0.346431, 0.344580, 0.340816,
+		0.151913, 0.317065, 0.324057, 0.326370, 0.327869, 0.330887,
+		0.267759, 0.362358, 0.365591, 0.281257, 0.341989, 0.340137,
+		0.336373, 0.332692, 0.147842, 0.317270, 0.322853, 0.324511,
+		0.328089, 0.275023, 0.361251, 0.364485, 0.286097, 0.348133,
+		0.346893, 0.343190, 0.340136, 0.332887, 0.149934, 0.321043,
+		0.323382, 0.327220, 0.285679, 0.370436, 0.370793, 0.299654,
+		0.345389, 0.349162, 0.345501, 0.342508, 0.338789, 0.336686,
+		0.148937, 0.321807, 0.326791, 0.300924, 0.368629, 0.380444,
+		0.314775, 0.342918, 0.345763, 0.347116, 0.344164, 0.340616,
+		0.339250, 0.337511, 0.147582, 0.328087, 0.306611, 0.372112,
+		0.383926, 0.337958, 0.344342, 0.344408, 0.344833, 0.346895,
+		0.343958, 0.342871, 0.342373, 0.343868, 0.138242, 0.336097,
+		0.385112, 0.396926, 0.356644, 0.244546, 0.266307, 0.271570,
+		0.278202, 0.285703, 0.296771, 0.312771, 0.318630, 0.349741,
+		0.104368, 0.429554, 0.439444, 0.474854, 0.366519, 0.379196,
+		0.379620, 0.380755, 0.379519, 0.389298, 0.387389, 0.391236,
+		0.405477, 0.452531, 0.294780, 0.466194, 0.565778, 0.370203,
+		0.382880, 0.383305, 0.384439, 0.383203, 0.389860, 0.400125,
+		0.403972, 0.418212, 0.463253, 0.492054, 0.304213, 0.566740,
+		0.262183, 0.283799, 0.288688, 0.294944, 0.299857, 0.314032,
+		0.329857, 0.354566, 0.373997, 0.500000, 0.597376, 0.598502,
+		0.346419,
+	},
+	{ // 42o
+		0.132280, 0.328102, 0.327264, 0.326426, 0.325588, 0.326880,
+		0.327276, 0.324967, 0.327465, 0.311586, 0.234742, 0.348428,
+		0.246592, 0.344293, 0.147515, 0.325083, 0.324245, 0.323407,
+		0.325259, 0.330266, 0.327116, 0.326887, 0.325470, 0.254613,
+		0.360296, 0.266318, 0.343368, 0.341517, 0.147448, 0.320811,
+		0.319973, 0.321883, 0.326929, 0.328388, 0.327318, 0.325901,
+		0.259729, 0.360727, 0.271066, 0.342444, 0.340592, 0.336828,
+		0.147506, 0.316621, 0.319111, 0.324214, 0.325713, 0.329253,
+		0.326995, 0.266133, 0.361821, 0.277102, 0.341519, 0.339668,
+		0.335904, 0.332223, 0.147817, 0.316825, 0.322408, 0.324066,
+		0.327645, 0.329996, 0.274986, 0.363981, 0.285588, 0.342948,
+		0.341708, 0.338005, 0.334951, 0.332417, 0.144266, 0.318541,
+		0.320887, 0.327510, 0.330423, 0.286802, 0.366098, 0.294980,
+		0.343222, 0.346996, 0.343335, 0.340341, 0.338326, 0.334247,
+		0.146863, 0.321879, 0.329648, 0.332921, 0.302194, 0.378650,
+		0.313273, 0.340751, 0.343597, 0.344949, 0.341998, 0.340153,
+		0.336811, 0.337741, 0.145509, 0.330482, 0.335942, 0.308967,
+		0.382192, 0.336456, 0.342745, 0.342811, 0.343236, 0.345298,
+		0.343494, 0.343445, 0.345615, 0.346612, 0.136738, 0.346490,
+		0.341541, 0.395502, 0.355423, 0.325814, 0.340582, 0.341007,
+		0.342141, 0.345351, 0.345914, 0.348473, 0.351821, 0.363373,
+		0.122501, 0.368496, 0.418843, 0.383662, 0.242832, 0.264593,
+		0.269856, 0.276488, 0.285715, 0.298093, 0.314253, 0.321259,
+		0.355720, 0.384143, 0.105992, 0.439431, 0.474854, 0.366150,
+		0.378826, 0.379251, 0.380386, 0.382668, 0.384931, 0.398356,
+		0.402266, 0.416826, 0.442337, 0.463253, 0.298424, 0.505376,
+		0.257702, 0.279318, 0.284206, 0.290463, 0.299316, 0.309105,
+		0.328372, 0.353080, 0.372827, 0.402624, 0.500000, 0.531802,
+		0.340354,
+	},
+	{ // 32o
+		0.127861, 0.324313, 0.323475, 0.322637, 0.321799, 0.326357,
+		0.322252, 0.319950, 0.325341, 0.309874, 0.310720, 0.233091,
+		0.242421, 0.340230, 0.143096, 0.321294, 0.320456, 0.319618,
+		0.324737, 0.325242, 0.322098, 0.324654, 0.323759, 0.324604,
+		0.252962, 0.262087, 0.339305, 0.337454, 0.143028, 0.317022,
+		0.316184, 0.321361, 0.321905, 0.323371, 0.325085, 0.324190,
+		0.325035, 0.258078, 0.266835, 0.338381, 0.336529, 0.332766,
+		0.143087, 0.312831, 0.318589, 0.319191, 0.320696, 0.327020,
+		0.325283, 0.326128, 0.264482, 0.272872, 0.337456, 0.335605,
+		0.331841, 0.328160, 0.143398, 0.316303, 0.317385, 0.319048,
+		0.325412, 0.328285, 0.328288, 0.273336, 0.281357, 0.342403,
+		0.341163, 0.337460, 0.334406, 0.331873, 0.144228, 0.318019,
+		0.320365, 0.326988, 0.329901, 0.334514, 0.286741, 0.294395,
+		0.337961, 0.341735, 0.338074, 0.335080, 0.333065, 0.333702,
+		0.141183, 0.310045, 0.327075, 0.333655, 0.338308, 0.303293,
+		0.308523, 0.335497, 0.338342, 0.339695, 0.336743, 0.334898,
+		0.336272, 0.325300, 0.139841, 0.327929, 0.336695, 0.341810,
+		0.310213, 0.331714, 0.340644, 0.340570, 0.340994, 0.343056,
+		0.341253, 0.342907, 0.343101, 0.344125, 0.134652, 0.349227,
+		0.357197, 0.344111, 0.353905, 0.324141, 0.338910, 0.339335,
+		0.340469, 0.343679, 0.345375, 0.349541, 0.352916, 0.366501,
+		0.120985, 0.374732, 0.375259, 0.382484, 0.325109, 0.339878,
+		0.340302, 0.341437, 0.343719, 0.350429, 0.354636, 0.358510,
+		0.375197, 0.394476, 0.125813, 0.431101, 0.443836, 0.241126,
+		0.262887, 0.268150, 0.274782, 0.284009, 0.298114, 0.315584,
+		0.322750, 0.358610, 0.391596, 0.452275, 0.107966, 0.474842,
+		0.253202, 0.274754, 0.279643, 0.285899, 0.294752, 0.308481,
+		0.323363, 0.348077, 0.371323, 0.401498, 0.468198, 0.500000,
+		0.334233,
+	},
+	{ // 22
+		0.177833, 0.501067, 0.497856, 0.494644, 0.491433, 0.502973,
+		0.499762, 0.498801, 0.505080, 0.499019, 0.502237, 0.505456,
+		0.620358, 0.526492, 0.181874, 0.487415, 0.484204, 0.480993,
+		0.492572, 0.500431, 0.496256, 0.500662, 0.504923, 0.508142,
+		0.511360, 0.632563, 0.522983, 0.511750, 0.181526, 0.474520,
+		0.471308, 0.482927, 0.490826, 0.497721, 0.498912, 0.503173,
+		0.506392, 0.509610, 0.630799, 0.519474, 0.508242, 0.497716,
+		0.181305, 0.461616, 0.473274, 0.481212, 0.488147, 0.500408,
+		0.501455, 0.504673, 0.507891, 0.629066, 0.515965, 0.504733,
+		0.494207, 0.483671, 0.181336, 0.463613, 0.471591, 0.478565,
+		0.490866, 0.502982, 0.502986, 0.506204, 0.627364, 0.528469,
+		0.517278, 0.506794, 0.496300, 0.485796, 0.182174, 0.465697,
+		0.472711, 0.485051, 0.497207, 0.508281, 0.508285, 0.629796,
+		0.524961, 0.525771, 0.515329, 0.504877, 0.494415, 0.488023,
+		0.183519, 0.466329, 0.478709, 0.490904, 0.502017, 0.513091,
+		0.631744, 0.523958, 0.521256, 0.522815, 0.512405, 0.501984,
+		0.495635, 0.488707, 0.181324, 0.466994, 0.479654, 0.490807,
+		0.501920, 0.635288, 0.530656, 0.525940, 0.523987, 0.525578,
+		0.515199, 0.508892, 0.502005, 0.489427, 0.184433, 0.479786,
+		0.490980, 0.502133, 0.626891, 0.524351, 0.530478, 0.528525,
+		0.526604, 0.528226, 0.521960, 0.515116, 0.503009, 0.503230,
+		0.184271, 0.486539, 0.497734, 0.616637, 0.527903, 0.534030,
+		0.532077, 0.530156, 0.528266, 0.534001, 0.527199, 0.515133,
+		0.515396, 0.510783, 0.189396, 0.502658, 0.622253, 0.531479,
+		0.537606, 0.535653, 0.533732, 0.531842, 0.534066, 0.539264,
+		0.527240, 0.527545, 0.522975, 0.528293, 0.195705, 0.627912,
+		0.658201, 0.671499, 0.669516, 0.667565, 0.665645, 0.668245,
+		0.670311, 0.674108, 0.664872, 0.653581, 0.659646, 0.665767,
+		0.500000,
+	},
+}
+
+// preflopVsRandom[a][i] is the equity of StartingHand a all in preflop
+// against i+1 random hands.
+var preflopVsRandom = [NumStartingHands][MaxPreflopOpponents]float32{
+	{ // AA
+		0.852037, 0.733797, 0.637374, 0.559266, 0.489868, 0.434820,
+		0.386711, 0.347112, 0.309791,
+	},
+	{ // AKs
+		0.670446, 0.506536, 0.413489, 0.355070, 0.310978, 0.278278,
+		0.249629, 0.227142, 0.206557,
+	},
+	{ // AQs
+		0.662089, 0.493778, 0.397839, 0.336578, 0.292932, 0.259768,
+		0.232686, 0.212323, 0.192081,
+	},
+	{ // AJs
+		0.653927, 0.483087, 0.385655, 0.321566, 0.278792, 0.245847,
+		0.220424, 0.197649, 0.181486,
+	},
+	{ // ATs
+		0.646024, 0.469505, 0.371442, 0.307924, 0.267229, 0.235591,
+		0.210641, 0.190229, 0.173730,
+	},
+	{ // A9s
+		0.627812, 0.445420, 0.344110, 0.282847, 0.242290, 0.211052,
+		0.187511, 0.168609, 0.153861,
+	},
+	{ // A8s
+		0.619438, 0.434639, 0.335917, 0.272598, 0.232453, 0.201647,
+		0.178962, 0.161889, 0.147333,
+	},
+	{ // A7s
+		0.609840, 0.423280, 0.324702, 0.264414, 0.223571, 0.194662,
+		0.174570, 0.155940, 0.142601,
+	},
+	{ // A6s
+		0.599058, 0.410726, 0.313554, 0.252749, 0.216222, 0.188914,
+		0.168020, 0.152337, 0.138707,
+	},
+	{ // A5s
+		0.599229, 0.413956, 0.317300, 0.259527, 0.220872, 0.194863,
+		0.174506, 0.157637, 0.144350,
+	},
+	{ // A4s
+		0.590336, 0.404604, 0.310623, 0.253081, 0.216981, 0.191542,
+		0.171324, 0.155219, 0.142103,
+	},
+	{ // A3s
+		0.582203, 0.396449, 0.302162, 0.246800, 0.211635, 0.186357,
+		0.166405, 0.150987, 0.139100,
+	},
+	{ // A2s
+		0.573789, 0.387599, 0.293762, 0.240589, 0.206375, 0.182000,
+		0.163487, 0.148546, 0.134903,
+	},
+	{ // AKo
+		0.653201, 0.481259, 0.385426, 0.323451, 0.278481, 0.242940,
+		0.216164, 0.192254, 0.172514,
+	},
+	{ // KK
+		0.823957, 0.689410, 0.582270, 0.497610, 0.429300, 0.373722,
+		0.328664, 0.291151, 0.261743,
+	},
+	{ // KQs
+		0.634004, 0.470481, 0.382184, 0.325089, 0.282972, 0.251993,
+		0.226656, 0.203839, 0.186238,
+	},
+	{ // KJs
+		0.625673, 0.458938, 0.368345, 0.310223, 0.270681, 0.237692,
+		0.213391, 0.192936, 0.176216,
+	},
+	{ // KTs
+		0.617886, 0.446444, 0.355652, 0.298486, 0.258121, 0.227265,
+		0.204387, 0.184744, 0.169099,
+	},
+	{ // K9s
+		0.599885, 0.423694, 0.329809, 0.272674, 0.232155, 0.203454,
+		0.182037, 0.163183, 0.148024,
+	},
+	{ // K8s
+		0.583123, 0.401718, 0.307503, 0.251418, 0.213118, 0.185401,
+		0.164968, 0.148483, 0.134496,
+	},
+	{ // K7s
+		0.575377, 0.392729, 0.299294, 0.243420, 0.206764, 0.179727,
+		0.160293, 0.143940, 0.132290,
+	},
+	{ // K6s
+		0.566407, 0.383344, 0.290161, 0.235323, 0.200756, 0.174192,
+		0.154179, 0.139792, 0.127052,
+	},
+	{ // K5s
+		0.557929, 0.372862, 0.283285, 0.229064, 0.194075, 0.170867,
+		0.151738, 0.137107, 0.124964,
+	},
+	{ // K4s
+		0.548846, 0.363674, 0.275836, 0.223186, 0.190470, 0.166632,
+		0.148551, 0.133775, 0.122932,
+	},
+	{ // K3s
+		0.540550, 0.357299, 0.268928, 0.218208, 0.186672, 0.162607,
+		0.145949, 0.131985, 0.121171,
+	},
+	{ // K2s
+		0.532117, 0.349937, 0.262800, 0.213140, 0.182191, 0.159731,
+		0.143666, 0.130510, 0.119860,
+	},
+	{ // AQo
+		0.644318, 0.468282, 0.368950, 0.304413, 0.258783, 0.224029,
+		0.195934, 0.174331, 0.155075,
+	},
+	{ // KQo
+		0.614558, 0.444900, 0.351723, 0.293238, 0.250102, 0.217193,
+		0.191010, 0.169090, 0.151375,
+	},
+	{ // QQ
+		0.799252, 0.649287, 0.534651, 0.447544, 0.379496, 0.325383,
+		0.284833, 0.248472, 0.222921,
+	},
+	{ // QJs
+		0.602592, 0.442527, 0.357004, 0.302638, 0.262020, 0.231498,
+		0.208081, 0.189127, 0.172788,
+	},
+	{ // QTs
+		0.594676, 0.431107, 0.345613, 0.290754, 0.251262, 0.222244,
+		0.199829, 0.179787, 0.166005,
+	},
+	{ // Q9s
+		0.576643, 0.406231, 0.319052, 0.264387, 0.225528, 0.197400,
+		0.175999, 0.158627, 0.145703,
+	},
+	{ // Q8s
+		0.560177, 0.384414, 0.296679, 0.243734, 0.207957, 0.180926,
+		0.160908, 0.144231, 0.131936,
+	},
+	{ // Q7s
+		0.543023, 0.365300, 0.276729, 0.224565, 0.190137, 0.165174,
+		0.146713, 0.132502, 0.120466,
+	},
+	{ // Q6s
+		0.536126, 0.357825, 0.270519, 0.219129, 0.185212, 0.160672,
+		0.142351, 0.129394, 0.117813,
+	},
+	{ // Q5s
+		0.527694, 0.348643, 0.262147, 0.212924, 0.179926, 0.157562,
+		0.139242, 0.125375, 0.115288,
+	},
+	{ // Q4s
+		0.518553, 0.339573, 0.255048, 0.207169, 0.176124, 0.153296,
+		0.136154, 0.124012, 0.112999,
+	},
+	{ // Q3s
+		0.510192, 0.332374, 0.248659, 0.201454, 0.171041, 0.151114,
+		0.133646, 0.122500, 0.111584,
+	},
+	{ // Q2s
+		0.501690, 0.323589, 0.241276, 0.197250, 0.167330, 0.146630,
+		0.131533, 0.118971, 0.109759,
+	},
+	{ // AJo
+		0.635633, 0.455232, 0.352842, 0.287942, 0.243564, 0.209892,
+		0.183332, 0.160131, 0.143777,
+	},
+	{ // KJo
+		0.605687, 0.430463, 0.335888, 0.277320, 0.235751, 0.203134,
+		0.177414, 0.156702, 0.139763,
+	},
+	{ // QJo
+		0.581347, 0.412408, 0.325266, 0.269789, 0.227865, 0.197854,
+		0.172840, 0.154328, 0.137063,
+	},
+	{ // JJ
+		0.774695, 0.612291, 0.492659, 0.401906, 0.335163, 0.285702,
+		0.245934, 0.216391, 0.193089,
+	},
+	{ // JTs
+		0.575279, 0.420651, 0.338737, 0.285822, 0.247906, 0.219336,
+		0.197517, 0.179759, 0.165629,
+	},
+	{ // J9s
+		0.556625, 0.394880, 0.312697, 0.260423, 0.224170, 0.196741,
+		0.175529, 0.159238, 0.144983,
+	},
+	{ // J8s
+		0.540156, 0.373623, 0.290500, 0.240418, 0.204818, 0.179837,
+		0.160474, 0.144076, 0.132246,
+	},
+	{ // J7s
+		0.523248, 0.352734, 0.270282, 0.221973, 0.187147, 0.163978,
+		0.145295, 0.131661, 0.120587,
+	},
+	{ // J6s
+		0.506059, 0.333237, 0.252842, 0.205824, 0.173086, 0.150359,
+		0.134484, 0.120619, 0.109454,
+	},
+	{ // J5s
+		0.499868, 0.326568, 0.246324, 0.200363, 0.169304, 0.146394,
+		0.131460, 0.117404, 0.107905,
+	},
+	{ // J4s
+		0.490705, 0.318907, 0.240145, 0.193680, 0.163943, 0.143179,
+		0.127620, 0.115982, 0.106113,
+	},
+	{ // J3s
+		0.482316, 0.311337, 0.233500, 0.188185, 0.159993, 0.140493,
+		0.125809, 0.113231, 0.104937,
+	},
+	{ // J2s
+		0.473782, 0.303033, 0.226945, 0.185107, 0.157329, 0.137675,
+		0.122086, 0.112384, 0.102749,
+	},
+	{ // ATo
+		0.627217, 0.443982, 0.340336, 0.274424, 0.229784, 0.197396,
+		0.171774, 0.149993, 0.134442,
+	},
+	{ // KTo
+		0.597389, 0.419297, 0.323933, 0.264759, 0.222384, 0.190772,
+		0.166537, 0.146986, 0.131363,
+	},
+	{ // QTo
+		0.572908, 0.401957, 0.313091, 0.257575, 0.216331, 0.187463,
+		0.162576, 0.144182, 0.129073,
+	},
+	{ // JTo
+		0.552477, 0.390330, 0.307736, 0.254343, 0.215136, 0.184947,
+		0.163779, 0.145578, 0.131418,
+	},
+	{ // TT
+		0.750118, 0.575106, 0.452069, 0.363951, 0.299838, 0.252529,
+		0.217860, 0.190756, 0.172035,
+	},
+	{ // T9s
+		0.540275, 0.386477, 0.309571, 0.258990, 0.223367, 0.197589,
+		0.176937, 0.161130, 0.147467,
+	},
+	{ // T8s
+		0.523344, 0.367716, 0.288247, 0.240916, 0.206524, 0.180190,
+		0.161619, 0.146894, 0.135375,
+	},
+	{ // T7s
+		0.506390, 0.346630, 0.267406, 0.221167, 0.188439, 0.165720,
+		0.147951, 0.134152, 0.122456,
+	},
+	{ // T6s
+		0.489407, 0.326336, 0.251398, 0.203616, 0.173335, 0.150849,
+		0.134538, 0.122491, 0.111563,
+	},
+	{ // T5s
+		0.472163, 0.308673, 0.232711, 0.188253, 0.160506, 0.138461,
+		0.123761, 0.111385, 0.103152,
+	},
+	{ // T4s
+		0.465305, 0.302097, 0.226780, 0.185586, 0.155431, 0.136324,
+		0.121457, 0.109555, 0.101114,
+	},
+	{ // T3s
+		0.456925, 0.293896, 0.220527, 0.179379, 0.151715, 0.133683,
+		0.118692, 0.107152, 0.099241,
+	},
+	{ // T2s
+		0.448395, 0.286103, 0.215056, 0.174909, 0.148869, 0.130221,
+		0.117082, 0.106739, 0.096533,
+	},
+	{ // A9o
+		0.607728, 0.415680, 0.310809, 0.245559, 0.201486, 0.171091,
+		0.145846, 0.127225, 0.112209,
+	},
+	{ // K9o
+		0.578119, 0.391649, 0.294740, 0.235672, 0.194468, 0.164026,
+		0.141127, 0.122659, 0.108453,
+	},
+	{ // Q9o
+		0.553604, 0.376249, 0.284504, 0.227802, 0.189750, 0.160708,
+		0.138618, 0.120900, 0.107045,
+	},
+	{ // J9o
+		0.532512, 0.363538, 0.278435, 0.225337, 0.187478, 0.160071,
+		0.138904, 0.122536, 0.108112,
+	},
+	{ // T9o
+		0.515317, 0.356933, 0.275637, 0.224369, 0.189352, 0.161973,
+		0.141923, 0.125549, 0.113340,
+	},
+	{ // 99
+		0.720573, 0.536203, 0.411979, 0.326000, 0.265961, 0.224595,
+		0.193670, 0.172519, 0.155103,
+	},
+	{ // 98s
+		0.508008, 0.359724, 0.283137, 0.236251, 0.203153, 0.177404,
+		0.159130, 0.145856, 0.133236,
+	},
+	{ // 97s
+		0.491177, 0.339958, 0.266211, 0.221643, 0.188354, 0.166045,
+		0.148526, 0.135389, 0.125454,
+	},
+	{ // 96s
+		0.474283, 0.320883, 0.248511, 0.203295, 0.173626, 0.152008,
+		0.135660, 0.122817, 0.115288,
+	},
+	{ // 95s
+		0.457219, 0.302444, 0.231123, 0.187861, 0.160793, 0.139511,
+		0.124766, 0.112420, 0.103974,
+	},
+	{ // 94s
+		0.438620, 0.283887, 0.213748, 0.172531, 0.145497, 0.128028,
+		0.113361, 0.102384, 0.093808,
+	},
+	{ // 93s
+		0.432643, 0.278533, 0.208802, 0.168568, 0.142273, 0.125319,
+		0.110297, 0.100588, 0.092290,
+	},
+	{ // 92s
+		0.424152, 0.270906, 0.202844, 0.164744, 0.139346, 0.122463,
+		0.109020, 0.098904, 0.090712,
+	},
+	{ // A8o
+		0.598726, 0.403937, 0.299742, 0.234684, 0.192146, 0.161470,
+		0.137635, 0.119796, 0.104195,
+	},
+	{ // K8o
+		0.560202, 0.369513, 0.270737, 0.213611, 0.173983, 0.145937,
+		0.124612, 0.108105, 0.093932,
+	},
+	{ // Q8o
+		0.535998, 0.352009, 0.262097, 0.204996, 0.168842, 0.141977,
+		0.121489, 0.104483, 0.092420,
+	},
+	{ // J8o
+		0.514902, 0.341650, 0.255968, 0.203215, 0.166967, 0.141409,
+		0.121657, 0.106423, 0.094188,
+	},
+	{ // T8o
+		0.497213, 0.333467, 0.253952, 0.203655, 0.167707, 0.142989,
+		0.124972, 0.110665, 0.099049,
+	},
+	{ // 98o
+		0.480970, 0.328613, 0.249737, 0.199196, 0.167043, 0.142472,
+		0.123107, 0.109769, 0.098489,
+	},
+	{ // 88
+		0.691630, 0.499925, 0.376837, 0.295479, 0.240119, 0.201220,
+		0.177036, 0.157783, 0.144039,
+	},
+	{ // 87s
+		0.479363, 0.338674, 0.267148, 0.221181, 0.189290, 0.167534,
+		0.149941, 0.138566, 0.127972,
+	},
+	{ // 86s
+		0.462433, 0.318504, 0.249174, 0.205489, 0.176731, 0.156794,
+		0.140259, 0.127640, 0.119371,
+	},
+	{ // 85s
+		0.445450, 0.300123, 0.231673, 0.189444, 0.161593, 0.142715,
+		0.128376, 0.117697, 0.108688,
+	},
+	{ // 84s
+		0.427016, 0.282377, 0.214249, 0.175393, 0.148958, 0.130086,
+		0.116607, 0.107162, 0.098504,
+	},
+	{ // 83s
+		0.408735, 0.263107, 0.197816, 0.160654, 0.135496, 0.118723,
+		0.106816, 0.097145, 0.089317,
+	},
+	{ // 82s
+		0.402716, 0.258509, 0.194666, 0.156376, 0.132766, 0.117085,
+		0.104881, 0.095128, 0.087110,
+	},
+	{ // A7o
+		0.588412, 0.392140, 0.285780, 0.224281, 0.183373, 0.153842,
+		0.131283, 0.114567, 0.099538,
+	},
+	{ // K7o
+		0.551874, 0.359006, 0.261460, 0.204215, 0.166180, 0.138795,
+		0.118339, 0.102036, 0.089454,
+	},
+	{ // Q7o
+		0.517657, 0.329863, 0.238706, 0.185528, 0.150514, 0.124681,
+		0.106072, 0.091457, 0.080447,
+	},
+	{ // J7o
+		0.496819, 0.319820, 0.233389, 0.182373, 0.148704, 0.124279,
+		0.106084, 0.092585, 0.081067,
+	},
+	{ // T7o
+		0.479081, 0.311583, 0.231917, 0.183930, 0.150843, 0.126770,
+		0.110242, 0.095800, 0.086093,
+	},
+	{ // 97o
+		0.462978, 0.305978, 0.229977, 0.183049, 0.149799, 0.127849,
+		0.111239, 0.098132, 0.088255,
+	},
+	{ // 87o
+		0.450508, 0.304661, 0.231079, 0.184286, 0.152565, 0.131125,
+		0.114641, 0.101609, 0.092346,
+	},
+	{ // 77
+		0.662360, 0.464718, 0.344076, 0.267488, 0.217390, 0.185184,
+		0.164263, 0.148083, 0.136484,
+	},
+	{ // 76s
+		0.453718, 0.319713, 0.250502, 0.207549, 0.178729, 0.158058,
+		0.144068, 0.131224, 0.122465,
+	},
+	{ // 75s
+		0.436755, 0.302270, 0.234920, 0.193644, 0.167448, 0.147879,
+		0.135097, 0.123392, 0.114873,
+	},
+	{ // 74s
+		0.418493, 0.282038, 0.218378, 0.178901, 0.153257, 0.135792,
+		0.122822, 0.112520, 0.104481,
+	},
+	{ // 73s
+		0.400359, 0.263929, 0.200808, 0.163890, 0.139053, 0.123772,
+		0.111038, 0.102363, 0.093643,
+	},
+	{ // 72s
+		0.381559, 0.245211, 0.184038, 0.149558, 0.127575, 0.112862,
+		0.101246, 0.092686, 0.084972,
+	},
+	{ // A6o
+		0.576825, 0.379389, 0.276780, 0.215269, 0.173925, 0.146331,
+		0.124846, 0.107344, 0.095188,
+	},
+	{ // K6o
+		0.542233, 0.349469, 0.252584, 0.194935, 0.158462, 0.132551,
+		0.112400, 0.097992, 0.085478,
+	},
+	{ // Q6o
+		0.510241, 0.322630, 0.231610, 0.178802, 0.144343, 0.118739,
+		0.100704, 0.087398, 0.076183,
+	},
+	{ // J6o
+		0.478443, 0.297706, 0.213807, 0.165143, 0.132349, 0.108979,
+		0.092491, 0.079733, 0.069726,
+	},
+	{ // T6o
+		0.460920, 0.291753, 0.212352, 0.164584, 0.133736, 0.111075,
+		0.095562, 0.083271, 0.073514,
+	},
+	{ // 96o
+		0.444913, 0.285947, 0.210837, 0.165304, 0.133931, 0.112777,
+		0.098030, 0.086395, 0.077438,
+	},
+	{ // 86o
+		0.432409, 0.283163, 0.212738, 0.167858, 0.139109, 0.117674,
+		0.102628, 0.091956, 0.083348,
+	},
+	{ // 76o
+		0.423227, 0.284219, 0.214268, 0.170153, 0.141819, 0.121219,
+		0.107097, 0.095490, 0.087452,
+	},
+	{ // 66
+		0.632847, 0.432078, 0.314957, 0.245215, 0.200976, 0.172922,
+		0.153037, 0.140081, 0.130805,
+	},
+	{ // 65s
+		0.431334, 0.303433, 0.237438, 0.196361, 0.169733, 0.151649,
+		0.138621, 0.127069, 0.118797,
+	},
+	{ // 64s
+		0.413333, 0.284962, 0.221728, 0.183353, 0.159728, 0.141244,
+		0.129461, 0.119185, 0.110412,
+	},
+	{ // 63s
+		0.395336, 0.266284, 0.203800, 0.168449, 0.145311, 0.129702,
+		0.118866, 0.108864, 0.100708,
+	},
+	{ // 62s
+		0.376690, 0.247501, 0.189250, 0.155255, 0.132347, 0.117323,
+		0.106181, 0.097746, 0.089536,
+	},
+	{ // A5o
+		0.576965, 0.382910, 0.279338, 0.220179, 0.180218, 0.152097,
+		0.131542, 0.114405, 0.101398,
+	},
+	{ // K5o
+		0.533140, 0.339930, 0.243744, 0.188064, 0.152679, 0.127196,
+		0.108459, 0.094077, 0.082001,
+	},
+	{ // Q5o
+		0.501201, 0.312543, 0.222809, 0.171975, 0.138049, 0.114891,
+		0.097615, 0.084234, 0.073431,
+	},
+	{ // J5o
+		0.471809, 0.290538, 0.207508, 0.158700, 0.128176, 0.104711,
+		0.089532, 0.077339, 0.067968,
+	},
+	{ // T5o
+		0.442509, 0.269978, 0.193590, 0.147954, 0.119039, 0.098306,
+		0.083361, 0.072461, 0.064062,
+	},
+	{ // 95o
+		0.426691, 0.265579, 0.191858, 0.147253, 0.118773, 0.099608,
+		0.085041, 0.074714, 0.065671,
+	},
+	{ // 85o
+		0.414275, 0.264498, 0.192803, 0.151045, 0.122880, 0.104488,
+		0.090571, 0.080502, 0.071931,
+	},
+	{ // 75o
+		0.405120, 0.264964, 0.195689, 0.155257, 0.128754, 0.110102,
+		0.097119, 0.087153, 0.079310,
+	},
+	{ // 65o
+		0.399443, 0.267004, 0.198953, 0.158698, 0.132391, 0.114914,
+		0.101766, 0.091541, 0.084340,
+	},
+	{ // 55
+		0.603249, 0.400446, 0.288602, 0.224979, 0.185424, 0.161246,
+		0.144292, 0.132337, 0.123839,
+	},
+	{ // 54s
+		0.414534, 0.290039, 0.227805, 0.189163, 0.164683, 0.148418,
+		0.135474, 0.124981, 0.116405,
+	},
+	{ // 53s
+		0.396930, 0.272392, 0.211515, 0.176350, 0.153525, 0.137552,
+		0.126054, 0.116079, 0.108113,
+	},
+	{ // 52s
+		0.378493, 0.254353, 0.195143, 0.161399, 0.141289, 0.126328,
+		0.114022, 0.105643, 0.098326,
+	},
+	{ // A4o
+		0.567297, 0.371875, 0.271323, 0.212967, 0.173930, 0.147255,
+		0.127628, 0.111277, 0.099648,
+	},
+	{ // K4o
+		0.523275, 0.329502, 0.235327, 0.181507, 0.147611, 0.123584,
+		0.105068, 0.091072, 0.080325,
+	},
+	{ // Q4o
+		0.491277, 0.305157, 0.215651, 0.165541, 0.135040, 0.110890,
+		0.094619, 0.081505, 0.071606,
+	},
+	{ // J4o
+		0.461864, 0.281534, 0.199730, 0.152579, 0.122348, 0.102058,
+		0.085869, 0.074697, 0.065264,
+	},
+	{ // T4o
+		0.435041, 0.264722, 0.187547, 0.143943, 0.114482, 0.094866,
+		0.080396, 0.069525, 0.061561,
+	},
+	{ // 94o
+		0.406711, 0.244679, 0.173038, 0.130986, 0.105130, 0.086901,
+		0.073839, 0.063396, 0.056257,
+	},
+	{ // 84o
+		0.394468, 0.243011, 0.174302, 0.134139, 0.108985, 0.090398,
+		0.077952, 0.068100, 0.060329,
+	},
+	{ // 74o
+		0.385498, 0.244348, 0.178333, 0.139153, 0.114031, 0.096450,
+		0.084595, 0.075339, 0.068053,
+	},
+	{ // 64o
+		0.380105, 0.248592, 0.182553, 0.144141, 0.119499, 0.103313,
+		0.091215, 0.083611, 0.075291,
+	},
+	{ // 54o
+		0.381553, 0.253006, 0.187876, 0.151138, 0.126499, 0.109872,
+		0.099422, 0.088971, 0.081911,
+	},
+	{ // 44
+		0.570228, 0.367391, 0.262434, 0.205553, 0.172934, 0.152164,
+		0.138977, 0.129303, 0.121301,
+	},
+	{ // 43s
+		0.386419, 0.263265, 0.204842, 0.170327, 0.146953, 0.132471,
+		0.120793, 0.111216, 0.103839,
+	},
+	{ // 42s
+		0.368290, 0.246154, 0.188540, 0.157628, 0.136091, 0.122145,
+		0.111321, 0.102513, 0.096039,
+	},
+	{ // A3o
+		0.558446, 0.362581, 0.263854, 0.205966, 0.169470, 0.143230,
+		0.123547, 0.108563, 0.096363,
+	},
+	{ // K3o
+		0.514257, 0.322093, 0.228906, 0.176752, 0.142797, 0.118884,
+		0.102041, 0.088749, 0.077962,
+	},
+	{ // Q3o
+		0.482194, 0.295335, 0.207915, 0.160420, 0.129126, 0.107569,
+		0.091531, 0.079097, 0.069723,
+	},
+	{ // J3o
+		0.452755, 0.273534, 0.192745, 0.147032, 0.118302, 0.098663,
+		0.083992, 0.073001, 0.063999,
+	},
+	{ // T3o
+		0.425946, 0.255783, 0.180238, 0.137399, 0.110398, 0.091920,
+		0.077146, 0.067505, 0.059417,
+	},
+	{ // 93o
+		0.400195, 0.239858, 0.166323, 0.127115, 0.101679, 0.082990,
+		0.070504, 0.061388, 0.054285,
+	},
+	{ // 83o
+		0.374838, 0.225025, 0.156692, 0.118371, 0.095099, 0.078599,
+		0.066925, 0.057939, 0.051129,
+	},
+	{ // 73o
+		0.366023, 0.226279, 0.160018, 0.122255, 0.099875, 0.083590,
+		0.071573, 0.063708, 0.057471,
+	},
+	{ // 63o
+		0.360776, 0.228122, 0.163549, 0.127056, 0.105837, 0.090297,
+		0.079590, 0.070933, 0.064982,
+	},
+	{ // 53o
+		0.362648, 0.234189, 0.172587, 0.136809, 0.113656, 0.099188,
+		0.088928, 0.080276, 0.072589,
+	},
+	{ // 43o
+		0.351459, 0.225279, 0.164134, 0.129106, 0.108216, 0.092627,
+		0.083236, 0.074797, 0.068493,
+	},
+	{ // 33
+		0.536931, 0.335399, 0.240014, 0.190345, 0.162935, 0.145749,
+		0.134454, 0.126551, 0.119945,
+	},
+	{ // 32s
+		0.359844, 0.237901, 0.181086, 0.150209, 0.130444, 0.117330,
+		0.107173, 0.099231, 0.091765,
+	},
+	{ // A2o
+		0.549286, 0.352916, 0.253949, 0.198811, 0.162413, 0.138409,
+		0.119238, 0.104070, 0.092003,
+	},
+	{ // K2o
+		0.505087, 0.312200, 0.221776, 0.169595, 0.139758, 0.115780,
+		0.099906, 0.087096, 0.076877,
+	},
+	{ // Q2o
+		0.472954, 0.286395, 0.201928, 0.153570, 0.124289, 0.104648,
+		0.089130, 0.077723, 0.068043,
+	},
+	{ // J2o
+		0.443485, 0.264758, 0.186305, 0.142932, 0.113754, 0.094491,
+		0.080699, 0.070558, 0.062206,
+	},
+	{ // T2o
+		0.416684, 0.248464, 0.173432, 0.133237, 0.106523, 0.088522,
+		0.075449, 0.065363, 0.057069,
+	},
+	{ // 92o
+		0.390979, 0.230747, 0.161107, 0.122029, 0.097734, 0.080420,
+		0.068202, 0.058980, 0.052214,
+	},
+	{ // 82o
+		0.368277, 0.216898, 0.151874, 0.115141, 0.090519, 0.074835,
+		0.064960, 0.056199, 0.049600,
+	},
+	{ // 72o
+		0.345836, 0.204104, 0.142369, 0.107379, 0.085533, 0.071134,
+		0.061600, 0.053123, 0.047864,
+	},
+	{ // 62o
+		0.340751, 0.208591, 0.147331, 0.111886, 0.091478, 0.076791,
+		0.066908, 0.060272, 0.053750,
+	},
+	{ // 52o
+		0.342846, 0.214471, 0.153771, 0.120693, 0.099071, 0.086635,
+		0.076210, 0.068914, 0.062263,
+	},
+	{ // 42o
+		0.331998, 0.205810, 0.147599, 0.114541, 0.096010, 0.082665,
+		0.073571, 0.065941, 0.060252,
+	},
+	{ // 32o
+		0.323032, 0.197080, 0.139497, 0.108090, 0.089596, 0.077643,
+		0.068098, 0.061965, 0.055497,
+	},
+	{ // 22
+		0.503340, 0.307184, 0.219629, 0.176987, 0.154558, 0.141170,
+		0.132825, 0.124884, 0.119051,
+	},
+}
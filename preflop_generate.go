@@ -0,0 +1,14 @@
+//go:build preflop
+
+package cactuskev
+
+// The preflop tables of preflop_arrays.go take about 25 minutes to generate
+// on a single CPU, so a plain go generate leaves them alone. Regenerate them
+// with
+//
+//	go generate -tags preflop
+//
+// makepreflop.go imports this package by path, so it must be importable as
+// github.com/martinolsen/cactuskev-go, from a module cache or GOPATH.
+
+//go:generate go run makepreflop.go
@@ -0,0 +1,151 @@
+package cactuskev
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestStartingHand(t *testing.T) {
+	var count [NumStartingHands]int
+	Combinations(NewDeck(), 2, func(cards []Card) {
+		h := StartingHandOf([2]Card{cards[0], cards[1]})
+		if h >= NumStartingHands {
+			t.Fatalf("%v: unexpected starting hand %d", cards, h)
+		}
+		if g := StartingHandOf([2]Card{cards[1], cards[0]}); g != h {
+			t.Errorf("%v: starting hand depends on order of cards: %v and %v", cards, h, g)
+		}
+		count[h]++
+	})
+
+	for h := StartingHand(0); h < NumStartingHands; h++ {
+		combos := h.Combos()
+		if len(combos) != count[h] {
+			t.Errorf("%v: expected %d combos, got %d", h, count[h], len(combos))
+		}
+		for _, c := range combos {
+			if StartingHandOf(c) != h {
+				t.Errorf("%v: unexpected combo %v", h, c)
+			}
+		}
+
+		if g, err := ParseStartingHand(h.String()); err != nil || g != h {
+			t.Errorf("%v: parsed as %v, %v", h, g, err)
+		}
+	}
+
+	for s, want := range map[string]StartingHand{"AA": 0, "AKs": 1, "AKo": 13, "KAo": 13, "22": 168, "32s": 155, "32o": 167} {
+		if h := MustParseStartingHand(s); h != want {
+			t.Errorf("%s: expected %d, got %d", s, want, h)
+		}
+	}
+
+	for _, s := range []string{"AK", "AAs", "A", "AKx"} {
+		if _, err := ParseStartingHand(s); !errors.Is(err, ErrRange) {
+			t.Errorf("%s: expected %v, got %v", s, ErrRange, err)
+		}
+	}
+}
+
+func TestPreflopEquity(t *testing.T) {
+	for a := StartingHand(0); a < NumStartingHands; a++ {
+		for b := StartingHand(0); b < NumStartingHands; b++ {
+			if sum := preflopEquity(t, a, b) + preflopEquity(t, b, a); math.Abs(sum-1) > 1e-5 {
+				t.Fatalf("%v against %v: equities add up to %f", a, b, sum)
+			}
+		}
+
+		for o := 1; o < MaxPreflopOpponents; o++ {
+			if preflopEquityVsRandom(t, a, o+1) >= preflopEquityVsRandom(t, a, o) {
+				t.Errorf("%v: equity against %d hands not below against %d", a, o+1, o)
+			}
+		}
+	}
+
+	for _, test := range []struct {
+		hand, other string
+		want        float64
+	}{
+		{"AA", "KK", 0.8195},
+		{"AKs", "QQ", 0.4605},
+		{"AKo", "22", 0.4735},
+	} {
+		got := preflopEquity(t, MustParseStartingHand(test.hand), MustParseStartingHand(test.other))
+		if math.Abs(got-test.want) > 0.0005 {
+			t.Errorf("%s against %s: expected %.4f, got %.4f", test.hand, test.other, test.want, got)
+		}
+	}
+
+	for _, test := range []struct {
+		hand      string
+		opponents int
+		want      float64
+	}{
+		{"AA", 1, 0.8520},
+		{"72o", 1, 0.3458},
+		{"AA", 9, 0.3115},
+	} {
+		got := preflopEquityVsRandom(t, MustParseStartingHand(test.hand), test.opponents)
+		if math.Abs(got-test.want) > 0.003 {
+			t.Errorf("%s against %d: expected %.4f, got %.4f", test.hand, test.opponents, test.want, got)
+		}
+	}
+}
+
+func preflopEquity(t *testing.T, hand, other StartingHand) float64 {
+	t.Helper()
+
+	e, err := PreflopEquity(hand, other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func preflopEquityVsRandom(t *testing.T, hand StartingHand, opponents int) float64 {
+	t.Helper()
+
+	e, err := PreflopEquityVsRandom(hand, opponents)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func TestPreflopEquityErrors(t *testing.T) {
+	if _, err := PreflopEquity(0, NumStartingHands); !errors.Is(err, ErrIndex) {
+		t.Errorf("expected %v, got %v", ErrIndex, err)
+	}
+	if _, err := PreflopEquity(255, 0); !errors.Is(err, ErrIndex) {
+		t.Errorf("expected %v, got %v", ErrIndex, err)
+	}
+	if _, err := PreflopEquityVsRandom(NumStartingHands, 1); !errors.Is(err, ErrIndex) {
+		t.Errorf("expected %v, got %v", ErrIndex, err)
+	}
+	for _, n := range []int{0, MaxPreflopOpponents + 1} {
+		if _, err := PreflopEquityVsRandom(0, n); !errors.Is(err, ErrIndex) {
+			t.Errorf("%d opponents: expected %v, got %v", n, ErrIndex, err)
+		}
+	}
+}
+
+// TestPreflopEquityExhaustive checks a few entries of the heads-up table
+// against RangeEquity.
+func TestPreflopEquityExhaustive(t *testing.T) {
+	if testing.Short() {
+		t.Skip("deals every board")
+	}
+
+	for _, test := range [][2]string{{"KK", "AKs"}, {"T9s", "87s"}, {"A5o", "55"}} {
+		r, err := RangeEquity([]Range{MustParseRange(test[0]), MustParseRange(test[1])}, nil, nil, EquityOptions{MaxExhaustive: 1e9})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := r.Players[0].Equity
+		if got := preflopEquity(t, MustParseStartingHand(test[0]), MustParseStartingHand(test[1])); math.Abs(got-want) > 1e-6 {
+			t.Errorf("%s against %s: expected %f, got %f", test[0], test[1], want, got)
+		}
+	}
+}
@@ -0,0 +1,300 @@
+package cactuskev
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var ErrRange = errors.New("invalid range")
+
+// A Combo is one pair of hole cards, the higher ranked card first, with the
+// weight it is played at.
+type Combo struct {
+	Cards  [2]Card
+	Weight float64
+}
+
+// A Range is a set of hole card combos, such as the hands a player would
+// raise with.
+type Range []Combo
+
+// ParseRange parses a comma separated list of hands in the usual shorthand:
+//
+//	AA, AKs, AKo, AK    a pair, suited, offsuit or any two cards
+//	TT+, AQo+           the pair and every higher pair, or the hand and
+//	                    every higher kicker below the top card
+//	AA-TT, KJs-K9s      pairs or kickers in between, inclusive
+//	AhKh                specific cards
+//
+// Any of these may be followed by a weight, as in "AKs:0.5". When a combo is
+// listed more than once, the last weight given wins.
+func ParseRange(s string) (Range, error) {
+	var (
+		weights = make(map[[2]Card]float64)
+		order   [][2]Card
+		pos     int
+	)
+
+	for _, tok := range strings.Split(s, ",") {
+		start := pos + len(tok) - len(strings.TrimLeft(tok, " \t\n"))
+		pos += len(tok) + 1
+
+		tok = strings.TrimSpace(tok)
+		if tok == "" {
+			continue
+		}
+
+		combos, err := parseRangeToken(tok)
+		if err != nil {
+			return nil, &ParseError{Input: s, Token: tok, Pos: start, Err: err}
+		}
+
+		for _, c := range combos {
+			if _, ok := weights[c.Cards]; !ok {
+				order = append(order, c.Cards)
+			}
+			weights[c.Cards] = c.Weight
+		}
+	}
+
+	r := make(Range, 0, len(order))
+	for _, cards := range order {
+		if w := weights[cards]; w > 0 {
+			r = append(r, Combo{Cards: cards, Weight: w})
+		}
+	}
+
+	return r, nil
+}
+
+// MustParseRange is like ParseRange but panics on error.
+func MustParseRange(s string) Range {
+	r, err := ParseRange(s)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func parseRangeToken(tok string) ([]Combo, error) {
+	weight := 1.0
+	if i := strings.IndexByte(tok, ':'); i >= 0 {
+		w, err := strconv.ParseFloat(tok[i+1:], 64)
+		if err != nil || w < 0 || w > 1 {
+			return nil, fmt.Errorf("%w weight %q", ErrRange, tok[i+1:])
+		}
+		tok, weight = tok[:i], w
+	}
+
+	var hands []startingHand
+
+	switch {
+	case strings.Contains(tok, "-"):
+		i := strings.IndexByte(tok, '-')
+		from, err := parseStartingHand(tok[:i])
+		if err != nil {
+			return nil, err
+		}
+		to, err := parseStartingHand(tok[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		if hands = from.to(to); hands == nil {
+			return nil, fmt.Errorf("%w span %q", ErrRange, tok)
+		}
+
+	case strings.HasSuffix(tok, "+"):
+		from, err := parseStartingHand(strings.TrimSuffix(tok, "+"))
+		if err != nil {
+			return nil, err
+		}
+		hands = from.to(from.top())
+
+	default:
+		if utf8.RuneCountInString(tok) >= 4 {
+			cards, err := parseCards(tok)
+			if err != nil {
+				return nil, err
+			}
+			if len(cards) != 2 {
+				return nil, fmt.Errorf("%w: expected 2 cards, got %d", ErrRange, len(cards))
+			}
+			return []Combo{{Cards: orderCombo(cards[0], cards[1]), Weight: weight}}, nil
+		}
+
+		h, err := parseStartingHand(tok)
+		if err != nil {
+			return nil, err
+		}
+		hands = []startingHand{h}
+	}
+
+	var combos []Combo
+	for _, h := range hands {
+		for _, cards := range h.combos() {
+			combos = append(combos, Combo{Cards: cards, Weight: weight})
+		}
+	}
+
+	return combos, nil
+}
+
+// startingHand is a hand such as "AKs", before suits are given. Suited and
+// offsuit are both false for a pair, or for either suitedness.
+type startingHand struct {
+	high, low       Rank
+	suited, offsuit bool
+}
+
+func parseStartingHand(s string) (startingHand, error) {
+	var (
+		h     startingHand
+		ranks []Rank
+	)
+
+	for _, r := range s {
+		if rank, ok := rankRunes[unicode.ToUpper(r)]; ok && len(ranks) < 2 {
+			ranks = append(ranks, rank)
+			continue
+		}
+
+		switch {
+		case len(ranks) == 2 && !h.suited && !h.offsuit && (r == 's' || r == 'S'):
+			h.suited = true
+		case len(ranks) == 2 && !h.suited && !h.offsuit && (r == 'o' || r == 'O'):
+			h.offsuit = true
+		default:
+			return h, fmt.Errorf("%w hand %q", ErrRange, s)
+		}
+	}
+
+	if len(ranks) != 2 {
+		return h, fmt.Errorf("%w hand %q", ErrRange, s)
+	}
+
+	h.high, h.low = ranks[0], ranks[1]
+	if h.low > h.high {
+		h.high, h.low = h.low, h.high
+	}
+
+	if h.high == h.low && (h.suited || h.offsuit) {
+		return h, fmt.Errorf("%w hand %q", ErrRange, s)
+	}
+
+	return h, nil
+}
+
+func (h startingHand) pair() bool { return h.high == h.low }
+
+// top returns the best hand of the same kind: aces for a pair, or the highest
+// kicker below the top card.
+func (h startingHand) top() startingHand {
+	if h.pair() {
+		h.high, h.low = Ace, Ace
+	} else {
+		h.low = h.high - 1
+	}
+	return h
+}
+
+// to lists the hands from h to other, inclusive. Both must be pairs, or share
+// their top card and suitedness; otherwise it returns nil.
+func (h startingHand) to(other startingHand) []startingHand {
+	var hands []startingHand
+
+	switch {
+	case h.pair() && other.pair():
+		lo, hi := h.high, other.high
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+		for r := lo; r <= hi; r++ {
+			hands = append(hands, startingHand{high: r, low: r})
+		}
+
+	case !h.pair() && !other.pair() && h.high == other.high &&
+		h.suited == other.suited && h.offsuit == other.offsuit:
+		lo, hi := h.low, other.low
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+		for r := lo; r <= hi; r++ {
+			g := h
+			g.low = r
+			hands = append(hands, g)
+		}
+	}
+
+	return hands
+}
+
+func (h startingHand) combos() [][2]Card {
+	var combos [][2]Card
+
+	for i, s := range suits {
+		for j, t := range suits {
+			switch {
+			case h.pair() && j <= i:
+			case !h.pair() && h.suited && i != j:
+			case !h.pair() && h.offsuit && i == j:
+			default:
+				combos = append(combos, orderCombo(NewCard(s, h.high), NewCard(t, h.low)))
+			}
+		}
+	}
+
+	return combos
+}
+
+// orderCombo puts the card of higher rank, or for a pair of higher suit
+// index, first.
+func orderCombo(a, b Card) [2]Card {
+	if a.Rank() < b.Rank() || a.Rank() == b.Rank() && a.Index() < b.Index() {
+		a, b = b, a
+	}
+	return [2]Card{a, b}
+}
+
+// Remove returns the combos of r that share no card with cards.
+func (r Range) Remove(cards ...Card) Range {
+	var (
+		out  Range
+		dead = FromCards(cards...)
+	)
+
+	for _, c := range r {
+		if comboMask(c.Cards)&dead == 0 {
+			out = append(out, c)
+		}
+	}
+
+	return out
+}
+
+// Weight returns the sum of the weights of r's combos.
+func (r Range) Weight() float64 {
+	var w float64
+	for _, c := range r {
+		w += c.Weight
+	}
+	return w
+}
+
+// String lists the combos of r in a form ParseRange accepts.
+func (r Range) String() string {
+	var s []string
+	for _, c := range r {
+		a, _ := c.Cards[0].MarshalText()
+		b, _ := c.Cards[1].MarshalText()
+		tok := string(a) + string(b)
+		if c.Weight != 1 {
+			tok += ":" + strconv.FormatFloat(c.Weight, 'g', -1, 64)
+		}
+		s = append(s, tok)
+	}
+	return strings.Join(s, ", ")
+}
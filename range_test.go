@@ -0,0 +1,216 @@
+package cactuskev
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseRange(t *testing.T) {
+	for _, tc := range []struct {
+		in     string
+		combos int
+	}{
+		{"AA", 6},
+		{"AKs", 4},
+		{"AKo", 12},
+		{"AK", 16},
+		{"AA-TT", 30},
+		{"TT-AA", 30},
+		{"QQ+", 18},
+		{"AQo+", 24},
+		{"KJs-K9s", 12},
+		{"76s", 4},
+		{"AhKh", 1},
+		{"AA-TT, AKs, AQo+, KJs-K9s, 76s", 30 + 4 + 24 + 12 + 4},
+		{"AKs, AK", 16},
+		{"AA, AsAh:0", 5},
+		{"", 0},
+	} {
+		r, err := ParseRange(tc.in)
+		if err != nil {
+			t.Errorf("%q: %v", tc.in, err)
+			continue
+		}
+		if len(r) != tc.combos {
+			t.Errorf("%q: expected %d combos, got %d: %v", tc.in, tc.combos, len(r), r)
+		}
+	}
+}
+
+func TestParseRangeCombos(t *testing.T) {
+	r := MustParseRange("T9s")
+	for _, c := range r {
+		if c.Cards[0].Rank() != Ten || c.Cards[1].Rank() != Nine || c.Cards[0].Suit() != c.Cards[1].Suit() {
+			t.Errorf("unexpected combo %v", c.Cards)
+		}
+	}
+
+	r = MustParseRange("KhAh:0.5, AKs")
+	if len(r) != 4 || r[0].Cards != [2]Card{NewCard(Heart, Ace), NewCard(Heart, King)} || r[0].Weight != 1 {
+		t.Errorf("expected the later weight to win, got %v", r)
+	}
+
+	r = MustParseRange("AhKh:0.5")
+	if s := r.String(); s != "AhKh:0.5" {
+		t.Errorf("unexpected String %q", s)
+	}
+	if w := MustParseRange("AA:0.5, KK").Weight(); w != 9 {
+		t.Errorf("expected weight 9, got %v", w)
+	}
+}
+
+func TestParseRangeErrors(t *testing.T) {
+	for _, tc := range []struct {
+		in, token string
+		pos       int
+	}{
+		{"AA, AKx", "AKx", 4},
+		{"AA,  QQ-AKs", "QQ-AKs", 5},
+		{"AKs-QJs", "AKs-QJs", 0},
+		{"AAs", "AAs", 0},
+		{"AK:2", "AK:2", 0},
+		{"AA, AhAh", "AhAh", 4},
+		{"AhKhQh", "AhKhQh", 0},
+	} {
+		_, err := ParseRange(tc.in)
+
+		var perr *ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("%q: expected ParseError, got %v", tc.in, err)
+			continue
+		}
+		if perr.Token != tc.token || perr.Pos != tc.pos {
+			t.Errorf("%q: expected %q at %d, got %q at %d", tc.in, tc.token, tc.pos, perr.Token, perr.Pos)
+		}
+	}
+}
+
+func TestRangeRemove(t *testing.T) {
+	r := MustParseRange("AA, AKs").Remove(mustParse(t, "As Kh")...)
+	if len(r) != 3+2 {
+		t.Errorf("expected 5 combos, got %v", r)
+	}
+}
+
+func TestRangeEquity(t *testing.T) {
+	opts := EquityOptions{Workers: 2}
+	board := mustParse(t, "Tc Jc 2d")
+
+	a, err := Equity(holes(t, "AsAh", "8c9c"), board, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := RangeEquity([]Range{MustParseRange("AsAh"), MustParseRange("9c8c")}, board, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for p := range a.Players {
+		if math.Abs(a.Players[p].Equity-b.Players[p].Equity) > 1e-12 {
+			t.Errorf("player %d: expected %v, got %v", p, a.Players[p], b.Players[p])
+		}
+	}
+
+	// Every combo of kings has as many runouts against AA, so the equity
+	// against a weighted range of kings is the weighted average of the
+	// equities against each combo.
+	aa := MustParseRange("AsAh")
+	kings := MustParseRange("KK, KdKc:0.25")
+	r, err := RangeEquity([]Range{aa, kings}, board, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Exhaustive || r.Runouts != 6*45*44/2 {
+		t.Errorf("expected 6 exhaustive holdings, got %+v", r)
+	}
+	var sum, weights float64
+	for _, c := range kings {
+		one, err := Equity([][2]Card{aa[0].Cards, c.Cards}, board, nil, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		e := one.Players[0].Equity
+		if e < 0.8 || e > 1 {
+			t.Errorf("unexpected equity %v against %v", e, c.Cards)
+		}
+		sum += c.Weight * e
+		weights += c.Weight
+	}
+	if want := sum / weights; math.Abs(r.Players[0].Equity-want) > 1e-9 {
+		t.Errorf("expected weighted equity %v, got %v", want, r.Players[0].Equity)
+	}
+
+	// Combos on the board are removed, and a range entirely on the board
+	// is an error.
+	if _, err := RangeEquity([]Range{aa, MustParseRange("TcJc")}, board, nil, opts); err != ErrHoldings {
+		t.Errorf("expected ErrHoldings, got %v", err)
+	}
+	if _, err := RangeEquity([]Range{aa, MustParseRange("AhKh")}, nil, nil, opts); err != ErrHoldings {
+		t.Errorf("expected ErrHoldings, got %v", err)
+	}
+}
+
+func TestRangeEquityMonteCarlo(t *testing.T) {
+	ranges := []Range{MustParseRange("QQ+, AKs"), MustParseRange("22+, A2s+, KTo+")}
+	opts := EquityOptions{MaxExhaustive: 1, Trials: 30000, Seed: 7, Workers: 1}
+
+	a, err := RangeEquity(ranges, nil, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts.Workers = 3
+	b, err := RangeEquity(ranges, nil, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.Exhaustive || a.Runouts != 30000 {
+		t.Errorf("expected 30000 random runouts, got %+v", a)
+	}
+	for p := range a.Players {
+		if a.Players[p] != b.Players[p] {
+			t.Errorf("same seed gave different results: %+v, %+v", a, b)
+		}
+	}
+	if e := a.Players[0].Equity; e < 0.6 || e > 0.8 {
+		t.Errorf("unexpected equity for the tight range: %v", e)
+	}
+}
+
+func TestRangeEquityOverlapping(t *testing.T) {
+	opts := EquityOptions{MaxExhaustive: 1, Trials: 20000, Seed: 3}
+
+	// Only AsAh against AdAc shares no card.
+	r, err := RangeEquity([]Range{MustParseRange("AsAh, AsAd"), MustParseRange("AsAh, AsAd, AhAd, AdAc")}, nil, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Runouts != opts.Trials || math.Abs(r.Players[0].Equity-0.5) > 0.01 {
+		t.Errorf("unexpected result %+v", r)
+	}
+
+	// Too many combinations to list, nearly all of which pick AsAh twice.
+	var wide Range
+	for _, c := range MustParseRange("AsAh, 22+, A2s+, K2s+, Q2s+, ATo+") {
+		if c.Cards != [2]Card{NewCard(Spade, Ace), NewCard(Heart, Ace)} {
+			c.Weight = 1e-6
+		}
+		wide = append(wide, c)
+	}
+	opts.Trials = 2000
+	for _, workers := range []int{1, 3} {
+		opts.Workers = workers
+		r, err := RangeEquity([]Range{wide, wide}, nil, nil, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Runouts == 0 || r.Runouts >= opts.Trials {
+			t.Errorf("expected some of %d trials to give up, got %d runouts", opts.Trials, r.Runouts)
+		}
+	}
+
+	if _, err := RangeEquity([]Range{MustParseRange("AA"), MustParseRange("AA"), MustParseRange("AA")}, nil, nil, opts); !errors.Is(err, ErrHoldings) {
+		t.Errorf("expected %v, got %v", ErrHoldings, err)
+	}
+}
@@ -0,0 +1,168 @@
+package cactuskev
+
+import (
+	"math/bits"
+	"sync"
+)
+
+// FastSevenCardHand is a SevenCardHand evaluated by direct table lookup
+// instead of trying each of its 21 five-card combinations. It produces the
+// same Score as SevenCardHand.
+type FastSevenCardHand struct{ SevenCardHand }
+
+func NewFastSevenCardHand() *FastSevenCardHand { return new(FastSevenCardHand) }
+
+func (h *FastSevenCardHand) Eval() Score {
+	return EvalSeven(h.A, h.B, h.C, h.D, h.E, h.F, h.G)
+}
+
+// EvalSeven scores the best five-card hand of seven cards.
+//
+// At most one suit can hold five or more of seven cards, and when one does
+// no full house or four of a kind is possible, so the hand is the best flush
+// in that suit, looked up by its rank bits. Otherwise suits do not matter,
+// and the hand is looked up by how many cards it has of each rank.
+//
+// A Joker is wild, and the hand scores as with Wild.Eval.
+func EvalSeven(a, b, c, d, e, f, g Card) Score {
+	seven.once.Do(seven.init)
+
+	var (
+		suits  uint64 // four bits per suit, at 4 << suit bit
+		counts [13]uint8
+		cards  = [...]Card{a, b, c, d, e, f, g}
+	)
+
+	if (a|b|c|d|e|f|g)&Joker != 0 {
+		s, _, _ := Wild{}.Eval(cards[:])
+		return s
+	}
+
+	for _, c := range cards {
+		suits += 1 << (c >> 10 & 0x3c)
+		counts[c>>8&0xf]++
+	}
+
+	for _, s := range [...]Card{1, 2, 4, 8} {
+		if suits>>(s<<2)&0xf < 5 {
+			continue
+		}
+
+		var flush int
+		for _, c := range cards {
+			if c>>12&0xf == s {
+				flush |= c.Bit()
+			}
+		}
+
+		return seven.flushes[flush]
+	}
+
+	return seven.ranks[quinaryHash(&counts)]
+}
+
+var seven sevenTables
+
+type sevenTables struct {
+	once sync.Once
+
+	// flushes holds the best flush, or straight flush, of 5 to 7 cards of
+	// the same suit, indexed by their rank bits.
+	flushes [1 << 13]Score
+
+	// ranks holds the best non-flush hand of seven cards, indexed by the
+	// quinaryHash of their rank counts.
+	ranks [49205]Score
+}
+
+// quinaryOffsets[q][n][k] is the number of sequences of n+1 rank counts
+// (each 0 to 4) summing to k, whose first count is less than q. Summing it
+// along a sequence ranks the sequence among all those of the same length
+// and sum, which gives a dense index into seven.ranks.
+var quinaryOffsets = func() (t [5][13][8]uint16) {
+	var ways [14][8]int // ways[n][k]: sequences of n counts summing to k
+	ways[0][0] = 1
+	for n := 1; n < len(ways); n++ {
+		for k := range ways[n] {
+			for q := 0; q <= 4 && q <= k; q++ {
+				ways[n][k] += ways[n-1][k-q]
+			}
+		}
+	}
+
+	for q := 1; q < len(t); q++ {
+		for n := range t[q] {
+			for k := range t[q][n] {
+				var sum int
+				for d := 0; d < q && d <= k; d++ {
+					sum += ways[n][k-d]
+				}
+				t[q][n][k] = uint16(sum)
+			}
+		}
+	}
+
+	return t
+}()
+
+func quinaryHash(counts *[13]uint8) int {
+	var (
+		sum int
+		k   = 7
+	)
+
+	for i, q := range counts {
+		sum += int(quinaryOffsets[q][len(counts)-i-1][k])
+		if k -= int(q); k <= 0 {
+			break
+		}
+	}
+
+	return sum
+}
+
+func (t *sevenTables) init() {
+	for b := range t.flushes {
+		switch n := bits.OnesCount(uint(b)); {
+		case n == 5:
+			t.flushes[b] = Flushes[b]
+		case n > 5:
+			best := Score(9999)
+			for rest := b; rest != 0; rest &= rest - 1 {
+				if q := t.flushes[b&^(rest&-rest)]; best.Less(q) {
+					best = q
+				}
+			}
+			t.flushes[b] = best
+		}
+	}
+
+	var (
+		counts [13]uint8
+		cards  = make([]Card, 0, 7)
+		fill   func(r, k int)
+	)
+
+	// Enumerate every way of holding seven cards by rank. Suits are dealt
+	// round robin, so that no suit holds more than two cards and no two
+	// cards of a rank share a suit.
+	fill = func(r, k int) {
+		if r == len(counts) {
+			if k == 0 {
+				t.ranks[quinaryHash(&counts)], _ = EvalBest(cards)
+			}
+			return
+		}
+
+		for q := 0; q <= 4 && q <= k; q++ {
+			counts[r] = uint8(q)
+			for i := 0; i < q; i++ {
+				cards = append(cards, NewCard(suits[len(cards)%len(suits)], Rank(r)))
+			}
+			fill(r+1, k-q)
+			cards = cards[:len(cards)-q]
+		}
+		counts[r] = 0
+	}
+	fill(0, 7)
+}
@@ -0,0 +1,42 @@
+package cactuskev
+
+import (
+	"testing"
+)
+
+func BenchmarkFastSevenHand(b *testing.B) {
+	h := NewFastSevenCardHand()
+	RandomizeHand(h)
+	bench(b, h)
+}
+
+func TestEvalSeven(t *testing.T) {
+	for i := 0; i < 100000; i++ {
+		h := NewFastSevenCardHand()
+		RandomizeHand(h)
+
+		if s, want := h.Eval(), h.SevenCardHand.Eval(); s != want {
+			t.Fatalf("%v: expected %v, got %v", h, want, s)
+		}
+	}
+}
+
+func TestAllSevenFast(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping exhaustive seven-card test in short mode")
+	}
+
+	AllSeven(t,
+		func() Hand { return NewFastSevenCardHand() },
+		func(h Hand) Hand { return h })
+}
+
+func TestCompareSeven(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping exhaustive seven-card test in short mode")
+	}
+
+	CompareSeven(t,
+		func() Hand { return NewFastSevenCardHand() },
+		func() Hand { return NewSevenCardHand() })
+}
@@ -0,0 +1,140 @@
+package cactuskev
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ShortDeckScore ranks a Short Deck (6+) hand, played with the 36 cards of
+// NewShortDeck, from 1 for a royal flush to 1404 for J-9-8-7-6. A flush beats
+// a full house, and A-6-7-8-9 is the lowest straight.
+type ShortDeckScore int16
+
+// Less reports whether s is a worse hand than other under the usual rules,
+// where a straight beats three of a kind. See ShortDeckRules for others.
+func (s ShortDeckScore) Less(other ShortDeckScore) bool {
+	return s > other
+}
+
+// Category returns the category of s, which does not depend on the
+// ShortDeckRules; only the order of the categories does.
+func (s ShortDeckScore) Category() Category {
+	switch {
+	case s > 1284:
+		return HighCard
+	case s > 780:
+		return OnePair
+	case s > 528:
+		return TwoPair
+	case s > 276:
+		return ThreeOfAKind
+	case s > 270:
+		return Straight
+	case s > 198:
+		return FullHouse
+	case s > 78:
+		return Flush
+	case s > 6:
+		return FourOfAKind
+	default:
+		return StraightFlush
+	}
+}
+
+func (s ShortDeckScore) String() string {
+	return fmt.Sprintf("%v(%d)", s.Category(), s)
+}
+
+// ShortDeckRules are the rules Short Deck hands are compared by. The zero
+// value is the usual rules.
+type ShortDeckRules struct {
+	// TripsBeatStraight ranks three of a kind above a straight.
+	TripsBeatStraight bool
+}
+
+// Categories lists the categories of Short Deck hands, best first.
+func (r ShortDeckRules) Categories() []Category {
+	if r.TripsBeatStraight {
+		return []Category{StraightFlush, FourOfAKind, Flush, FullHouse, ThreeOfAKind, Straight, TwoPair, OnePair, HighCard}
+	}
+	return []Category{StraightFlush, FourOfAKind, Flush, FullHouse, Straight, ThreeOfAKind, TwoPair, OnePair, HighCard}
+}
+
+// Less reports whether s is a worse hand than other under r.
+func (r ShortDeckRules) Less(s, other ShortDeckScore) bool {
+	return r.order(s) > r.order(other)
+}
+
+// order moves the 6 straights below the 252 three of a kinds if r says so.
+func (r ShortDeckRules) order(s ShortDeckScore) int {
+	switch {
+	case !r.TripsBeatStraight:
+	case s > 270 && s <= 276:
+		return int(s) + 252
+	case s > 276 && s <= 528:
+		return int(s) - 6
+	}
+	return int(s)
+}
+
+// EvalShortDeck returns the Short Deck score of the hand, or an error if it
+// holds a card below a Six.
+func (h *FiveCardHand) EvalShortDeck() (ShortDeckScore, error) {
+	if h.Bit()&0xf != 0 {
+		for _, c := range h.Cards() {
+			if c.Rank() < Six {
+				return 0, fmt.Errorf("%w: %v in short deck", ErrRank, c)
+			}
+		}
+	}
+
+	return h.evalShortDeck(), nil
+}
+
+// evalShortDeck is EvalShortDeck for a hand known to hold no card below a
+// Six.
+func (h *FiveCardHand) evalShortDeck() ShortDeckScore {
+	// Flushes and Straight Flushes
+	if h.IsSuited() {
+		return ShortDeckFlushes[h.Bit()]
+	}
+
+	// Straights and High Cards
+	if s := ShortDeck5[h.Bit()]; s != 0 {
+		return s
+	}
+
+	// and others...
+	return shortDeckValues[sort.SearchInts(shortDeckProducts[:], int((h.A&0xff)*(h.B&0xff)*(h.C&0xff)*(h.D&0xff)*(h.E&0xff)))]
+}
+
+// ShortDeckEval evaluates every five-card combination of 5 to 9 cards by the
+// rules r, and returns the best score along with the five cards that make
+// it. It returns an error for other numbers of cards, or if a card is below
+// a Six.
+func ShortDeckEval(cards []Card, r ShortDeckRules) (ShortDeckScore, [5]Card, error) {
+	if n := len(cards); n < 5 || n >= len(combinations) {
+		return 0, [5]Card{}, fmt.Errorf("hand of %d cards not supported", n)
+	}
+	for _, c := range cards {
+		if c.Rank() < Six {
+			return 0, [5]Card{}, fmt.Errorf("%w: %v in short deck", ErrRank, c)
+		}
+	}
+
+	var (
+		h    FiveCardHand
+		best = ShortDeckScore(9999)
+		five [5]Card
+	)
+
+	for _, c := range combinations[len(cards)] {
+		h.A, h.B, h.C, h.D, h.E = cards[c[0]], cards[c[1]], cards[c[2]], cards[c[3]], cards[c[4]]
+
+		if q := h.evalShortDeck(); r.Less(best, q) {
+			best, five = q, [5]Card{h.A, h.B, h.C, h.D, h.E}
+		}
+	}
+
+	return best, five, nil
+}
@@ -0,0 +1,201 @@
+package cactuskev
+
+import (
+	"errors"
+	"sort"
+	"testing"
+)
+
+func TestNewShortDeck(t *testing.T) {
+	d := NewShortDeck()
+	if d.Len() != 36 {
+		t.Fatalf("expected 36 cards, got %d", d.Len())
+	}
+
+	seen := make(map[Card]bool)
+	for _, c := range d {
+		if c.Rank() < Six || !c.Valid() || seen[c] {
+			t.Errorf("unexpected card %v", c)
+		}
+		seen[c] = true
+	}
+
+	if d[0] != NewCard(Club, Six) || d[35] != NewCard(Spade, Ace) {
+		t.Errorf("unexpected order %v", d)
+	}
+}
+
+// shortDeckKey ranks a Short Deck hand the slow way, lower being better.
+func shortDeckKey(h *FiveCardHand) (key int, cat Category) {
+	var counts [13]int
+	for _, c := range h.Cards() {
+		counts[c.Rank()]++
+	}
+
+	var rs []int
+	for r := range counts {
+		if counts[r] > 0 {
+			rs = append(rs, r)
+		}
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		if counts[rs[i]] != counts[rs[j]] {
+			return counts[rs[i]] > counts[rs[j]]
+		}
+		return rs[i] > rs[j]
+	})
+
+	straight := len(rs) == 5 && rs[0]-rs[4] == 4
+	if len(rs) == 5 && rs[0] == int(Ace) && rs[1] == int(Nine) && rs[4] == int(Six) {
+		straight, rs = true, append(rs[1:], -1)
+	}
+	flush := h.IsSuited()
+
+	order := ShortDeckRules{}.Categories()
+	switch {
+	case straight && flush:
+		cat = StraightFlush
+	case counts[rs[0]] == 4:
+		cat = FourOfAKind
+	case flush:
+		cat = Flush
+	case counts[rs[0]] == 3 && counts[rs[1]] == 2:
+		cat = FullHouse
+	case straight:
+		cat = Straight
+	case counts[rs[0]] == 3:
+		cat = ThreeOfAKind
+	case counts[rs[0]] == 2 && counts[rs[1]] == 2:
+		cat = TwoPair
+	case counts[rs[0]] == 2:
+		cat = OnePair
+	default:
+		cat = HighCard
+	}
+
+	for key = range order {
+		if order[key] == cat {
+			break
+		}
+	}
+	for _, r := range rs {
+		key = key*14 + 12 - r
+	}
+	for i := len(rs); i < 5; i++ {
+		key *= 14
+	}
+
+	return key, cat
+}
+
+func TestShortDeckAllHands(t *testing.T) {
+	var (
+		deck   = NewShortDeck()
+		scores = make(map[ShortDeckScore]int)
+		h      FiveCardHand
+	)
+
+	for a := 0; a < 32; a++ {
+		for b := a + 1; b < 33; b++ {
+			for c := b + 1; c < 34; c++ {
+				for d := c + 1; d < 35; d++ {
+					for e := d + 1; e < 36; e++ {
+						h.A, h.B, h.C, h.D, h.E = deck[a], deck[b], deck[c], deck[d], deck[e]
+
+						s, err := h.EvalShortDeck()
+						if err != nil {
+							t.Fatal(err)
+						}
+						key, cat := shortDeckKey(&h)
+						if s.Category() != cat {
+							t.Fatalf("%v: expected %v, got %v", &h, cat, s)
+						}
+						if k, ok := scores[s]; ok && k != key {
+							t.Fatalf("%v: %v is shared by unequal hands", &h, s)
+						}
+						scores[s] = key
+					}
+				}
+			}
+		}
+	}
+
+	if len(scores) != 1404 {
+		t.Fatalf("expected 1404 scores, got %d", len(scores))
+	}
+	for s := ShortDeckScore(2); s <= 1404; s++ {
+		if scores[s-1] >= scores[s] {
+			t.Errorf("%v is not worse than %v", s, s-1)
+		}
+	}
+}
+
+func TestShortDeckEval(t *testing.T) {
+	for _, tc := range []struct {
+		cards string
+		rules ShortDeckRules
+		want  Category
+	}{
+		{"Ah 6c 7d 8s 9h Kc Kd", ShortDeckRules{}, Straight},
+		{"Ah 6c 7d 8s 9h Kc Kd", ShortDeckRules{TripsBeatStraight: true}, Straight},
+		{"Ah 6c 7d 8s 9h Kc Kh", ShortDeckRules{}, Straight},
+		{"Ah 6c 7d 8s 9h Ac As", ShortDeckRules{}, Straight},
+		{"Ah 6c 7d 8s 9h Ac As", ShortDeckRules{TripsBeatStraight: true}, ThreeOfAKind},
+		{"Ah Kh 7h 8h Jh Ac As", ShortDeckRules{}, Flush},
+		{"Ah Kh 7h 8h Jh Ac As Kd", ShortDeckRules{}, Flush},
+		{"Ah 6h 7h 8h 9h", ShortDeckRules{}, StraightFlush},
+	} {
+		s, five, err := ShortDeckEval(mustParse(t, tc.cards), tc.rules)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.cards, err)
+		}
+		if c := s.Category(); c != tc.want {
+			t.Errorf("%s %+v: expected %v, got %v from %v", tc.cards, tc.rules, tc.want, s, five)
+		}
+
+		h := FiveCardHand{A: five[0], B: five[1], C: five[2], D: five[3], E: five[4]}
+		if q, err := h.EvalShortDeck(); err != nil || q != s {
+			t.Errorf("%v scores %v (%v), expected %v", five, q, err, s)
+		}
+	}
+
+	if s, err := mustParseFive(t, "2c 6d 7h 8s 9c").EvalShortDeck(); !errors.Is(err, ErrRank) {
+		t.Errorf("expected %v for a deuce, got %v, %v", ErrRank, s, err)
+	}
+	if s, _, err := ShortDeckEval(mustParse(t, "Ah Kh 7h 8h Jh 5c As"), ShortDeckRules{}); !errors.Is(err, ErrRank) {
+		t.Errorf("expected %v for a five, got %v, %v", ErrRank, s, err)
+	}
+	if s, _, err := ShortDeckEval(mustParse(t, "Ah Kh 7h 8h"), ShortDeckRules{}); err == nil {
+		t.Errorf("expected error for four cards, got %v", s)
+	}
+}
+
+func shortDeckScore(t *testing.T, s string) ShortDeckScore {
+	t.Helper()
+
+	q, err := mustParseFive(t, s).EvalShortDeck()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return q
+}
+
+func TestShortDeckRules(t *testing.T) {
+	var (
+		straight = shortDeckScore(t, "Ah Kc Qd Js Th")
+		trips    = shortDeckScore(t, "6h 6c 6d 7s 8h")
+		fullest  = shortDeckScore(t, "6h 6c 6d 7s 7h")
+		two      = shortDeckScore(t, "Ah Ac Kd Ks Qh")
+		rules    = ShortDeckRules{TripsBeatStraight: true}
+	)
+
+	if !trips.Less(straight) || !(ShortDeckRules{}).Less(trips, straight) {
+		t.Errorf("expected %v to lose to %v", trips, straight)
+	}
+	if !rules.Less(straight, trips) || rules.Less(trips, straight) {
+		t.Errorf("expected %v to beat %v", trips, straight)
+	}
+	if !rules.Less(trips, fullest) || !rules.Less(two, straight) {
+		t.Errorf("unexpected order of %v, %v, %v and %v", fullest, trips, straight, two)
+	}
+}
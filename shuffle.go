@@ -0,0 +1,50 @@
+package cactuskev
+
+import (
+	crand "crypto/rand"
+	"log"
+	"math/big"
+	"math/rand"
+)
+
+// A Shuffler is the source of randomness decks are shuffled with. Intn
+// returns a uniformly random number in [0, n). A *rand.Rand is a Shuffler.
+type Shuffler interface {
+	Intn(n int) int
+}
+
+// NewShuffler returns a Shuffler seeded with seed, which always deals the
+// same cards in the same order.
+func NewShuffler(seed int64) Shuffler {
+	return rand.New(rand.NewSource(seed))
+}
+
+// CryptoShuffler is a Shuffler reading from crypto/rand, for dealing games
+// where the cards must not be predictable.
+type CryptoShuffler struct{}
+
+func (CryptoShuffler) Intn(n int) int {
+	if n <= 0 {
+		log.Panicf("invalid argument to Intn: %d", n)
+	}
+
+	v, err := crand.Int(crand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		log.Panic(err)
+	}
+
+	return int(v.Int64())
+}
+
+// globalShuffler is the Shuffler of the top-level functions of math/rand.
+type globalShuffler struct{}
+
+func (globalShuffler) Intn(n int) int { return rand.Intn(n) }
+
+// Shuffle puts the cards of d in a uniformly random order drawn from s, by
+// Fisher-Yates shuffle.
+func (d Deck) Shuffle(s Shuffler) {
+	for i := d.Len() - 1; i > 0; i-- {
+		d.Swap(i, s.Intn(i+1))
+	}
+}
@@ -0,0 +1,71 @@
+package cactuskev
+
+import (
+	"testing"
+)
+
+func TestShuffleUniform(t *testing.T) {
+	const n = 240000
+
+	var (
+		s      = NewShuffler(1)
+		counts = make(map[[4]Card]int)
+	)
+
+	for i := 0; i < n; i++ {
+		d := NewDeck()[:4]
+		d.Shuffle(s)
+		counts[[4]Card{d[0], d[1], d[2], d[3]}]++
+	}
+
+	if len(counts) != 24 {
+		t.Fatalf("expected 24 orders of 4 cards, got %d", len(counts))
+	}
+
+	// Chi-squared with 23 degrees of freedom, at a significance of 0.001.
+	var (
+		want = float64(n) / 24
+		chi2 float64
+	)
+	for _, c := range counts {
+		chi2 += (float64(c) - want) * (float64(c) - want) / want
+	}
+	if chi2 > 49.73 {
+		t.Errorf("orders not uniform: chi-squared %.2f", chi2)
+	}
+}
+
+func TestShuffleSeed(t *testing.T) {
+	a, b := NewDeck(), NewDeck()
+	a.Shuffle(NewShuffler(42))
+	b.Shuffle(NewShuffler(42))
+
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("decks shuffled from the same seed differ at %d: %v and %v", i, a[i], b[i])
+		}
+	}
+
+	h, g := RandomHandFrom(7, NewShuffler(7)), RandomHandFrom(7, NewShuffler(7))
+	for i := 0; i < h.Len(); i++ {
+		if h.Card(i) != g.Card(i) {
+			t.Fatalf("hands dealt from the same seed differ at %d: %v and %v", i, h.Card(i), g.Card(i))
+		}
+	}
+}
+
+func TestCryptoShuffler(t *testing.T) {
+	d := NewDeck()
+	d.Shuffle(CryptoShuffler{})
+
+	seen := make(map[Card]bool)
+	for _, c := range d {
+		if seen[c] || !c.Valid() {
+			t.Fatalf("unexpected card %v in shuffled deck", c)
+		}
+		seen[c] = true
+	}
+	if len(seen) != 52 {
+		t.Errorf("expected 52 cards, got %d", len(seen))
+	}
+}
@@ -0,0 +1,37 @@
+package cactuskev
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"testing"
+)
+
+// TestTablesUpToDate fails if arrays.go or the *_arrays.go files differ from
+// what maketables.go generates.
+func TestTablesUpToDate(t *testing.T) {
+	gotool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not available")
+	}
+
+	for file, tables := range map[string]string{
+		"arrays.go":           "high",
+		"lowball_arrays.go":   "lowball",
+		"shortdeck_arrays.go": "shortdeck",
+	} {
+		want, err := exec.Command(gotool, "run", "maketables.go", "-tables", tables, "-output", "-").Output()
+		if err != nil {
+			t.Fatalf("running maketables.go: %v", err)
+		}
+
+		got, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is out of date; run go generate", file)
+		}
+	}
+}
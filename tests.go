@@ -3,38 +3,23 @@ package cactuskev
 import (
 	"testing"
 	"sync"
-	"time"
-	"math/rand"
 )
 
 func AllFive(t *testing.T, handfn func() Hand, zerofn func(Hand) Hand) {
-	rand.Seed(time.Now().UnixNano())
-
 	var (
 		hand  = handfn()
-		freq  = make([]int, 9)
-		deck  = NewDeck()
+		freq  = make([]int, numCategories)
 		count int
 	)
 
-	for a := 0; a < 48; a++ {
-		for b := a + 1; b < 49; b++ {
-			for c := b + 1; c < 50; c++ {
-				for d := c + 1; d < 51; d++ {
-					for e := d + 1; e < 52; e++ {
-						hand = zerofn(hand)
-						hand.SetCard(0, deck[a])
-						hand.SetCard(1, deck[b])
-						hand.SetCard(2, deck[c])
-						hand.SetCard(3, deck[d])
-						hand.SetCard(4, deck[e])
-						freq[hand.Eval().Category()]++
-						count++
-					}
-				}
-			}
+	Combinations(NewDeck(), 5, func(cards []Card) {
+		hand = zerofn(hand)
+		for i, c := range cards {
+			hand.SetCard(i, c)
 		}
-	}
+		freq[hand.Eval().Category()]++
+		count++
+	})
 
 	if t == nil {
 		return
@@ -60,55 +45,44 @@ func AllFive(t *testing.T, handfn func() Hand, zerofn func(Hand) Hand) {
 }
 
 func AllSeven(t *testing.T, handfn func() Hand, zerofn func(Hand) Hand) {
-	rand.Seed(time.Now().UnixNano())
-
 	var (
-		deck    = NewDeck()
-		freqch  = make(chan Category, 1e6)
-		freq    = make([]int, 9)
-		count   = 0
+		deck  = NewDeck()
+		freq  = make([]int, numCategories)
+		count = 0
+		mu    sync.Mutex
+		wg    sync.WaitGroup
 	)
-	
-	go func() {
-		for c := range freqch {
-			freq[c]++
-			count++
-		}
-	}()
-	
-	var wg sync.WaitGroup
-	for i := 0; i < 46; i++ {
+
+	for _, r := range SplitCombinations(deck.Len(), 7, 64) {
 		wg.Add(1)
-		go func(a int) {
-			var hand = handfn()
-			
-			hand.SetCard(0, deck[a])
-			for b := a + 1; b < 47; b++ {
-				hand.SetCard(1, deck[b])
-				for c := b + 1; c < 48; c++ {
-					hand.SetCard(2, deck[c])
-					for d := c + 1; d < 49; d++ {
-						hand.SetCard(3, deck[d])
-						for e := d + 1; e < 50; e++ {
-							hand.SetCard(4, deck[e])
-							for f := e + 1; f < 51; f++ {
-								hand.SetCard(5, deck[f])
-								for g := f + 1; g < 52; g++ {
-									hand.SetCard(6, deck[g])
-									freqch <- hand.Eval().Category()
-									hand = zerofn(hand)
-								}
-							}
-						}
-					}
+		go func(from, to int) {
+			defer wg.Done()
+
+			var (
+				hand = handfn()
+				f    = make([]int, len(freq))
+				n    int
+			)
+
+			CombinationsBetween(deck, 7, from, to, func(cards []Card) {
+				for i, c := range cards {
+					hand.SetCard(i, c)
 				}
+				f[hand.Eval().Category()]++
+				n++
+				hand = zerofn(hand)
+			})
+
+			mu.Lock()
+			for c := range f {
+				freq[c] += f[c]
 			}
-			wg.Done()
-		}(i)
+			count += n
+			mu.Unlock()
+		}(r[0], r[1])
 	}
-	
+
 	wg.Wait()
-	close(freqch)
 
 	if t == nil {
 		return
@@ -155,3 +129,49 @@ func verifyAllHands(t *testing.T, fs []int, count, total int, ts map[Category]in
 		t.Errorf("unexpected hand frequency count: %d", freqSum)
 	}
 }
+
+// CompareSeven evaluates every seven-card hand with handfn and reffn, and
+// fails t unless both give the same Score for each.
+func CompareSeven(t *testing.T, handfn, reffn func() Hand) {
+	var (
+		deck = NewDeck()
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		diff int
+	)
+
+	for _, r := range SplitCombinations(deck.Len(), 7, 64) {
+		wg.Add(1)
+		go func(from, to int) {
+			defer wg.Done()
+
+			var (
+				hand = handfn()
+				ref  = reffn()
+				n    int
+			)
+
+			CombinationsBetween(deck, 7, from, to, func(cards []Card) {
+				for i, c := range cards {
+					hand.SetCard(i, c)
+					ref.SetCard(i, c)
+				}
+				if x, y := hand.Eval(), ref.Eval(); x != y {
+					if n++; n <= 10 {
+						t.Errorf("%v: expected %v, got %v", ref, y, x)
+					}
+				}
+			})
+
+			mu.Lock()
+			diff += n
+			mu.Unlock()
+		}(r[0], r[1])
+	}
+
+	wg.Wait()
+
+	if diff != 0 {
+		t.Errorf("%d hands differ", diff)
+	}
+}
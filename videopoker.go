@@ -0,0 +1,355 @@
+package cactuskev
+
+import (
+	"math/bits"
+	"sync"
+)
+
+// A Paytable says what a video poker game pays, per coin bet, for each final
+// hand. A Paytable must not be changed once it has been used, as the sums
+// OptimalHold and Return work from are kept with it.
+type Paytable struct {
+	Name string
+
+	// Wild names the wild cards of the game, if any.
+	Wild *Wild
+
+	// Lines lists the paying hands, best first. A hand is paid by the
+	// first line it makes, and nothing if it makes none.
+	Lines []PayLine
+
+	once sync.Once
+	sums *holdSums
+}
+
+// A PayLine is one line of a Paytable.
+type PayLine struct {
+	Hand  string
+	Pays  int
+	Makes func(h PayHand) bool
+}
+
+// PayHand is a final video poker hand, as a PayLine sees it.
+type PayHand struct {
+	Score Score // with wild cards standing for their best
+	Wilds int   // number of wild cards
+}
+
+func royal(h PayHand) bool        { return h.Score == 1 }
+func naturalRoyal(h PayHand) bool { return h.Score == 1 && h.Wilds == 0 }
+func fourWilds(h PayHand) bool    { return h.Wilds == 4 }
+
+func is(c Category) func(PayHand) bool {
+	return func(h PayHand) bool { return h.Score.Category() == c }
+}
+
+func pairOf(min Rank) func(PayHand) bool {
+	return func(h PayHand) bool {
+		return h.Score.Category() == OnePair && h.Score.Ranks()[0] >= min
+	}
+}
+
+// quads matches four of a kind of rank from to to, with a kicker of rank
+// kickerFrom to kickerTo.
+func quads(from, to, kickerFrom, kickerTo Rank) func(PayHand) bool {
+	return func(h PayHand) bool {
+		if h.Score.Category() != FourOfAKind {
+			return false
+		}
+		r := h.Score.Ranks()
+		return r[0] >= from && r[0] <= to && r[1] >= kickerFrom && r[1] <= kickerTo
+	}
+}
+
+// The usual "full pay" versions of popular games, which return 99.54%,
+// 99.17%, 98.98% and 100.76% with optimal play.
+var (
+	JacksOrBetter96 = &Paytable{
+		Name: "Jacks or Better 9/6",
+		Lines: []PayLine{
+			{"Royal Flush", 800, royal},
+			{"Straight Flush", 50, is(StraightFlush)},
+			{"Four of a Kind", 25, is(FourOfAKind)},
+			{"Full House", 9, is(FullHouse)},
+			{"Flush", 6, is(Flush)},
+			{"Straight", 4, is(Straight)},
+			{"Three of a Kind", 3, is(ThreeOfAKind)},
+			{"Two Pair", 2, is(TwoPair)},
+			{"Jacks or Better", 1, pairOf(Jack)},
+		},
+	}
+
+	BonusPoker85 = &Paytable{
+		Name: "Bonus Poker 8/5",
+		Lines: []PayLine{
+			{"Royal Flush", 800, royal},
+			{"Straight Flush", 50, is(StraightFlush)},
+			{"Four Aces", 80, quads(Ace, Ace, Deuce, Ace)},
+			{"Four 2-4", 40, quads(Deuce, Four, Deuce, Ace)},
+			{"Four 5-K", 25, is(FourOfAKind)},
+			{"Full House", 8, is(FullHouse)},
+			{"Flush", 5, is(Flush)},
+			{"Straight", 4, is(Straight)},
+			{"Three of a Kind", 3, is(ThreeOfAKind)},
+			{"Two Pair", 2, is(TwoPair)},
+			{"Jacks or Better", 1, pairOf(Jack)},
+		},
+	}
+
+	DoubleDoubleBonus96 = &Paytable{
+		Name: "Double Double Bonus 9/6",
+		Lines: []PayLine{
+			{"Royal Flush", 800, royal},
+			{"Straight Flush", 50, is(StraightFlush)},
+			{"Four Aces with 2-4", 400, quads(Ace, Ace, Deuce, Four)},
+			{"Four 2-4 with A-4", 160, func(h PayHand) bool {
+				return quads(Deuce, Four, Deuce, Four)(h) || quads(Deuce, Four, Ace, Ace)(h)
+			}},
+			{"Four Aces", 160, quads(Ace, Ace, Deuce, Ace)},
+			{"Four 2-4", 80, quads(Deuce, Four, Deuce, Ace)},
+			{"Four 5-K", 50, is(FourOfAKind)},
+			{"Full House", 9, is(FullHouse)},
+			{"Flush", 6, is(Flush)},
+			{"Straight", 4, is(Straight)},
+			{"Three of a Kind", 3, is(ThreeOfAKind)},
+			{"Two Pair", 1, is(TwoPair)},
+			{"Jacks or Better", 1, pairOf(Jack)},
+		},
+	}
+
+	DeucesWildFullPay = &Paytable{
+		Name: "Deuces Wild (full pay)",
+		Wild: &DeucesWild,
+		Lines: []PayLine{
+			{"Natural Royal Flush", 800, naturalRoyal},
+			{"Four Deuces", 200, fourWilds},
+			{"Wild Royal Flush", 25, royal},
+			{"Five of a Kind", 15, is(FiveOfAKind)},
+			{"Straight Flush", 9, is(StraightFlush)},
+			{"Four of a Kind", 5, is(FourOfAKind)},
+			{"Full House", 3, is(FullHouse)},
+			{"Flush", 2, is(Flush)},
+			{"Straight", 2, is(Straight)},
+			{"Three of a Kind", 1, is(ThreeOfAKind)},
+		},
+	}
+)
+
+// Pay returns what hand pays per coin bet, and the line that pays it, or nil
+// if it pays nothing.
+func (p *Paytable) Pay(hand [5]Card) (int, *PayLine) {
+	var h PayHand
+
+	if p.Wild != nil {
+		h.Score, _, _, _ = p.Wild.best(hand)
+		for _, c := range hand {
+			if p.Wild.IsWild(c) {
+				h.Wilds++
+			}
+		}
+	} else {
+		h.Score = (&FiveCardHand{A: hand[0], B: hand[1], C: hand[2], D: hand[3], E: hand[4]}).Eval()
+	}
+
+	for i := range p.Lines {
+		if p.Lines[i].Makes(h) {
+			return p.Lines[i].Pays, &p.Lines[i]
+		}
+	}
+
+	return 0, nil
+}
+
+// A Hold is a choice of cards to keep before the draw.
+type Hold struct {
+	Cards [5]bool
+
+	// EV is the average pay, per coin bet, over every draw to the hold.
+	EV float64
+}
+
+// OptimalHold tries each of the 32 ways of holding cards of a hand dealt
+// from a 52-card deck against every possible draw, and returns the hold
+// with the highest expected value. Of equally good holds, it returns the
+// one holding the fewest cards. It returns an error if a card is not Valid
+// or is dealt twice.
+func OptimalHold(hand [5]Card, p *Paytable) (Hold, error) {
+	holds, err := Holds(hand, p)
+	if err != nil {
+		return Hold{}, err
+	}
+
+	best := holds[0]
+	for _, h := range holds[1:] {
+		if h.EV > best.EV {
+			best = h
+		}
+	}
+
+	return best, nil
+}
+
+// Holds returns the expected value of each of the 32 ways of holding cards
+// of hand, fewest cards first. It returns an error if a card is not Valid or
+// is dealt twice.
+func Holds(hand [5]Card, p *Paytable) ([]Hold, error) {
+	if err := checkCards(hand[:]); err != nil {
+		return nil, err
+	}
+
+	var (
+		sums  = p.holdSums()
+		order = make([]int, 5)
+		evs   = sums.evs(hand, order)
+		holds = make([]Hold, 0, len(evs))
+	)
+
+	for n := 0; n <= len(hand); n++ {
+		for m, ev := range evs {
+			if bits.OnesCount(uint(m)) != n {
+				continue
+			}
+
+			h := Hold{EV: ev}
+			for i, j := range order {
+				h.Cards[j] = m&(1<<uint(i)) != 0
+			}
+			holds = append(holds, h)
+		}
+	}
+
+	return holds, nil
+}
+
+// Return returns the share of the amount bet a player holding optimally gets
+// back on average, over every hand dealt.
+func (p *Paytable) Return() float64 {
+	var (
+		sums  = p.holdSums()
+		deck  = NewDeck()
+		order = make([]int, 5)
+		total float64
+	)
+
+	for a := 0; a < 48; a++ {
+		for b := a + 1; b < 49; b++ {
+			for c := b + 1; c < 50; c++ {
+				for d := c + 1; d < 51; d++ {
+					for e := d + 1; e < 52; e++ {
+						evs := sums.evs([5]Card{deck[a], deck[b], deck[c], deck[d], deck[e]}, order)
+
+						best := evs[0]
+						for _, ev := range evs[1:] {
+							if ev > best {
+								best = ev
+							}
+						}
+						total += best
+					}
+				}
+			}
+		}
+	}
+
+	return total / float64(Binomial(52, 5))
+}
+
+func (p *Paytable) holdSums() *holdSums {
+	p.once.Do(func() { p.sums = newHoldSums(p) })
+	return p.sums
+}
+
+// holdSums[k] holds, for every set of k cards, the sum of what every final
+// hand containing them pays, indexed by the colex rank of the set. From
+// these, the total pay of the draws to a hold follows by inclusion and
+// exclusion of the cards thrown away.
+type holdSums [6][]int64
+
+func newHoldSums(p *Paytable) *holdSums {
+	var (
+		s    holdSums
+		deck = NewDeck()
+	)
+
+	for k := range s {
+		s[k] = make([]int64, Binomial(52, k))
+	}
+
+	var idx [5]int
+	for idx[0] = 0; idx[0] < 48; idx[0]++ {
+		for idx[1] = idx[0] + 1; idx[1] < 49; idx[1]++ {
+			for idx[2] = idx[1] + 1; idx[2] < 50; idx[2]++ {
+				for idx[3] = idx[2] + 1; idx[3] < 51; idx[3]++ {
+					for idx[4] = idx[3] + 1; idx[4] < 52; idx[4]++ {
+						pay, _ := p.Pay([5]Card{deck[idx[0]], deck[idx[1]], deck[idx[2]], deck[idx[3]], deck[idx[4]]})
+						if pay == 0 {
+							continue
+						}
+
+						for m := 0; m < 32; m++ {
+							s[bits.OnesCount(uint(m))][colex(&idx, m)] += int64(pay)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return &s
+}
+
+// colex returns the ColexRank of the cards of idx, in ascending order,
+// picked by mask m.
+func colex(idx *[5]int, m int) int {
+	var (
+		picked [5]int
+		k      int
+	)
+	for i, c := range idx {
+		if m&(1<<uint(i)) != 0 {
+			picked[k] = c
+			k++
+		}
+	}
+	return ColexRank(picked[:k])
+}
+
+// evs returns the expected value of holding each subset of hand, as a mask
+// over the cards of hand sorted by their index in a new Deck; order is set
+// to the position in hand of each of those.
+func (s *holdSums) evs(hand [5]Card, order []int) (evs [32]float64) {
+	var idx [5]int
+
+	for i := range order {
+		order[i] = i
+	}
+	for i := 1; i < len(order); i++ {
+		for j := i; j > 0 && hand[order[j]].Index() < hand[order[j-1]].Index(); j-- {
+			order[j], order[j-1] = order[j-1], order[j]
+		}
+	}
+	for i, j := range order {
+		idx[i] = hand[j].Index()
+	}
+
+	// The total pay of the final hands containing each subset ...
+	var t [32]int64
+	for m := range t {
+		t[m] = s[bits.OnesCount(uint(m))][colex(&idx, m)]
+	}
+
+	// ... less that of those containing any card of the rest of hand.
+	for i := 0; i < 5; i++ {
+		for m := range t {
+			if m&(1<<uint(i)) == 0 {
+				t[m] -= t[m|1<<uint(i)]
+			}
+		}
+	}
+
+	for m := range t {
+		evs[m] = float64(t[m]) / float64(Binomial(47, 5-bits.OnesCount(uint(m))))
+	}
+
+	return evs
+}
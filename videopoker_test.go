@@ -0,0 +1,162 @@
+package cactuskev
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func five(t testing.TB, s string) [5]Card {
+	d := mustParse(t, s)
+	if len(d) != 5 {
+		t.Fatalf("expected 5 cards, got %v", d)
+	}
+	return [5]Card{d[0], d[1], d[2], d[3], d[4]}
+}
+
+func TestPay(t *testing.T) {
+	for _, tc := range []struct {
+		p    *Paytable
+		hand string
+		pays int
+		line string
+	}{
+		{JacksOrBetter96, "Ah Kh Qh Jh Th", 800, "Royal Flush"},
+		{JacksOrBetter96, "Jh Jc 2d 5s 9c", 1, "Jacks or Better"},
+		{JacksOrBetter96, "Th Tc 2d 5s 9c", 0, ""},
+		{BonusPoker85, "Ah Ac Ad As 9c", 80, "Four Aces"},
+		{BonusPoker85, "3h 3c 3d 3s 9c", 40, "Four 2-4"},
+		{DoubleDoubleBonus96, "Ah Ac Ad As 3c", 400, "Four Aces with 2-4"},
+		{DoubleDoubleBonus96, "Ah Ac Ad As 9c", 160, "Four Aces"},
+		{DoubleDoubleBonus96, "3h 3c 3d 3s Ac", 160, "Four 2-4 with A-4"},
+		{DoubleDoubleBonus96, "3h 3c 3d 3s 9c", 80, "Four 2-4"},
+		{DoubleDoubleBonus96, "9h 9c Kd Ks 3c", 1, "Two Pair"},
+		{DeucesWildFullPay, "Ah Kh Qh Jh Th", 800, "Natural Royal Flush"},
+		{DeucesWildFullPay, "2h Kh Qh Jh Th", 25, "Wild Royal Flush"},
+		{DeucesWildFullPay, "2h 2c 2d 2s 9c", 200, "Four Deuces"},
+		{DeucesWildFullPay, "2h 9d 9h 9s 9c", 15, "Five of a Kind"},
+		{DeucesWildFullPay, "2h Kd 9h 5s 7c", 0, ""},
+		{DeucesWildFullPay, "2h Kd Kh 5s 7c", 1, "Three of a Kind"},
+	} {
+		pays, line := tc.p.Pay(five(t, tc.hand))
+		name := ""
+		if line != nil {
+			name = line.Hand
+		}
+		if pays != tc.pays || name != tc.line {
+			t.Errorf("%s %s: expected %d for %q, got %d for %q", tc.p.Name, tc.hand, tc.pays, tc.line, pays, name)
+		}
+	}
+}
+
+func TestOptimalHold(t *testing.T) {
+	for _, tc := range []struct {
+		p    *Paytable
+		hand string
+		hold [5]bool
+		ev   float64
+	}{
+		{JacksOrBetter96, "Ah Kh Qh Jh Th", [5]bool{true, true, true, true, true}, 800},
+		// One royal, 8 other flushes, 3 straights and 12 high pairs.
+		{JacksOrBetter96, "Ah Kh Qh Jh 2c", [5]bool{true, true, true, true, false}, 872.0 / 47},
+		// Four to a royal beats a pat flush, with one flush fewer to draw.
+		{JacksOrBetter96, "Ah Kh Qh Jh 2h", [5]bool{true, true, true, true, false}, 866.0 / 47},
+		{JacksOrBetter96, "9s 9c 2d 5h 7c", [5]bool{true, true, false, false, false}, 0},
+		{DeucesWildFullPay, "2h 2c 2d 2s 9c", [5]bool{true, true, true, true, false}, 200},
+	} {
+		h, err := OptimalHold(five(t, tc.hand), tc.p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if h.Cards != tc.hold {
+			t.Errorf("%s %s: expected hold %v, got %v", tc.p.Name, tc.hand, tc.hold, h)
+		}
+		if tc.ev != 0 && math.Abs(h.EV-tc.ev) > 1e-9 {
+			t.Errorf("%s %s: expected EV %v, got %v", tc.p.Name, tc.hand, tc.ev, h.EV)
+		}
+	}
+}
+
+func TestOptimalHoldErrors(t *testing.T) {
+	hand := five(t, "Ah Kh Qh Jh Th")
+
+	hand[4] = hand[0]
+	if _, err := OptimalHold(hand, JacksOrBetter96); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("expected %v, got %v", ErrDuplicate, err)
+	}
+
+	for _, c := range []Card{0, Joker, Card(0xffff)} {
+		hand[4] = c
+		if _, err := OptimalHold(hand, JacksOrBetter96); !errors.Is(err, ErrCard) {
+			t.Errorf("%v: expected %v, got %v", c, ErrCard, err)
+		}
+		if _, err := Holds(hand, JacksOrBetter96); !errors.Is(err, ErrCard) {
+			t.Errorf("%v: expected %v, got %v", c, ErrCard, err)
+		}
+	}
+}
+
+// TestHolds checks the expected values against draws dealt one by one.
+func TestHolds(t *testing.T) {
+	hand := five(t, "Kh 7c Jd Ks 3h")
+
+	deck := NewDeck()
+	for _, c := range hand {
+		deck.Remove(c)
+	}
+
+	holds, err := Holds(hand, JacksOrBetter96)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, h := range holds {
+		var (
+			held  []Card
+			total int
+			n     int
+			draw  func(from int, cards []Card)
+		)
+		for i, c := range hand {
+			if h.Cards[i] {
+				held = append(held, c)
+			}
+		}
+
+		if len(held) < 2 {
+			continue // too many draws to deal one by one
+		}
+
+		draw = func(from int, cards []Card) {
+			if len(cards) == 5 {
+				pays, _ := JacksOrBetter96.Pay([5]Card{cards[0], cards[1], cards[2], cards[3], cards[4]})
+				total += pays
+				n++
+				return
+			}
+			for i := from; i < deck.Len(); i++ {
+				draw(i+1, append(cards, deck[i]))
+			}
+		}
+		draw(0, append([]Card(nil), held...))
+
+		if want := float64(total) / float64(n); math.Abs(h.EV-want) > 1e-9 {
+			t.Errorf("hold %v: expected EV %v, got %v", h.Cards, want, h.EV)
+		}
+	}
+}
+
+func TestReturn(t *testing.T) {
+	tables := map[*Paytable]float64{JacksOrBetter96: 0.995439}
+	if !testing.Short() {
+		tables[BonusPoker85] = 0.991660
+		tables[DoubleDoubleBonus96] = 0.989808
+		tables[DeucesWildFullPay] = 1.007619
+	}
+
+	for p, want := range tables {
+		if r := p.Return(); math.Abs(r-want) > 1e-6 {
+			t.Errorf("%s: expected return %.6f, got %.6f", p.Name, want, r)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package cactuskev
+
+import (
+	"fmt"
+)
+
+// Joker is a card that is always wild. It is not Valid, and NewDeck does not
+// include it.
+const Joker Card = 1 << 29
+
+// Wild names the wild cards of a game: a Joker is always wild, and so are
+// the cards of Ranks, such as the deuces of Deuces Wild.
+type Wild struct {
+	Ranks []Rank
+}
+
+// DeucesWild makes every deuce, and any Joker, wild.
+var DeucesWild = Wild{Ranks: []Rank{Deuce}}
+
+// IsWild reports whether c is wild.
+func (w Wild) IsWild(c Card) bool {
+	if c == Joker {
+		return true
+	}
+
+	for _, r := range w.Ranks {
+		if c.Rank() == r {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Eval evaluates every five-card combination of 5 to 9 cards, each wild card
+// standing for whichever card makes the best hand, and returns the best
+// Score. It also returns the five cards that make it, with wild cards
+// replaced by the cards they stand for, and which of them were wild.
+//
+// With wild cards, five of a kind is possible, and beats a straight flush.
+func (w Wild) Eval(cards []Card) (Score, [5]Card, [5]bool) {
+	if n := len(cards); n < 5 || n >= len(combinations) {
+		panic(fmt.Errorf("hand of %d cards not supported", n))
+	}
+
+	var (
+		best  = Score(9999)
+		five  [5]Card
+		wilds [5]bool
+	)
+
+	for _, c := range combinations[len(cards)] {
+		hand := [5]Card{cards[c[0]], cards[c[1]], cards[c[2]], cards[c[3]], cards[c[4]]}
+
+		if q, f, ws := w.eval(hand); best.Less(q) {
+			best, five, wilds = q, f, ws
+		}
+	}
+
+	return best, five, wilds
+}
+
+// eval scores five cards, picking the ranks the wild cards stand for, and
+// then their suits.
+func (w Wild) eval(hand [5]Card) (Score, [5]Card, [5]bool) {
+	score, ranks, flush, wilds := w.best(hand)
+	if ranks == nil {
+		return score, hand, wilds
+	}
+
+	return score, substitute(hand, wilds, *ranks, flush), wilds
+}
+
+// best scores five cards, and returns the ranks of the best hand the wild
+// cards make and whether it is a flush; the ranks are nil without wild
+// cards.
+func (w Wild) best(hand [5]Card) (Score, *[5]Rank, bool, [5]bool) {
+	var (
+		naturals []Card
+		wilds    [5]bool
+	)
+
+	for i, c := range hand {
+		if wilds[i] = w.IsWild(c); !wilds[i] {
+			naturals = append(naturals, c)
+		}
+	}
+
+	if len(naturals) == len(hand) {
+		h := FiveCardHand{A: hand[0], B: hand[1], C: hand[2], D: hand[3], E: hand[4]}
+		return h.Eval(), nil, false, wilds
+	}
+
+	var (
+		suited = true
+		same   = true
+	)
+	for _, c := range naturals {
+		suited = suited && c.Suit() == naturals[0].Suit()
+		same = same && c.Rank() == naturals[0].Rank()
+	}
+
+	// Five of a kind, of the naturals' rank or, with nothing but wild
+	// cards, of aces.
+	if same {
+		r := Ace
+		if len(naturals) > 0 {
+			r = naturals[0].Rank()
+		}
+		return Score(-1 - int(r)), &[5]Rank{r, r, r, r, r}, false, wilds
+	}
+
+	var (
+		best      = Score(9999)
+		bestRanks [5]Rank
+		bestFlush bool
+		ranks     [5]Rank
+		pick      func(i int, from Rank)
+	)
+
+	pick = func(i int, from Rank) {
+		for i < len(hand) && !wilds[i] {
+			ranks[i] = hand[i].Rank()
+			i++
+		}
+
+		if i == len(hand) {
+			if q, flush := scoreRanks5(ranks, suited); best.Less(q) {
+				best, bestRanks, bestFlush = q, ranks, flush
+			}
+			return
+		}
+
+		// The wild cards stand for ranks in ascending order, so that
+		// each set of ranks is only tried once.
+		for r := from; r <= Ace; r++ {
+			ranks[i] = r
+			pick(i+1, r)
+		}
+	}
+	pick(0, Deuce)
+
+	return best, &bestRanks, bestFlush, wilds
+}
+
+// scoreRanks5 scores five cards of the given ranks, a flush if flush is
+// possible and the ranks are unique. It reports whether it scored a flush.
+func scoreRanks5(ranks [5]Rank, flush bool) (Score, bool) {
+	var (
+		bits    int
+		product = 1
+	)
+
+	for _, r := range ranks {
+		bits |= 1 << uint(r)
+		product *= Primes[r]
+	}
+
+	if Unique5[bits] == 0 {
+		return findFast(uint32(product)), false
+	}
+	if flush {
+		return Flushes[bits], true
+	}
+	return Unique5[bits], false
+}
+
+// substitute replaces the wild cards of hand by cards of the given ranks.
+// For a flush they take the suit of the other cards; otherwise they take a
+// suit no card of the same rank has yet, avoiding the suit of the other
+// cards, so as not to make a flush by accident.
+func substitute(hand [5]Card, wilds [5]bool, ranks [5]Rank, flush bool) [5]Card {
+	var (
+		common Suit
+		used   = make(map[Card]bool)
+	)
+
+	for i, c := range hand {
+		if !wilds[i] {
+			common = c.Suit()
+			used[c] = true
+		}
+	}
+
+	for i := range hand {
+		if !wilds[i] {
+			continue
+		}
+
+		if flush {
+			hand[i] = NewCard(common, ranks[i])
+			continue
+		}
+
+		hand[i] = 0
+		for _, s := range append(suitsAvoiding(common), common) {
+			if c := NewCard(s, ranks[i]); !used[c] {
+				hand[i] = c
+				break
+			}
+		}
+		if hand[i] == 0 {
+			// Five of a kind takes a suit twice.
+			hand[i] = NewCard(suits[0], ranks[i])
+		}
+		used[hand[i]] = true
+	}
+
+	return hand
+}
+
+// suitsAvoiding lists the suits other than s.
+func suitsAvoiding(s Suit) []Suit {
+	var other []Suit
+	for _, t := range suits {
+		if t != s {
+			other = append(other, t)
+		}
+	}
+	return other
+}
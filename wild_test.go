@@ -0,0 +1,187 @@
+package cactuskev
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestJoker(t *testing.T) {
+	if Joker.Valid() || Joker.String() != "Joker" {
+		t.Errorf("unexpected Joker %v", Joker)
+	}
+	if !(Wild{}).IsWild(Joker) || (Wild{}).IsWild(NewCard(Club, Deuce)) || !DeucesWild.IsWild(NewCard(Club, Deuce)) {
+		t.Errorf("unexpected wild cards")
+	}
+}
+
+func TestWildEval(t *testing.T) {
+	for _, tc := range []struct {
+		wild  Wild
+		cards []Card
+		want  Score
+		five  string
+	}{
+		{DeucesWild, mustParse(t, "2c 2d Ah Ad Ac"), -13, ""},
+		{DeucesWild, mustParse(t, "2c 2d 2h 2s 7c"), -6, ""},
+		{Wild{}, append(mustParse(t, "Ah Kh Qh Jh"), Joker), 1, "Ah Kh Qh Jh Th"},
+		{DeucesWild, mustParse(t, "2c 5h 6h 7h 8h"), 6, "9h 5h 6h 7h 8h"},
+		{Wild{}, append(mustParse(t, "7c 7d 3s 9h"), Joker), 0, ""},
+		{Wild{}, append(mustParse(t, "7c 8d 3s 9h Kh Qc"), Joker, Joker), 0, ""},
+		{DeucesWild, mustParse(t, "As Ks Qs Js Ts"), 1, "As Ks Qs Js Ts"},
+	} {
+		score, five, wilds := tc.wild.Eval(tc.cards)
+
+		if tc.want != 0 && score != tc.want {
+			t.Errorf("%v: expected %v, got %v from %v", tc.cards, tc.want, score, five)
+		}
+		if tc.five != "" {
+			want := mustParse(t, tc.five)
+			for i := range five {
+				if five[i] != want[i] {
+					t.Errorf("%v: expected %v, got %v", tc.cards, want, five)
+					break
+				}
+			}
+		}
+
+		for i, c := range tc.cards[:5] {
+			if len(tc.cards) == 5 && wilds[i] != tc.wild.IsWild(c) {
+				t.Errorf("%v: unexpected wild cards %v", tc.cards, wilds)
+			}
+		}
+
+		if score.Category() != FiveOfAKind {
+			h := FiveCardHand{A: five[0], B: five[1], C: five[2], D: five[3], E: five[4]}
+			if h.Eval() != score {
+				t.Errorf("%v: %v scores %v, expected %v", tc.cards, five, h.Eval(), score)
+			}
+		}
+	}
+
+	if _, five, _ := DeucesWild.Eval(mustParse(t, "2c 2d Ah Ad Ac")); five[0] != NewCard(Spade, Ace) || five[1].Rank() != Ace {
+		t.Errorf("expected five aces, got %v", five)
+	}
+	if s, _, _ := (Wild{}).Eval(append(mustParse(t, "7c 7d 3s 9h"), Joker)); s.Category() != ThreeOfAKind {
+		t.Errorf("expected three sevens, got %v", s)
+	}
+	if s, _, _ := (Wild{}).Eval(append(mustParse(t, "7c 8d 3s 9h Kh Qc"), Joker, Joker)); s.Category() != Straight {
+		t.Errorf("expected a straight, got %v", s)
+	}
+}
+
+// TestWildEvalJoker checks a single joker against every card it could stand
+// for.
+func TestWildEvalJoker(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for n := 0; n < 500; n++ {
+		deck := NewDeck()
+		r.Shuffle(deck.Len(), deck.Swap)
+		naturals := deck[:4]
+
+		if naturals[0].Rank() == naturals[1].Rank() && naturals[1].Rank() == naturals[2].Rank() && naturals[2].Rank() == naturals[3].Rank() {
+			continue
+		}
+
+		want := Score(9999)
+		for _, c := range deck[4:] {
+			if s, _ := EvalBest(append(append([]Card(nil), naturals...), c)); want.Less(s) {
+				want = s
+			}
+		}
+
+		score, five, wilds := Wild{}.Eval(append(append([]Card(nil), naturals...), Joker))
+		if score != want {
+			t.Errorf("%v and a Joker: expected %v, got %v", naturals, want, score)
+		}
+		if !wilds[4] || checkDistinct(five[:]) != nil {
+			t.Errorf("%v and a Joker: unexpected cards %v", naturals, five)
+		}
+	}
+}
+
+// TestEvalJokerHand checks that the plain evaluators treat a parsed Joker as
+// wild.
+func TestEvalJokerHand(t *testing.T) {
+	tests := []struct {
+		in    string
+		score Score
+		desc  string
+	}{
+		{"As Ks Qs Js Joker", 1, "Royal Flush"},
+		{"Ah Ad Ac As Joker", -13, "Five Aces"},
+		{"7c 7d 3s 9h Joker", 0, ""},
+		{"As Ks Qs Js Joker 2c 3d", 1, "Royal Flush"},
+		{"7c 8d 3s 9h Kh Joker", 0, ""},
+	}
+
+	for _, test := range tests {
+		h, err := ParseHand(test.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _, _ := Wild{}.Eval(h.Cards())
+		if test.score != 0 && want != test.score {
+			t.Errorf("%s: expected wild score %v, got %v", test.in, test.score, want)
+		}
+		if s := h.Eval(); s != want {
+			t.Errorf("%s: expected %v, got %v", test.in, want, s)
+		}
+		if d := Describe(h); test.desc != "" && d != test.desc {
+			t.Errorf("%s: expected %q, got %q", test.in, test.desc, d)
+		}
+
+		if c := h.Cards(); len(c) == 5 {
+			f := FiveCardHand{A: c[0], B: c[1], C: c[2], D: c[3], E: c[4]}
+			if s := f.EvalSearch(); s != want {
+				t.Errorf("%s: search expected %v, got %v", test.in, want, s)
+			}
+		} else if len(c) == 7 {
+			if s := EvalSeven(c[0], c[1], c[2], c[3], c[4], c[5], c[6]); s != want {
+				t.Errorf("%s: seven expected %v, got %v", test.in, want, s)
+			}
+		}
+	}
+}
+
+func TestFiveOfAKind(t *testing.T) {
+	s := Score(-13)
+	if s.Category() != FiveOfAKind || !Score(1).Less(s) || !Score(-1).Less(s) {
+		t.Errorf("unexpected %v", s)
+	}
+	if c := Score(1).Category(); s.Category() >= c || Score(-1).Category() >= c {
+		t.Errorf("%v does not order above %v by category", s.Category(), c)
+	}
+	if d := s.Describe(); d != "Five Aces" {
+		t.Errorf("unexpected description %q", d)
+	}
+	if d := Score(-1).DescribeShort(); d != "Five Twos" {
+		t.Errorf("unexpected description %q", d)
+	}
+	if e := s.Equivalents(); e[0].Rank() != Ace || e[3].Rank() != Ace || e[4] != Joker {
+		t.Errorf("unexpected equivalents %v", e)
+	}
+	if s.Percentile() != 1 || s.Count() != 0 {
+		t.Errorf("unexpected percentile %v or count %d", s.Percentile(), s.Count())
+	}
+
+	b, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var u Score
+	if err := u.UnmarshalBinary(b); err != nil || u != s {
+		t.Errorf("expected %v, got %v (%v)", s, u, err)
+	}
+	var c Category
+	if err := c.UnmarshalText([]byte("five of a kind")); err != nil || c != FiveOfAKind {
+		t.Errorf("expected FiveOfAKind, got %v (%v)", c, err)
+	}
+	if b, err = FiveOfAKind.MarshalBinary(); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.UnmarshalBinary(b); err != nil || c != FiveOfAKind {
+		t.Errorf("expected FiveOfAKind, got %v (%v)", c, err)
+	}
+}